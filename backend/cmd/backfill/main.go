@@ -0,0 +1,146 @@
+// Package main backfills the quote history from provider candle endpoints.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/clients/coingecko"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients/finnhub"
+	"github.com/tmythicator/ticker-rush/backend/internal/config"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/repository/postgres"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+const dateLayout = "2006-01-02"
+
+func main() {
+	fromFlag := flag.String("from", "", "first day to backfill, as YYYY-MM-DD (required)")
+	toFlag := flag.String("to", "", "day to stop before, as YYYY-MM-DD (default: now)")
+	resolutionFlag := flag.String("resolution", "1h", "candle resolution, e.g. 5m, 1h or 1d")
+	symbolsFlag := flag.String("symbols", "", "comma-separated symbols to backfill (default: all tracked tickers)")
+	pause := flag.Duration("pause", 2*time.Second, "delay between provider requests")
+	flag.Parse()
+
+	from, to, resolution, err := parseRange(*fromFlag, *toFlag, *resolutionFlag)
+	if err != nil {
+		log.Fatalf("Invalid arguments: %v", err)
+	}
+
+	// Interrupting is safe: running the same command again resumes from the last checkpoint.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	log.Printf("Connecting to database...")
+	pool, err := pgxpool.New(ctx, cfg.DatabaseURL())
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	tickers, err := postgres.NewInstrumentRepository(pool).GetTrackedTickers(ctx)
+	if err != nil {
+		log.Fatalf("Failed to load tracked tickers: %v", err)
+	}
+	tickers = selectTickers(tickers, *symbolsFlag)
+
+	providers := map[string]service.CandleProvider{
+		domain.SourceFinnhub:   finnhub.NewClient(cfg.FinnhubKey, cfg.FinnhubTimeout),
+		domain.SourceCoinGecko: coingecko.NewClient(cfg.CoingeckoKey, cfg.CoingeckoTimeout),
+	}
+
+	log.Printf("Backfilling %d tickers at %s from %s to %s...",
+		len(tickers), domain.ResolutionName(resolution), from.Format(time.RFC3339), to.Format(time.RFC3339))
+
+	backfill := service.NewBackfill(postgres.NewBackfillRepository(pool), providers, *pause)
+	stored, err := backfill.Run(ctx, tickers, resolution, from, to)
+	if errors.Is(err, context.Canceled) {
+		log.Printf("Backfill interrupted after storing %d quotes; run it again to resume.", stored)
+
+		return
+	}
+	if err != nil {
+		log.Fatalf("Backfill failed after storing %d quotes: %v", stored, err)
+	}
+	log.Printf("Backfill completed successfully: %d quotes stored.", stored)
+}
+
+// parseRange parses the backfill range and resolution flags.
+func parseRange(fromValue, toValue, resolutionValue string) (time.Time, time.Time, time.Duration, error) {
+	resolution, ok := domain.ParseResolution(resolutionValue)
+	if !ok {
+		return time.Time{}, time.Time{}, 0, fmt.Errorf("invalid resolution %q", resolutionValue)
+	}
+
+	if fromValue == "" {
+		return time.Time{}, time.Time{}, 0, errors.New("-from is required")
+	}
+	from, err := time.Parse(dateLayout, fromValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, 0, fmt.Errorf("invalid -from: %w", err)
+	}
+
+	to := time.Now()
+	if toValue != "" {
+		to, err = time.Parse(dateLayout, toValue)
+		if err != nil {
+			return time.Time{}, time.Time{}, 0, fmt.Errorf("invalid -to: %w", err)
+		}
+	}
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, 0, errors.New("-from must be before -to")
+	}
+
+	return from, to, resolution, nil
+}
+
+// selectTickers keeps the tickers named in the comma-separated list, or all of them if it is
+// empty. Tickers may be named by instrument ID or by provider symbol.
+func selectTickers(tickers []*domain.TickerInfo, list string) []*domain.TickerInfo {
+	if list == "" {
+		return tickers
+	}
+
+	var symbols []string
+	for symbol := range strings.SplitSeq(list, ",") {
+		if symbol = strings.TrimSpace(symbol); symbol != "" {
+			symbols = append(symbols, symbol)
+		}
+	}
+
+	var selected []*domain.TickerInfo
+	for _, ticker := range tickers {
+		if slices.ContainsFunc(symbols, func(symbol string) bool { return namesTicker(symbol, ticker) }) {
+			selected = append(selected, ticker)
+		}
+	}
+	for _, symbol := range symbols {
+		if !slices.ContainsFunc(selected, func(t *domain.TickerInfo) bool { return namesTicker(symbol, t) }) {
+			log.Printf("Skipping %s: not tracked by any ladder", symbol)
+		}
+	}
+
+	return selected
+}
+
+// namesTicker reports whether symbol is the instrument ID or the provider symbol of the ticker.
+func namesTicker(symbol string, ticker *domain.TickerInfo) bool {
+	return symbol == ticker.Symbol || symbol == domain.ProviderSymbol(ticker.Symbol)
+}
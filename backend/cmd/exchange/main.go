@@ -41,6 +41,9 @@ import (
 	"github.com/tmythicator/ticker-rush/backend/internal/api/middleware"
 	"github.com/tmythicator/ticker-rush/backend/internal/config"
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/ladder/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/leaderboard/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/user/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/repository/postgres"
 	valkey "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
 	"github.com/tmythicator/ticker-rush/backend/internal/service"
@@ -54,8 +57,14 @@ type App struct {
 	tradeService       *service.Trade
 	marketService      *service.Market
 	leaderboardService *service.Leaderboard
+	ladderService      *service.Ladder
+	instrumentService  *service.Instrument
 	lifecycleWorker    *worker.LadderLifecycleWorker
+	quoteHub           *service.QuoteHub
+	warmupWorker       *worker.QuoteWarmupWorker
 	leaderboardWorker  *worker.LeaderboardWorker
+	optionExpiryWorker *worker.OptionExpiryWorker
+	predictionWorker   *worker.PredictionResolutionWorker
 	restHandler        *handler.RestHandler
 	valkeyClient       *redis.Client
 	postgreClient      *pgxpool.Pool
@@ -122,25 +131,47 @@ func NewApp(ctx context.Context, cfg *config.Config) (app *App, err error) {
 
 	// Initialize repositories
 	ladderRepo := postgres.NewLadderRepository(postgreClient)
+	instrumentRepo := postgres.NewInstrumentRepository(postgreClient)
 	userRepo := postgres.NewUser(postgreClient)
 	portfolioRepo := postgres.NewPortfolioRepository(postgreClient)
-	marketRepo := valkey.NewMarketRepository(valkeyClient)
 	leaderboardRepo := valkey.NewLeaderboardRepository(valkeyClient)
 	historyRepo := postgres.NewHistoryRepository(postgreClient)
+	fillRepo := postgres.NewFillRepository(postgreClient)
+	optionRepo := postgres.NewOptionRepository(postgreClient)
 	transactor := postgres.NewPgxTransactor(postgreClient)
+	valkeyMarketRepo := valkey.NewMarketRepository(valkeyClient)
+	// Reads fall back to the latest stored quote whenever a key is missing from Valkey.
+	marketRepo := service.NewQuoteRecovery(valkeyMarketRepo, historyRepo, ladderRepo)
+	quoteHub := service.NewQuoteHub(valkeyMarketRepo, cfg.QuoteStreamBuffer)
+	fxRates := service.NewFXRates(valkey.NewFXRateRepository(valkeyClient), cfg.FXRateMaxAge)
 
 	// Initialize services
 	userService := service.NewUser(userRepo, portfolioRepo, ladderRepo)
-	tradeService := service.NewTrade(userRepo, portfolioRepo, marketRepo, ladderRepo, transactor)
-	marketService := service.NewMarket(marketRepo, historyRepo, ladderRepo)
-	ladderService := service.NewLadder(ladderRepo)
-	leaderboardService := service.NewLeaderboard(userRepo, portfolioRepo, marketRepo, ladderRepo, leaderboardRepo)
+	tradeService := service.NewTrade(userRepo, portfolioRepo, fillRepo, marketRepo, ladderRepo, optionRepo, fxRates, transactor)
+	marketService := service.NewMarket(marketRepo, historyRepo, ladderRepo, quoteHub)
+	quarantineService := service.NewQuarantine(valkey.NewQuarantineRepository(valkeyClient), marketRepo, historyRepo)
+	ladderService := service.NewLadder(ladderRepo, fxRates)
+	instrumentService := service.NewInstrument(instrumentRepo)
+	basketService := service.NewBasket(postgres.NewBasketRepository(postgreClient), marketRepo, historyRepo, ladderRepo)
+	optionService := service.NewOptions(optionRepo, marketRepo, historyRepo, ladderRepo, fxRates, service.OptionPricing{
+		Volatility:           cfg.OptionVolatility,
+		HistoricalVolatility: cfg.OptionHistoricalVolatility,
+		RiskFreeRate:         cfg.OptionRiskFreeRate,
+	})
+	predictionService := service.NewPredictions(
+		postgres.NewPredictionRepository(postgreClient), userRepo, historyRepo, ladderRepo, transactor,
+		cfg.PredictionLiquidity, cfg.PredictionResolveInterval,
+	)
+	leaderboardService := service.NewLeaderboard(userRepo, portfolioRepo, marketRepo, ladderRepo, leaderboardRepo, fxRates, predictionService)
 
-	restHandler := handler.NewRestHandler(userService, tradeService, marketService, leaderboardService, ladderService, cfg.JWTSecret)
+	restHandler := handler.NewRestHandler(userService, tradeService, marketService, leaderboardService, ladderService, quarantineService, instrumentService, basketService, optionService, predictionService, cfg.JWTSecret)
 
 	// Initialize workers
 	leaderboardWorker := worker.NewLeaderboardWorker(leaderboardService, 1*time.Minute)
-	lifecycleWorker := worker.NewLadderLifecycleWorker(ladderRepo, portfolioRepo, marketRepo, 1*time.Minute)
+	lifecycleWorker := worker.NewLadderLifecycleWorker(ladderRepo, portfolioRepo, marketRepo, fxRates, predictionService, marketService, 1*time.Minute)
+	warmupWorker := worker.NewQuoteWarmupWorker(marketRepo, cfg.QuoteWarmupInterval)
+	optionExpiryWorker := worker.NewOptionExpiryWorker(optionService, cfg.OptionExpiryInterval)
+	predictionWorker := worker.NewPredictionResolutionWorker(predictionService, cfg.PredictionResolveInterval)
 
 	return &App{
 		cfg:                cfg,
@@ -148,8 +179,14 @@ func NewApp(ctx context.Context, cfg *config.Config) (app *App, err error) {
 		tradeService:       tradeService,
 		marketService:      marketService,
 		leaderboardService: leaderboardService,
+		ladderService:      ladderService,
+		instrumentService:  instrumentService,
 		lifecycleWorker:    lifecycleWorker,
+		quoteHub:           quoteHub,
+		warmupWorker:       warmupWorker,
 		leaderboardWorker:  leaderboardWorker,
+		optionExpiryWorker: optionExpiryWorker,
+		predictionWorker:   predictionWorker,
 		restHandler:        restHandler,
 		valkeyClient:       valkeyClient,
 		postgreClient:      postgreClient,
@@ -162,7 +199,7 @@ func (a *App) Run(ctx context.Context) error {
 	rlRepo := valkey.NewRateLimitter(a.valkeyClient)
 
 	// HTTP Server
-	router, err := api.NewRouter(a.restHandler, a.cfg, rlRepo)
+	router, err := api.NewRouter(a.restHandler, a.cfg, rlRepo, a.userService)
 	if err != nil {
 		return fmt.Errorf("failed to create router: %w", err)
 	}
@@ -171,6 +208,8 @@ func (a *App) Run(ctx context.Context) error {
 		Addr:    fmt.Sprintf(":%d", a.cfg.ServerPort),
 		Handler: router,
 	}
+	// Streaming clients never finish on their own, so end them as soon as shutdown starts.
+	srv.RegisterOnShutdown(a.quoteHub.Close)
 
 	g.Go(func() error {
 		log.Printf("Exchange API running on :%d\n", a.cfg.ServerPort)
@@ -196,11 +235,25 @@ func (a *App) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to listen gRPC: %w", err)
 	}
 
+	// gRPC calls are limited like requests to the REST API.
+	grpcLimiter := middleware.NewRateLimitter(rlRepo, 100, time.Minute)
 	grpcServer := googlegrpc.NewServer(
-		googlegrpc.UnaryInterceptor(middleware.GrpcAuthInterceptor(a.cfg.JWTSecret)),
+		grpcapi.ServerCodec(),
+		googlegrpc.ChainUnaryInterceptor(
+			middleware.GrpcErrorInterceptor(),
+			grpcLimiter.GrpcInterceptor(a.cfg.JWTSecret, grpcapi.PublicMethods...),
+			middleware.GrpcAuthInterceptor(a.cfg.JWTSecret, grpcapi.PublicMethods...),
+		),
+		googlegrpc.ChainStreamInterceptor(
+			middleware.GrpcStreamErrorInterceptor(),
+			middleware.GrpcStreamAuthInterceptor(a.cfg.JWTSecret, grpcapi.PublicMethods...),
+		),
 	)
-	exchangeServer := grpcapi.NewExchangeServer(a.tradeService, a.marketService, a.userService)
+	exchangeServer := grpcapi.NewExchangeServer(a.tradeService, a.marketService, a.userService, a.instrumentService)
 	exchange.RegisterExchangeServiceServer(grpcServer, exchangeServer)
+	ladder.RegisterLadderServiceServer(grpcServer, grpcapi.NewLadderServer(a.ladderService))
+	leaderboard.RegisterLeaderBoardServiceServer(grpcServer, grpcapi.NewLeaderboardServer(a.leaderboardService))
+	user.RegisterUserServiceServer(grpcServer, grpcapi.NewUserServer(a.userService, a.cfg.JWTSecret))
 
 	g.Go(func() error {
 		log.Printf("Exchange gRPC running on :%d\n", 50051)
@@ -214,6 +267,8 @@ func (a *App) Run(ctx context.Context) error {
 	g.Go(func() error {
 		<-ctx.Done()
 		log.Println("Shutting down gRPC server...")
+		// Quote streams only end once the hub drains, so GracefulStop would wait for them forever.
+		a.quoteHub.Close()
 		grpcServer.GracefulStop()
 
 		return nil
@@ -237,6 +292,33 @@ func (a *App) Run(ctx context.Context) error {
 		return nil
 	})
 
+	// Quote Warm-up Worker
+	g.Go(func() error {
+		if wuErr := a.warmupWorker.Start(ctx); wuErr != nil && !errors.Is(wuErr, context.Canceled) {
+			return fmt.Errorf("quote warm-up worker error: %w", wuErr)
+		}
+
+		return nil
+	})
+
+	// Option Expiry Worker
+	g.Go(func() error {
+		if oeErr := a.optionExpiryWorker.Start(ctx); oeErr != nil && !errors.Is(oeErr, context.Canceled) {
+			return fmt.Errorf("option expiry worker error: %w", oeErr)
+		}
+
+		return nil
+	})
+
+	// Prediction Resolution Worker
+	g.Go(func() error {
+		if prErr := a.predictionWorker.Start(ctx); prErr != nil && !errors.Is(prErr, context.Canceled) {
+			return fmt.Errorf("prediction resolution worker error: %w", prErr)
+		}
+
+		return nil
+	})
+
 	return g.Wait()
 }
 
@@ -26,6 +26,7 @@ import (
 	"os/signal"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	go_redis "github.com/redis/go-redis/v9"
@@ -33,9 +34,14 @@ import (
 
 	"github.com/tmythicator/ticker-rush/backend/internal/clients/coingecko"
 	"github.com/tmythicator/ticker-rush/backend/internal/clients/finnhub"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients/oanda"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients/replay"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients/synthetic"
 	"github.com/tmythicator/ticker-rush/backend/internal/config"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 	"github.com/tmythicator/ticker-rush/backend/internal/repository/postgres"
 	"github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
 	"github.com/tmythicator/ticker-rush/backend/internal/telemetry"
 	"github.com/tmythicator/ticker-rush/backend/internal/worker"
 )
@@ -46,7 +52,19 @@ func main() {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
-	if err = cfg.ValidateFinnhubKey(); err != nil {
+	if cfg.UseLiveMarketData() {
+		if err = cfg.ValidateFinnhubKey(); err != nil {
+			log.Fatalf("Fetcher failed to start: %v", err)
+		}
+	}
+
+	syntheticSymbols, err := synthetic.ParseSymbolParams(cfg.SyntheticSymbols, synthetic.DefaultParams)
+	if err != nil {
+		log.Fatalf("Fetcher failed to start: %v", err)
+	}
+
+	staticFXRates, err := service.ParseStaticFXRates(cfg.FXStaticRates)
+	if err != nil {
 		log.Fatalf("Fetcher failed to start: %v", err)
 	}
 
@@ -71,6 +89,7 @@ func main() {
 
 	// Initialize Market Repository
 	marketRepo := redis.NewMarketRepository(rdb)
+	quarantineRepo := redis.NewQuarantineRepository(rdb)
 
 	// Connect to Postgres
 	postgreConnStr := cfg.DatabaseURL()
@@ -86,23 +105,136 @@ func main() {
 	historyRepo := postgres.NewHistoryRepository(pgPool)
 	ladderRepo := postgres.NewLadderRepository(pgPool)
 
-	// Initialize Finnhub Client
-	finnhubClient := finnhub.NewClient(cfg.FinnhubKey, cfg.FinnhubTimeout)
+	newSyntheticClient := func() *synthetic.Client {
+		return synthetic.NewClient(synthetic.Config{
+			Seed:            cfg.SyntheticSeed,
+			Step:            cfg.SyntheticStep,
+			JumpProbability: cfg.SyntheticJumpProbability,
+			JumpVolatility:  cfg.SyntheticJumpVolatility,
+			Symbols:         syntheticSymbols,
+		})
+	}
+
+	var finnhubClient, coingeckoClient, oandaClient worker.QuoteProvider
+	// Exchange rates come from OANDA where available and are fixed otherwise.
+	var fxProvider service.FXRateProvider = staticFXRates
+	switch {
+	case cfg.UseSyntheticMarketData():
+		log.Println("Using synthetic market data instead of Finnhub, CoinGecko and OANDA")
+		finnhubClient = newSyntheticClient()
+		coingeckoClient = newSyntheticClient()
+		oandaClient = newSyntheticClient()
+	case cfg.UseReplayMarketData():
+		log.Printf("Replaying market data from %s at %gx speed", cfg.ReplayDir, cfg.ReplaySpeed)
+		replayClient := replay.NewClient(replay.Config{
+			Dir:         cfg.ReplayDir,
+			Speed:       cfg.ReplaySpeed,
+			StartOffset: cfg.ReplayStartOffset,
+			Epoch:       replayEpoch(ctx, cfg, ladderRepo),
+		})
+		finnhubClient = replayClient
+		coingeckoClient = replayClient
+		oandaClient = replayClient
+	default:
+		// Initialize Finnhub Client
+		finnhubClient = finnhub.NewClient(cfg.FinnhubKey, cfg.FinnhubTimeout)
 
-	// Initialize CoinGecko Client
-	coingeckoClient := coingecko.NewClient(cfg.CoingeckoKey, cfg.CoingeckoTimeout)
+		// Initialize CoinGecko Client
+		coingeckoClient = coingecko.NewClient(cfg.CoingeckoKey, cfg.CoingeckoTimeout)
+
+		// Initialize OANDA Client, which needs an account unlike the other providers
+		if cfg.HasOanda() {
+			client := oanda.NewClient(cfg.OandaURL, cfg.OandaToken, cfg.OandaAccountID, cfg.OandaTimeout)
+			oandaClient = client
+			fxProvider = client
+		} else {
+			log.Println("OANDA_API_TOKEN or OANDA_ACCOUNT_ID is not set, currency pairs will not be quoted")
+		}
+	}
 
 	// Initialize Workers
-	finnhubWorker := worker.NewMarketFetcher("Finnhub", finnhubClient, marketRepo, historyRepo, ladderRepo, &worker.FetcherConfig{
-		FetchInterval:   cfg.FinnhubFetchInterval,
-		RefreshInterval: cfg.MarketFetcherRefreshInterval,
-		RequestTimeout:  cfg.FinnhubTimeout,
+	finnhubWorker := worker.NewMarketFetcher(domain.SourceFinnhub, finnhubClient, marketRepo, historyRepo, ladderRepo, quarantineRepo, &worker.FetcherConfig{
+		FetchInterval:     cfg.FinnhubFetchInterval,
+		Burst:             cfg.FinnhubFetchBurst,
+		MaxBackoff:        cfg.MarketFetcherMaxBackoff,
+		RefreshInterval:   cfg.MarketFetcherRefreshInterval,
+		RequestTimeout:    cfg.FinnhubTimeout,
+		MaxDeviation:      cfg.StockMaxDeviation,
+		MaxQuoteAge:       cfg.QuoteMaxAge,
+		ValidationWindow:  cfg.QuoteValidationWindow,
+		AcceptLevelShifts: cfg.QuoteAcceptLevelShifts,
+		LeaseTTL:          cfg.MarketFetcherLeaseTTL,
 	})
-	coingeckoWorker := worker.NewMarketFetcher("CoinGecko", coingeckoClient, marketRepo, historyRepo, ladderRepo, &worker.FetcherConfig{
-		FetchInterval:   cfg.CoingeckoFetchInterval,
-		RefreshInterval: cfg.MarketFetcherRefreshInterval,
-		RequestTimeout:  cfg.CoingeckoTimeout,
+	coingeckoWorker := worker.NewMarketFetcher(domain.SourceCoinGecko, coingeckoClient, marketRepo, historyRepo, ladderRepo, quarantineRepo, &worker.FetcherConfig{
+		FetchInterval:     cfg.CoingeckoFetchInterval,
+		Burst:             cfg.CoingeckoFetchBurst,
+		MaxBackoff:        cfg.MarketFetcherMaxBackoff,
+		RefreshInterval:   cfg.MarketFetcherRefreshInterval,
+		RequestTimeout:    cfg.CoingeckoTimeout,
+		MaxDeviation:      cfg.CryptoMaxDeviation,
+		MaxQuoteAge:       cfg.QuoteMaxAge,
+		ValidationWindow:  cfg.QuoteValidationWindow,
+		AcceptLevelShifts: cfg.QuoteAcceptLevelShifts,
+		LeaseTTL:          cfg.MarketFetcherLeaseTTL,
 	})
+	var oandaWorker *worker.MarketFetcher
+	if oandaClient != nil {
+		oandaWorker = worker.NewMarketFetcher(domain.SourceOANDA, oandaClient, marketRepo, historyRepo, ladderRepo, quarantineRepo, &worker.FetcherConfig{
+			FetchInterval:     cfg.OandaFetchInterval,
+			Burst:             cfg.OandaFetchBurst,
+			MaxBackoff:        cfg.MarketFetcherMaxBackoff,
+			RefreshInterval:   cfg.MarketFetcherRefreshInterval,
+			RequestTimeout:    cfg.OandaTimeout,
+			MaxDeviation:      cfg.FXMaxDeviation,
+			MaxQuoteAge:       cfg.QuoteMaxAge,
+			ValidationWindow:  cfg.QuoteValidationWindow,
+			AcceptLevelShifts: cfg.QuoteAcceptLevelShifts,
+			LeaseTTL:          cfg.MarketFetcherLeaseTTL,
+		})
+	}
+	syntheticWorker := worker.NewMarketFetcher(domain.SourceSynthetic, newSyntheticClient(), marketRepo, historyRepo, ladderRepo, quarantineRepo, &worker.FetcherConfig{
+		FetchInterval:     cfg.SyntheticFetchInterval,
+		Burst:             1,
+		MaxBackoff:        cfg.MarketFetcherMaxBackoff,
+		RefreshInterval:   cfg.MarketFetcherRefreshInterval,
+		RequestTimeout:    cfg.SyntheticFetchInterval,
+		MaxDeviation:      cfg.CryptoMaxDeviation,
+		MaxQuoteAge:       cfg.QuoteMaxAge,
+		ValidationWindow:  cfg.QuoteValidationWindow,
+		AcceptLevelShifts: cfg.QuoteAcceptLevelShifts,
+		LeaseTTL:          cfg.MarketFetcherLeaseTTL,
+	})
+
+	// Baskets are priced from the quotes the other workers store.
+	basketWorker := worker.NewBasketWorker(
+		service.NewBasket(postgres.NewBasketRepository(pgPool), marketRepo, historyRepo, ladderRepo),
+		marketRepo, historyRepo, cfg.BasketPriceInterval,
+	)
+
+	// Option premiums are priced from the quotes of their underlyings.
+	fxRates := service.NewFXRates(redis.NewFXRateRepository(rdb), cfg.FXRateMaxAge)
+	optionWorker := worker.NewOptionPricingWorker(
+		service.NewOptions(postgres.NewOptionRepository(pgPool), marketRepo, historyRepo, ladderRepo, fxRates, service.OptionPricing{
+			Volatility:           cfg.OptionVolatility,
+			HistoricalVolatility: cfg.OptionHistoricalVolatility,
+			RiskFreeRate:         cfg.OptionRiskFreeRate,
+		}),
+		marketRepo, historyRepo, cfg.OptionPriceInterval,
+	)
+
+	if cfg.MarketFetcherLeaseTTL > 0 {
+		leaseRepo := redis.NewLeaseRepository(rdb)
+		holder := fetcherInstanceID()
+		log.Printf("Leader election enabled, instance %s", holder)
+
+		for _, w := range []*worker.MarketFetcher{finnhubWorker, coingeckoWorker, oandaWorker, syntheticWorker} {
+			if w != nil {
+				w.EnableLeaderElection(leaseRepo, holder)
+			}
+		}
+		basketWorker.EnableLeaderElection(leaseRepo, holder, cfg.MarketFetcherLeaseTTL)
+		optionWorker.EnableLeaderElection(leaseRepo, holder, cfg.MarketFetcherLeaseTTL)
+	}
 
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -122,6 +254,59 @@ func main() {
 		return nil
 	})
 
+	if oandaWorker != nil {
+		g.Go(func() error {
+			if err := oandaWorker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				return fmt.Errorf("oanda worker error: %w", err)
+			}
+
+			return nil
+		})
+	}
+
+	g.Go(func() error {
+		if err := syntheticWorker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			return fmt.Errorf("synthetic worker error: %w", err)
+		}
+
+		return nil
+	})
+
+	// Restore quotes from history after a Valkey restart instead of waiting for a full fetch cycle.
+	warmupWorker := worker.NewQuoteWarmupWorker(service.NewQuoteRecovery(marketRepo, historyRepo, ladderRepo), cfg.QuoteWarmupInterval)
+	g.Go(func() error {
+		if err := warmupWorker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			return fmt.Errorf("quote warm-up worker error: %w", err)
+		}
+
+		return nil
+	})
+
+	fxWorker := worker.NewFXRateWorker(fxRates, fxProvider, ladderRepo, cfg.FXRefreshInterval)
+	g.Go(func() error {
+		if err := fxWorker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			return fmt.Errorf("fx rate worker error: %w", err)
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		if err := basketWorker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			return fmt.Errorf("basket worker error: %w", err)
+		}
+
+		return nil
+	})
+
+	g.Go(func() error {
+		if err := optionWorker.Start(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			return fmt.Errorf("option pricing worker error: %w", err)
+		}
+
+		return nil
+	})
+
 	log.Println("Fetcher service running...")
 
 	if err := g.Wait(); err != nil {
@@ -130,3 +315,37 @@ func main() {
 		log.Println("Fetcher service stopped cleanly")
 	}
 }
+
+// replayEpoch is the wall-clock time replayed series start at: REPLAY_EPOCH if set,
+// otherwise the start of the active ladder, so its clock lines up with the recording.
+func replayEpoch(ctx context.Context, cfg *config.Config, ladderRepo *postgres.LadderRepository) time.Time {
+	if !cfg.ReplayEpoch.IsZero() {
+		return cfg.ReplayEpoch
+	}
+
+	ladderID, err := ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		log.Printf("No active ladder to align replay with, starting now: %v", err)
+
+		return time.Now()
+	}
+
+	ladder, err := ladderRepo.GetLadder(ctx, ladderID)
+	if err != nil {
+		log.Printf("Failed to load active ladder, starting replay now: %v", err)
+
+		return time.Now()
+	}
+
+	return ladder.StartTime
+}
+
+// fetcherInstanceID identifies this replica in leases.
+func fetcherInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "fetcher"
+	}
+
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
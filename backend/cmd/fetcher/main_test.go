@@ -2,19 +2,17 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/jackc/pgx/v5"
 	go_redis "github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/clients/finnhub"
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
-	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
 	"github.com/tmythicator/ticker-rush/backend/internal/worker"
 )
@@ -24,11 +22,11 @@ type MockFinnhubClient struct {
 	FinnhubQuote finnhub.Response
 }
 
-func (m *MockFinnhubClient) GetQuote(ctx context.Context, symbol string) (*exchange.Quote, error) {
-	return &exchange.Quote{
+func (m *MockFinnhubClient) GetQuote(ctx context.Context, symbol string) (*domain.Quote, error) {
+	return &domain.Quote{
 		Symbol:    symbol,
 		Price:     m.FinnhubQuote.CurrentPrice,
-		Timestamp: timestamppb.New(time.Unix(m.FinnhubQuote.Timestamp, 0)),
+		Timestamp: time.Unix(m.FinnhubQuote.Timestamp, 0),
 	}, nil
 }
 
@@ -43,6 +41,14 @@ func (m *MockHistoryRepository) GetHistory(ctx context.Context, symbol string, l
 	return nil, nil
 }
 
+func (m *MockHistoryRepository) GetLatestQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error) {
+	return nil, nil
+}
+
+func (m *MockHistoryRepository) GetQuoteAt(ctx context.Context, symbol string, at time.Time) (*domain.Quote, error) {
+	return nil, pgx.ErrNoRows
+}
+
 // MockLadderRepository mocks the ladder management.
 type MockLadderRepository struct {
 	ActiveLadderID int64
@@ -97,6 +103,14 @@ func (m *MockLadderRepository) DeleteLadderPortfolioItemsByLadder(ctx context.Co
 	return nil
 }
 
+func (m *MockLadderRepository) GetHeldSymbols(ctx context.Context, ladderID int64) ([]string, error) {
+	return nil, nil
+}
+
+func (m *MockLadderRepository) GetBasketComponents(ctx context.Context, ladderID int64) ([]*domain.TickerInfo, error) {
+	return nil, nil
+}
+
 func TestMarketFetcher(t *testing.T) {
 	// 1. Setup Miniredis
 	mr, err := miniredis.Run()
@@ -110,8 +124,8 @@ func TestMarketFetcher(t *testing.T) {
 
 	// 2. Setup Mock Finnhub
 	mockQuote := finnhub.Response{
-		Change:       10.0,
-		CurrentPrice: 150.0,
+		Change:       decimal.NewFromInt(10),
+		CurrentPrice: decimal.NewFromInt(150),
 		Timestamp:    time.Now().Unix(),
 	}
 	mockClient := &MockFinnhubClient{FinnhubQuote: mockQuote}
@@ -125,7 +139,7 @@ func TestMarketFetcher(t *testing.T) {
 			{Symbol: "AAPL", Source: "Finnhub"},
 		},
 	}
-	marketWorker := worker.NewMarketFetcher("Finnhub", mockClient, marketRepo, historyRepo, ladderRepo, &worker.FetcherConfig{
+	marketWorker := worker.NewMarketFetcher("Finnhub", mockClient, marketRepo, historyRepo, ladderRepo, redis.NewQuarantineRepository(rdb), &worker.FetcherConfig{
 		FetchInterval:   100 * time.Millisecond,
 		RefreshInterval: 1 * time.Minute,
 		RequestTimeout:  5 * time.Second,
@@ -148,11 +162,9 @@ func TestMarketFetcher(t *testing.T) {
 	val, err := rdb.Get(ctx, "market:AAPL").Result()
 	assert.NoError(t, err)
 
-	var quote redis.ValkeyQuote
-
-	err = json.Unmarshal([]byte(val), &quote)
+	quote, err := marketRepo.DecodeQuote(val)
 	assert.NoError(t, err)
 
-	assert.Equal(t, 150.0, quote.Price)
+	assert.Equal(t, "150", quote.Price.String())
 	assert.Equal(t, "AAPL", quote.Symbol)
 }
@@ -0,0 +1,120 @@
+// Package main syncs the instrument catalogue from a seed file and provider profiles.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/db"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients/coingecko"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients/finnhub"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients/oanda"
+	"github.com/tmythicator/ticker-rush/backend/internal/config"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/repository/postgres"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// seedInstrument is an entry of the seed file.
+type seedInstrument struct {
+	// Symbol is the provider symbol, which is qualified with the source to the instrument ID.
+	Symbol     string          `json:"symbol"`
+	Source     string          `json:"source"`
+	Name       string          `json:"name"`
+	AssetClass string          `json:"asset_class"`
+	Currency   string          `json:"currency"`
+	Exchange   string          `json:"exchange"`
+	TickSize   decimal.Decimal `json:"tick_size"`
+	PipSize    decimal.Decimal `json:"pip_size"`
+}
+
+func main() {
+	seedPath := flag.String("seed", "", "path to a JSON seed file (default: the embedded catalogue)")
+	fetch := flag.Bool("fetch", false, "fetch provider profiles for tracked tickers missing from the seed")
+	flag.Parse()
+
+	ctx := context.Background()
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	seed, err := loadSeed(*seedPath)
+	if err != nil {
+		log.Fatalf("Failed to load seed: %v", err)
+	}
+
+	log.Printf("Connecting to database...")
+	pool, err := pgxpool.New(ctx, cfg.DatabaseURL())
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	var providers map[string]service.InstrumentProfileProvider
+	if *fetch {
+		providers = map[string]service.InstrumentProfileProvider{
+			domain.SourceFinnhub:   finnhub.NewClient(cfg.FinnhubKey, cfg.FinnhubTimeout),
+			domain.SourceCoinGecko: coingecko.NewClient(cfg.CoingeckoKey, cfg.CoingeckoTimeout),
+		}
+		if cfg.HasOanda() {
+			providers[domain.SourceOANDA] = oanda.NewClient(cfg.OandaURL, cfg.OandaToken, cfg.OandaAccountID, cfg.OandaTimeout)
+		}
+	}
+
+	instrumentService := service.NewInstrument(postgres.NewInstrumentRepository(pool))
+	saved, err := instrumentService.Sync(ctx, seed, providers)
+	if err != nil {
+		log.Fatalf("Failed to sync instruments: %v", err)
+	}
+	log.Printf("Instrument sync completed successfully: %d instruments saved.", saved)
+}
+
+// loadSeed reads the seed file at path, or the embedded catalogue if path is empty.
+func loadSeed(path string) ([]*domain.Instrument, error) {
+	data := db.InstrumentsSeed
+	if path != "" {
+		var err error
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var entries []seedInstrument
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid seed file: %w", err)
+	}
+
+	instruments := make([]*domain.Instrument, len(entries))
+	for i, e := range entries {
+		if e.Symbol == "" {
+			return nil, fmt.Errorf("seed entry %d has no symbol", i)
+		}
+		if e.Currency == "" {
+			e.Currency = "USD"
+		}
+		if !e.TickSize.IsPositive() {
+			e.TickSize = decimal.New(1, -2)
+		}
+		instruments[i] = &domain.Instrument{
+			Symbol:     domain.InstrumentID(e.Source, e.Symbol),
+			Source:     e.Source,
+			Name:       e.Name,
+			AssetClass: e.AssetClass,
+			Currency:   e.Currency,
+			Exchange:   e.Exchange,
+			TickSize:   e.TickSize,
+			PipSize:    e.PipSize,
+		}
+	}
+
+	return instruments, nil
+}
@@ -0,0 +1,40 @@
+// Package main moves Valkey data cached under bare provider symbols to instrument IDs.
+// It is run once after the instrument ID migration and is safe to run again.
+package main
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	go_redis "github.com/redis/go-redis/v9"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/config"
+	"github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+)
+
+func main() {
+	ctx := context.Background()
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	log.Printf("Connecting to Valkey...")
+	rdb := go_redis.NewClient(&go_redis.Options{
+		Addr: cfg.RedisHost + ":" + strconv.Itoa(cfg.RedisPort),
+	})
+	defer func() { _ = rdb.Close() }()
+
+	quotes, err := redis.NewMarketRepository(rdb).MigrateInstrumentIDs(ctx)
+	if err != nil {
+		log.Fatalf("Failed to migrate quote keys: %v", err)
+	}
+	log.Printf("Quotes: %d moved, %d dropped", quotes.Moved, quotes.Dropped)
+
+	quarantined, err := redis.NewQuarantineRepository(rdb).MigrateInstrumentIDs(ctx)
+	if err != nil {
+		log.Fatalf("Failed to migrate quarantined quotes: %v", err)
+	}
+	log.Printf("Quarantined quotes: %d moved, %d dropped", quarantined.Moved, quarantined.Dropped)
+}
@@ -12,6 +12,7 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/config"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 )
 
 func main() {
@@ -219,17 +220,19 @@ func insertTickers(ctx context.Context, pool *pgxpool.Pool, ladderID int64) erro
 		symbol string
 		source string
 	}{
-		{"AAPL", "Finnhub"},
-		{"AMZN", "Finnhub"},
-		{"MSFT", "Finnhub"},
-		{"TSLA", "Finnhub"},
-		{"bitcoin", "CoinGecko"},
-		{"ethereum", "CoinGecko"},
+		{"AAPL", domain.SourceFinnhub},
+		{"AMZN", domain.SourceFinnhub},
+		{"MSFT", domain.SourceFinnhub},
+		{"TSLA", domain.SourceFinnhub},
+		{"bitcoin", domain.SourceCoinGecko},
+		{"ethereum", domain.SourceCoinGecko},
+		{"EUR_USD", domain.SourceOANDA},
+		{"GBP_USD", domain.SourceOANDA},
 	}
 	for _, t := range tickers {
 		_, err := pool.Exec(ctx, `
 			INSERT INTO ladder_tickers (ladder_id, stock_symbol, source)
-			VALUES ($1, $2, $3)`, ladderID, t.symbol, t.source)
+			VALUES ($1, $2, $3)`, ladderID, domain.InstrumentID(t.source, t.symbol), t.source)
 		if err != nil {
 			return err
 		}
@@ -270,11 +273,11 @@ func insertActiveParticipants(ctx context.Context, pool *pgxpool.Pool, ladderID
 		qty       float64
 		avgPr     float64
 	}{
-		{1, "AAPL", 30.0, 180.00},
-		{1, "bitcoin", 0.1, 60000.00},
-		{2, "TSLA", 25.0, 160.00},
-		{4, "MSFT", 10.0, 400.00},
-		{4, "ethereum", 1.0, 3000.00},
+		{1, "FH:AAPL", 30.0, 180.00},
+		{1, "CG:bitcoin", 0.1, 60000.00},
+		{2, "FH:TSLA", 25.0, 160.00},
+		{4, "FH:MSFT", 10.0, 400.00},
+		{4, "CG:ethereum", 1.0, 3000.00},
 	}
 
 	for _, h := range holdings {
@@ -7,3 +7,8 @@ import "embed"
 //
 //go:embed migrations/*.sql
 var MigrationsFS embed.FS
+
+// InstrumentsSeed holds the default instrument catalogue as a JSON array.
+//
+//go:embed instruments.json
+var InstrumentsSeed []byte
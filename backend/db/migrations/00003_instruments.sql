@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS instruments (
+    symbol TEXT PRIMARY KEY,
+    source TEXT NOT NULL DEFAULT 'unknown',
+    name TEXT NOT NULL DEFAULT '',
+    asset_class TEXT NOT NULL DEFAULT 'unknown',
+    currency TEXT NOT NULL DEFAULT 'USD',
+    exchange TEXT NOT NULL DEFAULT '',
+    tick_size NUMERIC NOT NULL DEFAULT 0.01 CHECK (tick_size > 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS instruments_symbol_trgm_idx ON instruments USING gin (symbol gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS instruments_name_trgm_idx ON instruments USING gin (name gin_trgm_ops);
+
+-- +goose Down
+DROP TABLE IF EXISTS instruments;
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS backfill_checkpoints (
+    symbol TEXT NOT NULL,
+    resolution TEXT NOT NULL,
+    range_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    source TEXT NOT NULL DEFAULT 'unknown',
+    loaded_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (symbol, resolution, range_start)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS backfill_checkpoints;
@@ -0,0 +1,66 @@
+-- +goose Up
+-- Symbols become instrument IDs qualified with their source, e.g. FH:AAPL or CG:bitcoin,
+-- so that providers quoting the same symbol no longer collide. See domain.InstrumentID.
+-- Quotes cached in Valkey under bare symbols are moved separately by cmd/rekey.
+
+-- +goose StatementBegin
+CREATE FUNCTION pg_temp.instrument_id(source TEXT, symbol TEXT) RETURNS TEXT AS $$
+    SELECT CASE
+        WHEN strpos(symbol, ':') > 0 THEN symbol
+        ELSE CASE source
+            WHEN 'Finnhub' THEN 'FH'
+            WHEN 'CoinGecko' THEN 'CG'
+            WHEN 'Synthetic' THEN 'SYN'
+            ELSE source
+        END || ':' || symbol
+    END
+$$ LANGUAGE SQL IMMUTABLE;
+-- +goose StatementEnd
+
+-- Portfolio items and stored quotes carry no ticker source, so their symbols are mapped
+-- through the tickers, preferring the most recent ladder, and then the catalogue.
+CREATE TEMP TABLE instrument_ids AS
+SELECT DISTINCT ON (symbol) symbol, pg_temp.instrument_id(source, symbol) AS id
+FROM (
+    SELECT stock_symbol AS symbol, source, 0 AS priority, ladder_id FROM ladder_tickers
+    UNION ALL
+    SELECT symbol, source, 1, 0 FROM instruments
+) known
+WHERE strpos(symbol, ':') = 0
+ORDER BY symbol, priority, ladder_id DESC;
+
+UPDATE ladder_portfolio_items p
+SET stock_symbol = m.id
+FROM instrument_ids m
+WHERE p.stock_symbol = m.symbol;
+
+UPDATE market_quotes
+SET symbol = source || ':' || symbol
+WHERE source IN ('FH', 'CG') AND strpos(symbol, ':') = 0;
+
+UPDATE market_quotes q
+SET symbol = m.id
+FROM instrument_ids m
+WHERE q.symbol = m.symbol;
+
+UPDATE ladder_tickers
+SET stock_symbol = pg_temp.instrument_id(source, stock_symbol)
+WHERE strpos(stock_symbol, ':') = 0;
+
+UPDATE instruments
+SET symbol = pg_temp.instrument_id(source, symbol)
+WHERE strpos(symbol, ':') = 0;
+
+UPDATE backfill_checkpoints
+SET symbol = pg_temp.instrument_id(source, symbol)
+WHERE strpos(symbol, ':') = 0;
+
+DROP TABLE instrument_ids;
+DROP FUNCTION pg_temp.instrument_id(TEXT, TEXT);
+
+-- +goose Down
+UPDATE backfill_checkpoints SET symbol = substr(symbol, strpos(symbol, ':') + 1);
+UPDATE instruments SET symbol = substr(symbol, strpos(symbol, ':') + 1);
+UPDATE ladder_tickers SET stock_symbol = substr(stock_symbol, strpos(stock_symbol, ':') + 1);
+UPDATE market_quotes SET symbol = substr(symbol, strpos(symbol, ':') + 1);
+UPDATE ladder_portfolio_items SET stock_symbol = substr(stock_symbol, strpos(stock_symbol, ':') + 1);
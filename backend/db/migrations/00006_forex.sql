@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE instruments ADD COLUMN IF NOT EXISTS pip_size NUMERIC NOT NULL DEFAULT 0 CHECK (pip_size >= 0);
+
+-- +goose Down
+ALTER TABLE instruments DROP COLUMN IF EXISTS pip_size;
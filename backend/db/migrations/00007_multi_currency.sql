@@ -0,0 +1,27 @@
+-- +goose Up
+-- Balances and net worth are kept in the ladder's base currency; instruments quoted in other
+-- currencies are converted at the FX rate of the time of each fill or valuation.
+ALTER TABLE ladders ADD COLUMN IF NOT EXISTS base_currency TEXT NOT NULL DEFAULT 'USD';
+
+CREATE TABLE IF NOT EXISTS ladder_fills (
+    id bigserial PRIMARY KEY,
+    ladder_id BIGINT NOT NULL REFERENCES ladders(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    stock_symbol TEXT NOT NULL,
+    side TEXT NOT NULL CHECK (side IN ('buy', 'sell')),
+    quantity NUMERIC NOT NULL CHECK (quantity > 0),
+    -- Fill price in the instrument's currency.
+    price NUMERIC NOT NULL,
+    currency TEXT NOT NULL,
+    -- Units of the ladder's base currency per unit of the instrument's currency.
+    fx_rate NUMERIC NOT NULL CHECK (fx_rate > 0),
+    -- Amount debited or credited, in the ladder's base currency.
+    amount NUMERIC NOT NULL,
+    executed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS ladder_fills_ladder_user_idx ON ladder_fills (ladder_id, user_id, executed_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS ladder_fills;
+ALTER TABLE ladders DROP COLUMN IF EXISTS base_currency;
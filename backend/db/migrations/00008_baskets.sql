@@ -0,0 +1,22 @@
+-- +goose Up
+-- Baskets are instruments priced from the quotes of their components. Each component is
+-- held in a fixed number of units, chosen at creation so that the basket starts at its base
+-- value with the components weighted as requested.
+CREATE TABLE IF NOT EXISTS baskets (
+    symbol TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    base_value NUMERIC NOT NULL CHECK (base_value > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS basket_components (
+    basket_symbol TEXT NOT NULL REFERENCES baskets(symbol) ON DELETE CASCADE,
+    component_symbol TEXT NOT NULL,
+    weight NUMERIC NOT NULL CHECK (weight > 0),
+    units NUMERIC NOT NULL CHECK (units > 0),
+    PRIMARY KEY (basket_symbol, component_symbol)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS basket_components;
+DROP TABLE IF EXISTS baskets;
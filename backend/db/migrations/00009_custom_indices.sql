@@ -0,0 +1,25 @@
+-- +goose Up
+-- Custom indices are baskets published by players. Their weights are fixed at creation and
+-- restored on the chosen schedule, and other players can follow them.
+ALTER TABLE baskets
+    ADD COLUMN IF NOT EXISTS owner_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS rebalance TEXT NOT NULL DEFAULT 'none'
+        CHECK (rebalance IN ('none', 'weekly', 'monthly', 'quarterly')),
+    ADD COLUMN IF NOT EXISTS rebalanced_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+CREATE INDEX IF NOT EXISTS baskets_owner_id_idx ON baskets (owner_id);
+
+CREATE TABLE IF NOT EXISTS basket_followers (
+    basket_symbol TEXT NOT NULL REFERENCES baskets(symbol) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (basket_symbol, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS basket_followers;
+DROP INDEX IF EXISTS baskets_owner_id_idx;
+ALTER TABLE baskets
+    DROP COLUMN IF EXISTS rebalanced_at,
+    DROP COLUMN IF EXISTS rebalance,
+    DROP COLUMN IF EXISTS owner_id;
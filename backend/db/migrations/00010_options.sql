@@ -0,0 +1,23 @@
+-- +goose Up
+-- Option contracts are listed per ladder on its underlyings. They are cash-settled at their
+-- intrinsic value when they expire, using the underlying's recorded price at expiry.
+CREATE TABLE IF NOT EXISTS option_contracts (
+    symbol TEXT PRIMARY KEY,
+    ladder_id BIGINT NOT NULL REFERENCES ladders(id) ON DELETE CASCADE,
+    underlying_symbol TEXT NOT NULL,
+    option_type TEXT NOT NULL CHECK (option_type IN ('call', 'put')),
+    strike NUMERIC NOT NULL CHECK (strike > 0),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    -- Annualized volatility the premium is priced with; NULL uses the configured or
+    -- historical volatility of the underlying.
+    volatility NUMERIC CHECK (volatility > 0),
+    settlement_price NUMERIC,
+    settled_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS option_contracts_ladder_id_idx ON option_contracts (ladder_id, expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS option_contracts_ladder_id_idx;
+DROP TABLE IF EXISTS option_contracts;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- Prediction markets ask whether an instrument of a ladder closes above a threshold at a
+-- given time. Players stake cash on YES or NO against a logarithmic market scoring rule
+-- market maker, and every winning share pays out one unit of the ladder's base currency.
+CREATE TABLE IF NOT EXISTS prediction_markets (
+    id BIGSERIAL PRIMARY KEY,
+    ladder_id BIGINT NOT NULL REFERENCES ladders(id) ON DELETE CASCADE,
+    symbol TEXT NOT NULL,
+    question TEXT NOT NULL,
+    threshold NUMERIC NOT NULL CHECK (threshold > 0),
+    resolves_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    -- Liquidity parameter b of the market maker; its loss is bounded by b * ln(2).
+    liquidity NUMERIC NOT NULL CHECK (liquidity > 0),
+    yes_shares NUMERIC NOT NULL DEFAULT 0,
+    no_shares NUMERIC NOT NULL DEFAULT 0,
+    outcome TEXT CHECK (outcome IN ('yes', 'no')),
+    resolution_price NUMERIC,
+    resolved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS prediction_markets_ladder_id_idx ON prediction_markets (ladder_id, resolves_at);
+
+CREATE TABLE IF NOT EXISTS prediction_positions (
+    market_id BIGINT NOT NULL REFERENCES prediction_markets(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    yes_shares NUMERIC NOT NULL DEFAULT 0,
+    no_shares NUMERIC NOT NULL DEFAULT 0,
+    staked NUMERIC NOT NULL DEFAULT 0,
+    PRIMARY KEY (market_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS prediction_positions;
+DROP INDEX IF EXISTS prediction_markets_ladder_id_idx;
+DROP TABLE IF EXISTS prediction_markets;
@@ -0,0 +1,12 @@
+-- name: GetBackfillCheckpoint :one
+SELECT loaded_until
+FROM backfill_checkpoints
+WHERE symbol = $1 AND resolution = $2 AND range_start = $3;
+
+-- name: UpsertBackfillCheckpoint :exec
+INSERT INTO backfill_checkpoints (symbol, resolution, range_start, source, loaded_until, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (symbol, resolution, range_start) DO UPDATE SET
+    source = EXCLUDED.source,
+    loaded_until = EXCLUDED.loaded_until,
+    updated_at = NOW();
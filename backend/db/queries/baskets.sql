@@ -0,0 +1,93 @@
+-- name: InsertBasket :execrows
+INSERT INTO baskets (symbol, name, base_value, owner_id, rebalance)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (symbol) DO NOTHING;
+
+-- name: InsertBasketComponent :exec
+INSERT INTO basket_components (basket_symbol, component_symbol, weight, units)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListBaskets :many
+SELECT b.symbol, b.name, b.base_value, b.owner_id, COALESCE(u.username, '')::text AS owner_username,
+       b.rebalance, b.rebalanced_at, b.created_at,
+       (SELECT COUNT(*) FROM basket_followers f WHERE f.basket_symbol = b.symbol) AS follower_count
+FROM baskets b
+LEFT JOIN users u ON u.id = b.owner_id
+ORDER BY b.symbol;
+
+-- name: ListIndices :many
+SELECT b.symbol, b.name, b.base_value, b.owner_id, COALESCE(u.username, '')::text AS owner_username,
+       b.rebalance, b.rebalanced_at, b.created_at,
+       (SELECT COUNT(*) FROM basket_followers f WHERE f.basket_symbol = b.symbol) AS follower_count
+FROM baskets b
+LEFT JOIN users u ON u.id = b.owner_id
+WHERE b.owner_id IS NOT NULL
+ORDER BY b.symbol
+LIMIT $1 OFFSET $2;
+
+-- name: GetBasket :one
+SELECT b.symbol, b.name, b.base_value, b.owner_id, COALESCE(u.username, '')::text AS owner_username,
+       b.rebalance, b.rebalanced_at, b.created_at,
+       (SELECT COUNT(*) FROM basket_followers f WHERE f.basket_symbol = b.symbol) AS follower_count
+FROM baskets b
+LEFT JOIN users u ON u.id = b.owner_id
+WHERE b.symbol = $1;
+
+-- name: GetBasketComponents :many
+SELECT component_symbol, weight, units
+FROM basket_components
+WHERE basket_symbol = $1
+ORDER BY component_symbol;
+
+-- name: ListBasketComponents :many
+SELECT basket_symbol, component_symbol, weight, units
+FROM basket_components
+ORDER BY basket_symbol, component_symbol;
+
+-- name: ListComponentsOfBaskets :many
+SELECT basket_symbol, component_symbol, weight, units
+FROM basket_components
+WHERE basket_symbol = ANY(@symbols::text[])
+ORDER BY basket_symbol, component_symbol;
+
+-- name: ListInstrumentCurrencies :many
+SELECT symbol, currency
+FROM instruments
+WHERE symbol = ANY(@symbols::text[]);
+
+-- name: IsTickerInLadder :one
+SELECT EXISTS (SELECT 1 FROM ladder_tickers WHERE stock_symbol = $1);
+
+-- name: DeleteBasket :execrows
+DELETE FROM baskets
+WHERE symbol = $1;
+
+-- name: CountBasketsByOwner :one
+SELECT COUNT(*) FROM baskets
+WHERE owner_id = $1;
+
+-- name: UpdateBasketComponentUnits :exec
+UPDATE basket_components
+SET units = $3
+WHERE basket_symbol = $1 AND component_symbol = $2;
+
+-- name: SetBasketRebalancedAt :exec
+UPDATE baskets
+SET rebalanced_at = $2
+WHERE symbol = $1;
+
+-- name: FollowBasket :exec
+INSERT INTO basket_followers (basket_symbol, user_id)
+VALUES ($1, $2)
+ON CONFLICT (basket_symbol, user_id) DO NOTHING;
+
+-- name: UnfollowBasket :exec
+DELETE FROM basket_followers
+WHERE basket_symbol = $1 AND user_id = $2;
+
+-- name: ListBasketFollowers :many
+SELECT u.username
+FROM basket_followers f
+JOIN users u ON u.id = f.user_id
+WHERE f.basket_symbol = $1
+ORDER BY f.followed_at;
@@ -0,0 +1,3 @@
+-- name: InsertLadderFill :exec
+INSERT INTO ladder_fills (ladder_id, user_id, stock_symbol, side, quantity, price, currency, fx_rate, amount, executed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
@@ -0,0 +1,45 @@
+-- name: UpsertInstrument :exec
+INSERT INTO instruments (symbol, source, name, asset_class, currency, exchange, tick_size, pip_size, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+ON CONFLICT (symbol) DO UPDATE
+SET source = EXCLUDED.source,
+    name = EXCLUDED.name,
+    asset_class = EXCLUDED.asset_class,
+    currency = EXCLUDED.currency,
+    exchange = EXCLUDED.exchange,
+    tick_size = EXCLUDED.tick_size,
+    pip_size = EXCLUDED.pip_size,
+    updated_at = NOW();
+
+-- name: DeleteInstrument :exec
+DELETE FROM instruments
+WHERE symbol = $1;
+
+-- name: SearchInstruments :many
+-- Prefix matches on symbol or name rank first, then trigram similarity catches typos.
+-- The pattern is a LIKE prefix pattern with wildcards in the query already escaped.
+-- Symbols are instrument IDs; the query matches them with or without the source prefix.
+SELECT symbol, source, name, asset_class, currency, exchange, tick_size, pip_size
+FROM instruments
+WHERE symbol ILIKE @pattern::text
+   OR symbol ILIKE '%:' || @pattern::text
+   OR name ILIKE @pattern::text
+   OR similarity(symbol, @query::text) > 0.3
+   OR similarity(name, @query::text) > 0.3
+ORDER BY lower(substr(symbol, strpos(symbol, ':') + 1)) = lower(@query::text) OR lower(symbol) = lower(@query::text) DESC,
+         symbol ILIKE @pattern::text OR symbol ILIKE '%:' || @pattern::text DESC,
+         name ILIKE @pattern::text DESC,
+         greatest(similarity(symbol, @query::text), similarity(name, @query::text)) DESC,
+         symbol
+LIMIT @max_results;
+
+-- name: ListInstruments :many
+SELECT symbol, source, name, asset_class, currency, exchange, tick_size, pip_size
+FROM instruments
+ORDER BY symbol
+LIMIT @max_results;
+
+-- name: ListTrackedTickers :many
+SELECT DISTINCT stock_symbol, source
+FROM ladder_tickers
+ORDER BY stock_symbol;
@@ -1,40 +1,47 @@
 -- name: CreateLadder :one
-INSERT INTO ladders (name, type, start_time, end_time, initial_balance, is_active)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, name, type, start_time, end_time, initial_balance, is_active, created_at;
+INSERT INTO ladders (name, type, start_time, end_time, initial_balance, base_currency, is_active)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at;
 
 -- name: GetActiveLadder :one
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 WHERE is_active = TRUE
 ORDER BY start_time DESC
 LIMIT 1;
 
 -- name: GetExpiredActiveLadders :many
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 WHERE is_active = TRUE AND end_time <= $1;
 
 -- name: GetPendingLaddersToActivate :many
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 WHERE is_active = FALSE AND start_time <= $1 AND end_time > $1;
 
 
 -- name: GetLadder :one
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 WHERE id = $1;
 
 -- name: ListLadders :many
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 ORDER BY start_time DESC;
 
 -- name: GetLadderTickers :many
-SELECT stock_symbol, source
-FROM ladder_tickers
-WHERE ladder_id = $1;
+SELECT lt.stock_symbol, lt.source,
+       COALESCE(i.name, '')::text AS name,
+       COALESCE(i.asset_class, '')::text AS asset_class,
+       COALESCE(i.currency, '')::text AS currency,
+       COALESCE(i.exchange, '')::text AS exchange,
+       COALESCE(i.tick_size, 0)::numeric AS tick_size,
+       COALESCE(i.pip_size, 0)::numeric AS pip_size
+FROM ladder_tickers lt
+LEFT JOIN instruments i ON i.symbol = lt.stock_symbol
+WHERE lt.ladder_id = $1;
 
 -- name: AddLadderTicker :exec
 INSERT INTO ladder_tickers (ladder_id, stock_symbol, source)
@@ -124,8 +131,23 @@ WHERE ladder_id = $1 AND user_id = $2 AND stock_symbol = $3;
 DELETE FROM ladder_portfolio_items
 WHERE ladder_id = $1;
 
+-- name: GetLadderHeldSymbols :many
+SELECT DISTINCT stock_symbol
+FROM ladder_portfolio_items
+WHERE ladder_id = $1 AND quantity > 0;
+
 -- name: PruneLadderParticipants :exec
 DELETE FROM ladder_participants
 WHERE ladder_id = $1 AND final_rank > $2;
 
 
+
+-- name: GetLadderBasketComponents :many
+SELECT DISTINCT bc.component_symbol,
+       COALESCE(i.tick_size, 0)::numeric AS tick_size,
+       COALESCE(i.pip_size, 0)::numeric AS pip_size
+FROM ladder_tickers lt
+JOIN basket_components bc ON bc.basket_symbol = lt.stock_symbol
+LEFT JOIN instruments i ON i.symbol = bc.component_symbol
+WHERE lt.ladder_id = $1
+ORDER BY bc.component_symbol;
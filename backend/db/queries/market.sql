@@ -11,3 +11,16 @@ WITH latest_quotes AS (
     LIMIT $2
 )
 SELECT * FROM latest_quotes ORDER BY created_at ASC;
+
+-- name: GetLatestQuotes :many
+SELECT DISTINCT ON (symbol) symbol, price, source, created_at
+FROM market_quotes
+WHERE symbol = ANY(@symbols::text[])
+ORDER BY symbol, created_at DESC;
+
+-- name: GetQuoteAt :one
+SELECT symbol, price, source, created_at
+FROM market_quotes
+WHERE symbol = $1 AND created_at <= $2
+ORDER BY created_at DESC
+LIMIT 1;
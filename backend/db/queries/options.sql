@@ -0,0 +1,39 @@
+-- name: InsertOptionContract :execrows
+INSERT INTO option_contracts (symbol, ladder_id, underlying_symbol, option_type, strike, expires_at, volatility)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (symbol) DO NOTHING;
+
+-- name: ListOptionContracts :many
+SELECT *
+FROM option_contracts
+WHERE ladder_id = $1
+ORDER BY underlying_symbol, expires_at, option_type, strike;
+
+-- name: GetOptionContract :one
+SELECT *
+FROM option_contracts
+WHERE symbol = $1;
+
+-- name: ListExpiredOptionContracts :many
+SELECT *
+FROM option_contracts
+WHERE settled_at IS NULL AND expires_at <= $1
+ORDER BY expires_at;
+
+-- name: CreditOptionSettlement :exec
+UPDATE ladder_participants p
+SET balance = p.balance + i.quantity * @payout::numeric
+FROM ladder_portfolio_items i
+WHERE i.ladder_id = p.ladder_id
+  AND i.user_id = p.user_id
+  AND i.ladder_id = @ladder_id
+  AND i.stock_symbol = @symbol;
+
+-- name: DeleteOptionHoldings :exec
+DELETE FROM ladder_portfolio_items
+WHERE ladder_id = $1 AND stock_symbol = $2;
+
+-- name: MarkOptionContractSettled :execrows
+UPDATE option_contracts
+SET settlement_price = $2, settled_at = $3
+WHERE symbol = $1 AND settled_at IS NULL;
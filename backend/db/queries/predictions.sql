@@ -0,0 +1,63 @@
+-- name: InsertPredictionMarket :one
+INSERT INTO prediction_markets (ladder_id, symbol, question, threshold, resolves_at, liquidity)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at;
+
+-- name: ListPredictionMarkets :many
+SELECT *
+FROM prediction_markets
+WHERE ladder_id = $1
+ORDER BY resolves_at, id;
+
+-- name: GetPredictionMarketForUpdate :one
+SELECT *
+FROM prediction_markets
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdatePredictionMarketShares :exec
+UPDATE prediction_markets
+SET yes_shares = $2, no_shares = $3
+WHERE id = $1;
+
+-- name: AddPredictionPosition :one
+INSERT INTO prediction_positions (market_id, user_id, yes_shares, no_shares, staked)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (market_id, user_id) DO UPDATE
+SET yes_shares = prediction_positions.yes_shares + EXCLUDED.yes_shares,
+    no_shares = prediction_positions.no_shares + EXCLUDED.no_shares,
+    staked = prediction_positions.staked + EXCLUDED.staked
+RETURNING *;
+
+-- name: ListPredictionPositions :many
+SELECT p.*
+FROM prediction_positions p
+JOIN prediction_markets m ON m.id = p.market_id
+WHERE m.ladder_id = $1 AND p.user_id = $2
+ORDER BY m.resolves_at, m.id;
+
+-- name: ListOpenPredictionPositions :many
+SELECT p.*
+FROM prediction_positions p
+JOIN prediction_markets m ON m.id = p.market_id
+WHERE m.ladder_id = $1 AND m.outcome IS NULL
+ORDER BY p.user_id, p.market_id;
+
+-- name: ListDuePredictionMarkets :many
+SELECT *
+FROM prediction_markets
+WHERE outcome IS NULL AND resolves_at <= $1
+ORDER BY resolves_at;
+
+-- name: MarkPredictionMarketResolved :execrows
+UPDATE prediction_markets
+SET outcome = $2, resolution_price = $3, resolved_at = $4
+WHERE id = $1 AND outcome IS NULL;
+
+-- name: CreditPredictionPayouts :exec
+UPDATE ladder_participants lp
+SET balance = lp.balance + CASE WHEN @outcome::text = 'yes' THEN p.yes_shares ELSE p.no_shares END
+FROM prediction_positions p
+WHERE p.user_id = lp.user_id
+  AND p.market_id = @market_id
+  AND lp.ladder_id = @ladder_id;
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/mem"
+)
+
+// encodedMessage is a message already in protobuf wire format.
+type encodedMessage []byte
+
+// codec is the protobuf codec of the gRPC server. Encoded messages, like live quotes as
+// published to Valkey, are sent as they are instead of being marshalled again per stream.
+type codec struct {
+	encoding.CodecV2
+}
+
+// Marshal returns encoded messages unchanged and marshals everything else with the
+// default protobuf codec.
+func (c codec) Marshal(v any) (mem.BufferSlice, error) {
+	if msg, ok := v.(encodedMessage); ok {
+		return mem.BufferSlice{mem.SliceBuffer(msg)}, nil
+	}
+
+	return c.CodecV2.Marshal(v)
+}
+
+// ServerCodec returns the server option installing the codec. Servers registering the
+// ExchangeService must use it.
+func ServerCodec() googlegrpc.ServerOption {
+	return googlegrpc.ForceServerCodecV2(codec{CodecV2: encoding.GetCodecV2(proto.Name)})
+}
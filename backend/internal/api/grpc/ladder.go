@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/api/handler"
+	"github.com/tmythicator/ticker-rush/backend/internal/api/middleware"
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/ladder/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// LadderServer implements the gRPC ladder service.
+type LadderServer struct {
+	ladder.UnimplementedLadderServiceServer
+
+	ladderService *service.Ladder
+}
+
+// NewLadderServer creates a new instance of LadderServer.
+func NewLadderServer(ladderService *service.Ladder) *LadderServer {
+	return &LadderServer{ladderService: ladderService}
+}
+
+// GetActiveLadder returns full metadata for the currently active ladder.
+func (s *LadderServer) GetActiveLadder(
+	ctx context.Context,
+	_ *ladder.GetActiveLadderRequest,
+) (*ladder.GetActiveLadderResponse, error) {
+	l, err := s.ladderService.GetActiveLadder(ctx)
+	if err != nil {
+		return nil, apperrors.ErrFailedToFetchActiveLadder
+	}
+
+	return &ladder.GetActiveLadderResponse{Ladder: handler.ToExternalLadder(l)}, nil
+}
+
+// JoinLadder enrolls the authenticated user in the active ladder.
+func (s *LadderServer) JoinLadder(
+	ctx context.Context,
+	_ *ladder.JoinLadderRequest,
+) (*ladder.JoinLadderResponse, error) {
+	userID, err := middleware.GetRequiredUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ladderService.JoinLadder(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ladder.JoinLadderResponse{}, nil
+}
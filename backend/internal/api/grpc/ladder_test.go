@@ -0,0 +1,49 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/ladder/v1"
+)
+
+func TestLadderServer(t *testing.T) {
+	env := setupTestEnv(t)
+	client := ladder.NewLadderServiceClient(env.conn)
+	ctx := context.Background()
+
+	t.Run("GetActiveLadderIsPublic", func(t *testing.T) {
+		env.ladderRepo.On("GetLadder", mock.Anything, int64(1)).Return(&domain.Ladder{ID: 1, Name: "Season 1"}, nil).Once()
+
+		resp, err := client.GetActiveLadder(ctx, &ladder.GetActiveLadderRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "Season 1", resp.GetLadder().GetName())
+	})
+
+	t.Run("JoinLadderRequiresAuth", func(t *testing.T) {
+		_, err := client.JoinLadder(ctx, &ladder.JoinLadderRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("JoinLadderMapsAppErrors", func(t *testing.T) {
+		env.ladderRepo.On("JoinLadder", mock.Anything, int64(1), int64(1)).Return(nil).Once()
+		env.ladderRepo.On("JoinLadder", mock.Anything, int64(1), int64(1)).Return(apperrors.ErrAlreadyJoinedLadder).Once()
+
+		_, err := client.JoinLadder(env.authContext(ctx), &ladder.JoinLadderRequest{})
+		require.NoError(t, err)
+
+		_, err = client.JoinLadder(env.authContext(ctx), &ladder.JoinLadderRequest{})
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, apperrors.ErrAlreadyJoinedLadder.Error(), st.Message())
+	})
+}
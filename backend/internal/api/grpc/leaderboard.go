@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/api/handler"
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/leaderboard/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// defaultLeaderboardLimit is the page size GetLeaderboard uses if the request sets no limit.
+const defaultLeaderboardLimit = 10
+
+// LeaderboardServer implements the gRPC leaderboard service.
+type LeaderboardServer struct {
+	leaderboard.UnimplementedLeaderBoardServiceServer
+
+	leadService *service.Leaderboard
+}
+
+// NewLeaderboardServer creates a new instance of LeaderboardServer.
+func NewLeaderboardServer(leadService *service.Leaderboard) *LeaderboardServer {
+	return &LeaderboardServer{leadService: leadService}
+}
+
+// GetLeaderboard returns a page of the active ladder's leaderboard.
+func (s *LeaderboardServer) GetLeaderboard(
+	ctx context.Context,
+	req *leaderboard.GetLeaderboardRequest,
+) (*leaderboard.GetLeaderboardResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	}
+
+	resp, err := s.leadService.GetLeaderboard(ctx, int(req.GetOffset()), limit)
+	if err != nil {
+		return nil, apperrors.ErrFailedToFetchLeaderboard
+	}
+
+	return handler.ToExternalLeaderboardResponse(resp), nil
+}
@@ -3,17 +3,36 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"log"
+	"slices"
 
-	"google.golang.org/grpc/codes"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/api/handler"
 	"github.com/tmythicator/ticker-rush/backend/internal/api/middleware"
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/ladder/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/leaderboard/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/user/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/service"
 )
 
+// PublicMethods are the RPCs served without authentication, like their public REST routes.
+var PublicMethods = []string{
+	ladder.LadderService_GetActiveLadder_FullMethodName,
+	leaderboard.LeaderBoardService_GetLeaderboard_FullMethodName,
+	user.UserService_CreateUser_FullMethodName,
+	user.UserService_Login_FullMethodName,
+	user.UserService_Logout_FullMethodName,
+	user.UserService_GetPublicProfile_FullMethodName,
+	exchange.ExchangeService_SearchInstruments_FullMethodName,
+}
+
 // ExchangeServer implements the gRPC exchange service.
 type ExchangeServer struct {
 	exchange.UnimplementedExchangeServiceServer
@@ -21,6 +40,7 @@ type ExchangeServer struct {
 	tradeService  *service.Trade
 	marketService *service.Market
 	userService   *service.User
+	instService   *service.Instrument
 }
 
 // NewExchangeServer creates a new instance of ExchangeServer.
@@ -28,11 +48,13 @@ func NewExchangeServer(
 	tradeService *service.Trade,
 	marketService *service.Market,
 	userService *service.User,
+	instService *service.Instrument,
 ) *ExchangeServer {
 	return &ExchangeServer{
 		tradeService:  tradeService,
 		marketService: marketService,
 		userService:   userService,
+		instService:   instService,
 	}
 }
 
@@ -48,12 +70,191 @@ func (s *ExchangeServer) GetQuote(
 
 	quote, err := s.marketService.GetQuote(ctx, req.GetSymbol())
 	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, apperrors.ErrMarketDataWarmingUp
+		}
+
 		return nil, err
 	}
 
 	return &exchange.GetQuoteResponse{Quote: handler.ToExternalQuote(quote)}, nil
 }
 
+// defaultHistoryLimit is the number of quotes GetHistory returns if the request sets no limit.
+const defaultHistoryLimit = 100
+
+// GetHistory retrieves historical quotes for a symbol.
+func (s *ExchangeServer) GetHistory(
+	ctx context.Context,
+	req *exchange.GetHistoryRequest,
+) (*exchange.GetHistoryResponse, error) {
+	_, err := middleware.GetRequiredUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetSymbol() == "" {
+		return nil, apperrors.ErrSymbolRequired
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+
+	history, err := s.marketService.GetHistory(ctx, req.GetSymbol(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	protoHistory := make([]*exchange.Quote, len(history))
+	for i, q := range history {
+		protoHistory[i] = handler.ToExternalQuote(q)
+	}
+
+	return &exchange.GetHistoryResponse{History: protoHistory}, nil
+}
+
+// GetIndicators computes technical indicators over a stock's stored quotes.
+func (s *ExchangeServer) GetIndicators(
+	ctx context.Context,
+	req *exchange.GetIndicatorsRequest,
+) (*exchange.GetIndicatorsResponse, error) {
+	_, err := middleware.GetRequiredUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetSymbol() == "" {
+		return nil, apperrors.ErrSymbolRequired
+	}
+
+	types, err := service.ParseIndicatorTypes(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	indicators, err := s.marketService.GetIndicators(ctx, req.GetSymbol(), types, int(req.GetPeriod()), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	protoIndicators := make([]*exchange.Indicator, len(indicators))
+	for i, ind := range indicators {
+		protoIndicators[i] = handler.ToExternalIndicator(ind)
+	}
+
+	return &exchange.GetIndicatorsResponse{Indicators: protoIndicators}, nil
+}
+
+// SearchInstruments searches the instrument catalogue.
+func (s *ExchangeServer) SearchInstruments(
+	ctx context.Context,
+	req *exchange.SearchInstrumentsRequest,
+) (*exchange.SearchInstrumentsResponse, error) {
+	instruments, err := s.instService.Search(ctx, req.GetQuery(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	protoInstruments := make([]*exchange.Instrument, len(instruments))
+	for i, inst := range instruments {
+		protoInstruments[i] = handler.ToExternalInstrument(inst)
+	}
+
+	return &exchange.SearchInstrumentsResponse{Instruments: protoInstruments}, nil
+}
+
+// StreamQuotes streams live quotes for the requested symbols, or for every ticker of the
+// active ladder if none are given. Like the SSE endpoint, it replays the quotes missed since
+// the "last-event-id" metadata, and a slow client loses its oldest buffered quotes rather
+// than holding up the shared feed. The stream ends with Unavailable when the server drains.
+func (s *ExchangeServer) StreamQuotes(
+	req *exchange.StreamQuotesRequest,
+	stream exchange.ExchangeService_StreamQuotesServer,
+) error {
+	ctx := stream.Context()
+
+	_, err := middleware.GetRequiredUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	symbols := slices.Clone(req.GetSymbols())
+	if req.GetSymbol() != "" {
+		symbols = append(symbols, req.GetSymbol())
+	}
+
+	sub, err := s.marketService.SubscribeToQuotes(ctx, symbols...)
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	// Last sent event ID per symbol, so that live quotes already replayed are not sent twice.
+	sent := make(map[string]string)
+	send := func(quote *domain.Quote) error {
+		if last, ok := sent[quote.Symbol]; ok && quote.EventID != "" && !domain.QuoteEventIDAfter(quote.EventID, last) {
+			return nil
+		}
+		// Send blocks while the client's flow control window is full; meanwhile the hub keeps
+		// only the newest quotes in the subscription's buffer. Live quotes are forwarded in
+		// their published encoding, shared by every stream.
+		var sendErr error
+		if quote.Payload != nil {
+			sendErr = stream.SendMsg(encodedMessage(quote.Payload))
+		} else {
+			sendErr = stream.Send(&exchange.StreamQuotesResponse{
+				Quote:   handler.ToExternalQuote(quote),
+				EventId: quote.EventID,
+			})
+		}
+		if sendErr != nil {
+			return sendErr
+		}
+		sent[quote.Symbol] = quote.EventID
+
+		return nil
+	}
+
+	if lastEventID := lastEventID(ctx); lastEventID != "" {
+		missed, missedErr := s.marketService.MissedQuotes(ctx, lastEventID, symbols...)
+		if missedErr != nil {
+			log.Printf("[StreamQuotes] Failed to replay quotes since %s: %v", lastEventID, missedErr)
+		}
+		for _, quote := range missed {
+			if sendErr := send(quote); sendErr != nil {
+				return sendErr
+			}
+		}
+	}
+
+	quotes := sub.Quotes()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case quote, ok := <-quotes:
+			if !ok {
+				// The hub is draining for shutdown; the client reconnects to another instance.
+				return apperrors.ErrShuttingDown
+			}
+			if sendErr := send(quote); sendErr != nil {
+				return sendErr
+			}
+		}
+	}
+}
+
+// lastEventID returns the event ID a reconnecting client last received, if any.
+func lastEventID(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, "last-event-id"); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
 // CreateTrade executes a buy or sell order.
 func (s *ExchangeServer) CreateTrade(
 	ctx context.Context,
@@ -70,7 +271,7 @@ func (s *ExchangeServer) CreateTrade(
 	case exchange.TradeAction_SELL:
 		_, err = s.tradeService.SellStock(ctx, userID, req.GetSymbol(), req.GetQuantity())
 	default:
-		return nil, status.Error(codes.InvalidArgument, "Invalid trade action")
+		return nil, apperrors.ErrInvalidTradeAction
 	}
 
 	if err != nil {
@@ -79,7 +280,7 @@ func (s *ExchangeServer) CreateTrade(
 
 	fullUser, err := s.userService.GetUserWithPortfolio(ctx, userID)
 	if err != nil {
-		return nil, err
+		return nil, apperrors.ErrInternalServiceError
 	}
 
 	return &exchange.CreateTradeResponse{
@@ -0,0 +1,288 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	grpcapi "github.com/tmythicator/ticker-rush/backend/internal/api/grpc"
+	"github.com/tmythicator/ticker-rush/backend/internal/api/middleware"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/ladder/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/user/v1"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+const testSecret = "test-secret"
+
+type testEnv struct {
+	conn        *googlegrpc.ClientConn
+	client      exchange.ExchangeServiceClient
+	marketRepo  *redisRepo.MarketRepository
+	historyRepo *mocks.MockHistoryRepository
+	ladderRepo  *mocks.MockLadderRepository
+	userRepo    *mocks.MockUserRepository
+	instRepo    *mocks.MockInstrumentRepository
+	quoteHub    *service.QuoteHub
+	token       string
+}
+
+func setupTestEnv(t *testing.T) *testEnv {
+	mr := miniredis.RunT(t)
+	valkeyClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = valkeyClient.Close() })
+
+	marketRepo := redisRepo.NewMarketRepository(valkeyClient)
+	historyRepo := new(mocks.MockHistoryRepository)
+	ladderRepo := new(mocks.MockLadderRepository)
+	ladderRepo.On("GetActiveLadder", mock.Anything).Return(int64(1), nil).Maybe()
+	ladderRepo.On("GetAllowedTickers", mock.Anything, int64(1)).Return([]*domain.TickerInfo{
+		{Symbol: "AAPL"},
+		{Symbol: "bitcoin"},
+	}, nil).Maybe()
+
+	quoteHub := service.NewQuoteHub(marketRepo, 0)
+	t.Cleanup(quoteHub.Close)
+	marketService := service.NewMarket(marketRepo, historyRepo, ladderRepo, quoteHub)
+	userRepo := new(mocks.MockUserRepository)
+	userService := service.NewUser(userRepo, new(mocks.MockPortfolioRepository), ladderRepo)
+	instRepo := new(mocks.MockInstrumentRepository)
+
+	listener := bufconn.Listen(1 << 20)
+	limiter := middleware.NewRateLimitter(redisRepo.NewRateLimitter(valkeyClient), 100, time.Minute)
+	server := googlegrpc.NewServer(
+		grpcapi.ServerCodec(),
+		googlegrpc.ChainUnaryInterceptor(
+			middleware.GrpcErrorInterceptor(),
+			limiter.GrpcInterceptor(testSecret, grpcapi.PublicMethods...),
+			middleware.GrpcAuthInterceptor(testSecret, grpcapi.PublicMethods...),
+		),
+		googlegrpc.ChainStreamInterceptor(
+			middleware.GrpcStreamErrorInterceptor(),
+			middleware.GrpcStreamAuthInterceptor(testSecret, grpcapi.PublicMethods...),
+		),
+	)
+	exchange.RegisterExchangeServiceServer(server, grpcapi.NewExchangeServer(nil, marketService, userService, service.NewInstrument(instRepo)))
+	ladder.RegisterLadderServiceServer(server, grpcapi.NewLadderServer(service.NewLadder(ladderRepo, service.NewFXRates(nil, 0))))
+	user.RegisterUserServiceServer(server, grpcapi.NewUserServer(userService, testSecret))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := googlegrpc.NewClient("passthrough:///bufnet",
+		googlegrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		googlegrpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	token, err := service.GenerateToken(&domain.User{ID: 1, Username: "bot"}, testSecret)
+	require.NoError(t, err)
+
+	return &testEnv{
+		conn:        conn,
+		client:      exchange.NewExchangeServiceClient(conn),
+		marketRepo:  marketRepo,
+		historyRepo: historyRepo,
+		ladderRepo:  ladderRepo,
+		userRepo:    userRepo,
+		instRepo:    instRepo,
+		quoteHub:    quoteHub,
+		token:       token,
+	}
+}
+
+func (env *testEnv) authContext(ctx context.Context, pairs ...string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, append([]string{"authorization", "Bearer " + env.token}, pairs...)...)
+}
+
+func (env *testEnv) saveQuote(t *testing.T, symbol string, price int64) {
+	t.Helper()
+
+	err := env.marketRepo.SaveQuote(context.Background(), &domain.Quote{
+		Symbol:    symbol,
+		Price:     decimal.NewFromInt(price),
+		Timestamp: time.Now(),
+	})
+	require.NoError(t, err)
+}
+
+func waitForSubscribers(t *testing.T, repo *redisRepo.MarketRepository, symbol string, n int64) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		counts, err := repo.CountSubscribers(context.Background(), []string{symbol})
+
+		return err == nil && counts[symbol] == n
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestExchangeServer_GetHistory(t *testing.T) {
+	env := setupTestEnv(t)
+	ctx := context.Background()
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		_, err := env.client.GetHistory(ctx, &exchange.GetHistoryRequest{Symbol: "AAPL"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("MissingSymbol", func(t *testing.T) {
+		_, err := env.client.GetHistory(env.authContext(ctx), &exchange.GetHistoryRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		env.historyRepo.On("GetHistory", mock.Anything, "AAPL", 100).Return([]*domain.Quote{
+			{Symbol: "AAPL", Price: decimal.NewFromInt(150), Timestamp: time.Now()},
+		}, nil).Once()
+
+		resp, err := env.client.GetHistory(env.authContext(ctx), &exchange.GetHistoryRequest{Symbol: "AAPL"})
+		require.NoError(t, err)
+		require.Len(t, resp.GetHistory(), 1)
+		assert.Equal(t, 150.0, resp.GetHistory()[0].GetPrice())
+		assert.Equal(t, "150.00", resp.GetHistory()[0].GetPriceDecimal())
+		env.historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("ExactPrice", func(t *testing.T) {
+		env.historyRepo.On("GetHistory", mock.Anything, "bitcoin", 1).Return([]*domain.Quote{
+			{Symbol: "bitcoin", Price: decimal.RequireFromString("0.00001235"), Timestamp: time.Now()},
+		}, nil).Once()
+
+		resp, err := env.client.GetHistory(env.authContext(ctx), &exchange.GetHistoryRequest{Symbol: "bitcoin", Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.GetHistory(), 1)
+		assert.Equal(t, "0.00001235", resp.GetHistory()[0].GetPriceDecimal())
+		assert.Equal(t, int32(8), resp.GetHistory()[0].GetPricePrecision())
+	})
+}
+
+func TestExchangeServer_GetIndicators(t *testing.T) {
+	env := setupTestEnv(t)
+	ctx := env.authContext(context.Background())
+
+	t.Run("InvalidType", func(t *testing.T) {
+		_, err := env.client.GetIndicators(ctx, &exchange.GetIndicatorsRequest{Symbol: "AAPL", Type: "vwap"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SMA", func(t *testing.T) {
+		now := time.Now()
+		quotes := []*domain.Quote{
+			{Symbol: "AAPL", Price: decimal.NewFromInt(10), Timestamp: now.Add(-time.Minute)},
+			{Symbol: "AAPL", Price: decimal.NewFromInt(20), Timestamp: now},
+		}
+		env.historyRepo.On("GetLatestQuotes", mock.Anything, []string{"AAPL"}).Return(quotes[1:], nil).Once()
+		env.historyRepo.On("GetHistory", mock.Anything, "AAPL", 100+1).Return(quotes, nil).Once()
+
+		resp, err := env.client.GetIndicators(ctx, &exchange.GetIndicatorsRequest{Symbol: "AAPL", Type: "sma", Period: 2})
+		require.NoError(t, err)
+		require.Len(t, resp.GetIndicators(), 1)
+		require.Len(t, resp.GetIndicators()[0].GetPoints(), 1)
+		assert.Equal(t, 15.0, resp.GetIndicators()[0].GetPoints()[0].GetValue())
+		env.historyRepo.AssertExpectations(t)
+	})
+}
+
+func TestExchangeServer_SearchInstruments(t *testing.T) {
+	env := setupTestEnv(t)
+
+	env.instRepo.On("SearchInstruments", mock.Anything, "app", 5).Return([]*domain.Instrument{
+		{Symbol: "AAPL", Name: "Apple Inc", AssetClass: domain.AssetClassStock, TickSize: decimal.New(1, -2)},
+	}, nil).Once()
+
+	// The catalogue is public, so no credentials are sent.
+	resp, err := env.client.SearchInstruments(context.Background(), &exchange.SearchInstrumentsRequest{Query: "app", Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, resp.GetInstruments(), 1)
+	assert.Equal(t, "Apple Inc", resp.GetInstruments()[0].GetName())
+	assert.Equal(t, 0.01, resp.GetInstruments()[0].GetTickSize())
+	env.instRepo.AssertExpectations(t)
+}
+
+func TestExchangeServer_StreamQuotes(t *testing.T) {
+	t.Run("Unauthenticated", func(t *testing.T) {
+		env := setupTestEnv(t)
+
+		stream, err := env.client.StreamQuotes(context.Background(), &exchange.StreamQuotesRequest{Symbol: "AAPL"})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("LiveAndReplay", func(t *testing.T) {
+		env := setupTestEnv(t)
+		ctx, cancel := context.WithCancel(env.authContext(context.Background()))
+		defer cancel()
+
+		stream, err := env.client.StreamQuotes(ctx, &exchange.StreamQuotesRequest{Symbols: []string{"AAPL", "bitcoin"}})
+		require.NoError(t, err)
+		waitForSubscribers(t, env.marketRepo, "bitcoin", 1)
+
+		env.saveQuote(t, "AAPL", 150)
+		first, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, "AAPL", first.GetQuote().GetSymbol())
+		assert.True(t, domain.IsQuoteEventID(first.GetEventId()))
+
+		// Quotes published after the client disconnects are replayed when it resumes.
+		cancel()
+		waitForSubscribers(t, env.marketRepo, "bitcoin", 0)
+		env.saveQuote(t, "bitcoin", 60000)
+		env.saveQuote(t, "AAPL", 151)
+
+		resumed, err := env.client.StreamQuotes(
+			env.authContext(context.Background(), "last-event-id", first.GetEventId()),
+			&exchange.StreamQuotesRequest{Symbols: []string{"AAPL", "bitcoin"}},
+		)
+		require.NoError(t, err)
+
+		for _, want := range []float64{60000, 151} {
+			resp, recvErr := resumed.Recv()
+			require.NoError(t, recvErr)
+			assert.Equal(t, want, resp.GetQuote().GetPrice())
+		}
+	})
+
+	t.Run("SymbolNotAllowed", func(t *testing.T) {
+		env := setupTestEnv(t)
+
+		stream, err := env.client.StreamQuotes(env.authContext(context.Background()), &exchange.StreamQuotesRequest{Symbol: "MSFT"})
+		require.NoError(t, err)
+
+		_, err = stream.Recv()
+		assert.Error(t, err)
+	})
+
+	t.Run("ShutdownEndsStream", func(t *testing.T) {
+		env := setupTestEnv(t)
+
+		stream, err := env.client.StreamQuotes(env.authContext(context.Background()), &exchange.StreamQuotesRequest{Symbol: "AAPL"})
+		require.NoError(t, err)
+		waitForSubscribers(t, env.marketRepo, "AAPL", 1)
+
+		env.quoteHub.Close()
+
+		_, err = stream.Recv()
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
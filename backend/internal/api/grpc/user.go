@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"context"
+
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/api/handler"
+	"github.com/tmythicator/ticker-rush/backend/internal/api/middleware"
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/user/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// UserServer implements the gRPC user service.
+type UserServer struct {
+	user.UnimplementedUserServiceServer
+
+	userService *service.User
+	jwtSecret   string
+}
+
+// NewUserServer creates a new instance of UserServer.
+func NewUserServer(userService *service.User, jwtSecret string) *UserServer {
+	return &UserServer{
+		userService: userService,
+		jwtSecret:   jwtSecret,
+	}
+}
+
+// CreateUser registers a new player account.
+func (s *UserServer) CreateUser(
+	ctx context.Context,
+	req *user.CreateUserRequest,
+) (*user.CreateUserResponse, error) {
+	createdUser, err := s.userService.CreateUser(
+		ctx,
+		req.GetUsername(),
+		req.GetPassword(),
+		req.GetFirstName(),
+		req.GetLastName(),
+		req.GetAgbAccepted(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fullUser, err := s.userService.GetUserWithPortfolio(ctx, createdUser.ID)
+	if err != nil {
+		return nil, apperrors.ErrFailedToFetchProfileAfterCreation
+	}
+
+	return &user.CreateUserResponse{User: handler.ToExternalUser(fullUser)}, nil
+}
+
+// Login authenticates a user. Instead of the REST API's cookie, the session token is sent
+// back in the "authorization" response header, ready to be passed on subsequent calls.
+func (s *UserServer) Login(
+	ctx context.Context,
+	req *user.LoginRequest,
+) (*user.LoginResponse, error) {
+	authenticatedUser, err := s.userService.Authenticate(ctx, req.GetUsername(), req.GetPassword())
+	if err != nil {
+		return nil, apperrors.ErrInvalidCredentials
+	}
+
+	fullUser, err := s.userService.GetUserWithPortfolio(ctx, authenticatedUser.ID)
+	if err != nil {
+		return nil, apperrors.ErrFailedToFetchProfile
+	}
+
+	token, err := service.GenerateToken(authenticatedUser, s.jwtSecret)
+	if err != nil {
+		return nil, apperrors.ErrFailedToGenerateToken
+	}
+
+	err = googlegrpc.SetHeader(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.LoginResponse{User: handler.ToExternalUser(fullUser)}, nil
+}
+
+// Logout ends a session. Tokens are stateless, so clients simply discard theirs.
+func (s *UserServer) Logout(
+	_ context.Context,
+	_ *user.LogoutRequest,
+) (*user.LogoutResponse, error) {
+	return &user.LogoutResponse{Message: "Logged out successfully"}, nil
+}
+
+// GetMe returns the authenticated user's profile and portfolio.
+func (s *UserServer) GetMe(
+	ctx context.Context,
+	_ *user.GetMeRequest,
+) (*user.GetMeResponse, error) {
+	userID, err := middleware.GetRequiredUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fullUser, err := s.userService.GetUserWithPortfolio(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternalServiceError
+	}
+
+	return &user.GetMeResponse{User: handler.ToExternalUser(fullUser)}, nil
+}
+
+// UpdateUser updates the fields of the authenticated user's profile named in the update mask.
+func (s *UserServer) UpdateUser(
+	ctx context.Context,
+	req *user.UpdateUserRequest,
+) (*user.UpdateUserResponse, error) {
+	userID, err := middleware.GetRequiredUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedUser, err := s.userService.UpdateUser(
+		ctx,
+		userID,
+		req.GetFirstName(),
+		req.GetLastName(),
+		req.GetWebsite(),
+		req.GetIsPublic(),
+		handler.UpdateUserPaths(req.GetUpdateMask()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.UpdateUserResponse{User: handler.ToExternalUser(updatedUser)}, nil
+}
+
+// DeleteUser anonymizes the authenticated user's profile.
+func (s *UserServer) DeleteUser(
+	ctx context.Context,
+	_ *user.DeleteUserRequest,
+) (*user.DeleteUserResponse, error) {
+	userID, err := middleware.GetRequiredUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.userService.AnonymizeUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.DeleteUserResponse{}, nil
+}
+
+// GetPublicProfile returns a user's public profile.
+func (s *UserServer) GetPublicProfile(
+	ctx context.Context,
+	req *user.GetPublicProfileRequest,
+) (*user.GetPublicProfileResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, apperrors.ErrUsernameRequired
+	}
+
+	publicProfile, err := s.userService.GetPublicProfile(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.GetPublicProfileResponse{Profile: handler.ToExternalPublicProfile(publicProfile)}, nil
+}
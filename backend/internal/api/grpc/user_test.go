@@ -0,0 +1,81 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	googlegrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/user/v1"
+)
+
+func TestUserServer(t *testing.T) {
+	env := setupTestEnv(t)
+	client := user.NewUserServiceClient(env.conn)
+	ctx := context.Background()
+
+	bot := &domain.User{ID: 7, Username: "bot", FirstName: "Trading", LastName: "Bot"}
+
+	t.Run("LoginReturnsTokenHeader", func(t *testing.T) {
+		hash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		require.NoError(t, err)
+		env.userRepo.On("GetUserByUsername", mock.Anything, "bot").Return(bot, string(hash), nil).Once()
+		env.userRepo.On("GetUserWithPortfolioForActiveLadder", mock.Anything, int64(7)).Return(bot, nil).Twice()
+
+		var header metadata.MD
+		resp, err := client.Login(ctx, &user.LoginRequest{Username: "bot", Password: "password123"}, googlegrpc.Header(&header))
+		require.NoError(t, err)
+		assert.Equal(t, "bot", resp.GetUser().GetUsername())
+		require.Len(t, header.Get("authorization"), 1)
+
+		// The returned token authenticates subsequent calls.
+		authCtx := metadata.AppendToOutgoingContext(ctx, "authorization", header.Get("authorization")[0])
+		me, err := client.GetMe(authCtx, &user.GetMeRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, "Trading", me.GetUser().GetFirstName())
+	})
+
+	t.Run("LoginWithWrongPassword", func(t *testing.T) {
+		env.userRepo.On("GetUserByUsername", mock.Anything, "bot").Return(nil, "", errors.New("no rows")).Once()
+
+		_, err := client.Login(ctx, &user.LoginRequest{Username: "bot", Password: "wrong"})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("GetMeRequiresAuth", func(t *testing.T) {
+		_, err := client.GetMe(ctx, &user.GetMeRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("ValidationErrorsCarryFieldViolations", func(t *testing.T) {
+		_, err := client.CreateUser(ctx, &user.CreateUserRequest{Username: "newbot", Password: "short", FirstName: "New", LastName: "Bot", AgbAccepted: true})
+		st, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+		var violations []string
+		for _, detail := range st.Details() {
+			if badRequest, isBadRequest := detail.(*errdetails.BadRequest); isBadRequest {
+				for _, v := range badRequest.GetFieldViolations() {
+					violations = append(violations, v.GetField())
+				}
+			}
+		}
+		assert.Equal(t, []string{"password"}, violations)
+	})
+
+	t.Run("GetPublicProfileIsPublic", func(t *testing.T) {
+		_, err := client.GetPublicProfile(ctx, &user.GetPublicProfileRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
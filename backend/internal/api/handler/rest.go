@@ -2,24 +2,28 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/gin-contrib/sse"
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/api/middleware"
 	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/ladder/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/user/v1"
-	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
 	"github.com/tmythicator/ticker-rush/backend/internal/service"
 )
 
@@ -30,6 +34,11 @@ type RestHandler struct {
 	marketService *service.Market
 	leadService   *service.Leaderboard
 	ladderService *service.Ladder
+	quarService   *service.Quarantine
+	instService   *service.Instrument
+	basketService *service.Basket
+	optService    *service.Options
+	predService   *service.Predictions
 	jwtSecret     string
 }
 
@@ -40,6 +49,11 @@ func NewRestHandler(
 	marketService *service.Market,
 	leadService *service.Leaderboard,
 	ladderService *service.Ladder,
+	quarService *service.Quarantine,
+	instService *service.Instrument,
+	basketService *service.Basket,
+	optService *service.Options,
+	predService *service.Predictions,
 	jwtSecret string,
 ) *RestHandler {
 	return &RestHandler{
@@ -48,6 +62,11 @@ func NewRestHandler(
 		marketService: marketService,
 		leadService:   leadService,
 		ladderService: ladderService,
+		quarService:   quarService,
+		instService:   instService,
+		basketService: basketService,
+		optService:    optService,
+		predService:   predService,
 		jwtSecret:     jwtSecret,
 	}
 }
@@ -148,22 +167,6 @@ func (h *RestHandler) UpdateUser(c *gin.Context) {
 		return
 	}
 
-	var paths []string
-	if req.UpdateMask != nil {
-		for _, p := range req.UpdateMask.Paths {
-			switch p {
-			case "firstName", "first_name":
-				paths = append(paths, "first_name")
-			case "lastName", "last_name":
-				paths = append(paths, "last_name")
-			case "website":
-				paths = append(paths, "website")
-			case "isPublic", "is_public":
-				paths = append(paths, "is_public")
-			}
-		}
-	}
-
 	updatedUser, err := h.userService.UpdateUser(
 		c.Request.Context(),
 		userID,
@@ -171,7 +174,7 @@ func (h *RestHandler) UpdateUser(c *gin.Context) {
 		req.LastName,
 		req.Website,
 		req.IsPublic,
-		paths,
+		UpdateUserPaths(req.UpdateMask),
 	)
 
 	if err != nil {
@@ -288,19 +291,7 @@ func (h *RestHandler) CreateTrade(c *gin.Context) {
 		return
 	}
 
-	var err error
-	switch req.Action {
-	case exchange.TradeAction_BUY:
-		_, err = h.tradeService.BuyStock(c.Request.Context(), userID, req.Symbol, req.Quantity)
-	case exchange.TradeAction_SELL:
-		_, err = h.tradeService.SellStock(c.Request.Context(), userID, req.Symbol, req.Quantity)
-	default:
-		status, errType, detail := apperrors.MatchError(apperrors.ErrInvalidTradeAction)
-		RespondWithProblem(c, status, errType, detail, nil)
-
-		return
-	}
-
+	resp, err := h.executeTrade(c.Request.Context(), userID, &req)
 	if err != nil {
 		status, errType, detail := apperrors.MatchError(err)
 		invalidParams := apperrors.ValidationErrorParams(err)
@@ -309,19 +300,34 @@ func (h *RestHandler) CreateTrade(c *gin.Context) {
 		return
 	}
 
-	fullUser, err := h.userService.GetUserWithPortfolio(c.Request.Context(), userID)
+	c.JSON(http.StatusOK, resp)
+}
+
+// executeTrade performs a trade for the user and returns their updated standing.
+func (h *RestHandler) executeTrade(ctx context.Context, userID int64, req *exchange.CreateTradeRequest) (*exchange.CreateTradeResponse, error) {
+	var err error
+	switch req.GetAction() {
+	case exchange.TradeAction_BUY:
+		_, err = h.tradeService.BuyStock(ctx, userID, req.GetSymbol(), req.GetQuantity())
+	case exchange.TradeAction_SELL:
+		_, err = h.tradeService.SellStock(ctx, userID, req.GetSymbol(), req.GetQuantity())
+	default:
+		return nil, apperrors.ErrInvalidTradeAction
+	}
 	if err != nil {
-		status, errType, detail := apperrors.MatchError(apperrors.ErrInternalServiceError)
-		RespondWithProblem(c, status, errType, detail, nil)
+		return nil, err
+	}
 
-		return
+	fullUser, err := h.userService.GetUserWithPortfolio(ctx, userID)
+	if err != nil {
+		return nil, apperrors.ErrInternalServiceError
 	}
 
-	c.JSON(http.StatusOK, &exchange.CreateTradeResponse{
+	return &exchange.CreateTradeResponse{
 		Participant: &ladder.LadderParticipant{
 			User: ToExternalPublicProfile(fullUser),
 		},
-	})
+	}, nil
 }
 
 // GetLeaderboard handles leaderboard fetching requests.
@@ -362,65 +368,99 @@ func (h *RestHandler) StreamQuotes(c *gin.Context) {
 	c.Header("Connection", "keep-alive")
 	c.Header("Transfer-Encoding", "chunked")
 
-	symbol := c.Query("symbol")
-	log.Printf("[StreamQuotes] Starting stream for symbol: %s", symbol)
+	symbols := streamSymbols(c)
+	log.Printf("[StreamQuotes] Starting stream for symbols: %v", symbols)
 
 	clientGone := c.Writer.CloseNotify()
 
-	pubsub, err := h.marketService.SubscribeToQuotes(c.Request.Context(), symbol)
+	sub, err := h.marketService.SubscribeToQuotes(c.Request.Context(), symbols...)
 	if err != nil {
 		log.Printf("[StreamQuotes] Failed to subscribe: %v", err)
+		if errors.Is(err, apperrors.ErrShuttingDown) {
+			status, errType, detail := apperrors.MatchError(err)
+			RespondWithProblem(c, status, errType, detail, nil)
+
+			return
+		}
 		RespondWithProblem(c, http.StatusBadRequest, apperrors.TypeValidation, err.Error(), nil)
 
 		return
 	}
+	defer sub.Close()
+
+	// Last sent event ID per symbol, so that live quotes already replayed are not sent twice.
+	sent := make(map[string]string)
+	send := func(quote *domain.Quote) {
+		if last, ok := sent[quote.Symbol]; ok && quote.EventID != "" && !domain.QuoteEventIDAfter(quote.EventID, last) {
+			return
+		}
+		payloadBytes, marshalErr := json.Marshal(ToExternalQuote(quote))
+		if marshalErr != nil {
+			log.Printf("[StreamQuotes] Failed to marshal quote: %v", marshalErr)
 
-	defer func() {
-		log.Printf("[StreamQuotes] Closing pubsub for symbol: %s", symbol)
-		err := pubsub.Close()
-		if err != nil {
-			log.Printf("Failed to close pubsub: %v", err)
+			return
+		}
+		c.Render(-1, sse.Event{Id: quote.EventID, Event: "quote", Data: string(payloadBytes)})
+		// Flush the response to ensure it's sent immediately
+		c.Writer.Flush()
+		sent[quote.Symbol] = quote.EventID
+	}
+
+	// A reconnecting EventSource resends the ID of the last event it saw. The live subscription
+	// is already open, so nothing published while replaying is lost.
+	if lastEventID := c.GetHeader("Last-Event-ID"); lastEventID != "" {
+		missed, missedErr := h.marketService.MissedQuotes(c.Request.Context(), lastEventID, symbols...)
+		if missedErr != nil {
+			log.Printf("[StreamQuotes] Failed to replay quotes since %s: %v", lastEventID, missedErr)
 		}
-	}()
+		for _, quote := range missed {
+			send(quote)
+		}
+	}
 
-	ch := pubsub.Channel()
+	quotes := sub.Quotes()
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 
 	c.Stream(func(w io.Writer) bool {
 		select {
 		case <-clientGone:
-			log.Printf("[StreamQuotes] Client gone regarding symbol: %s", symbol)
+			log.Printf("[StreamQuotes] Client gone regarding symbols: %v", symbols)
 
 			return false
 		case <-ticker.C:
 			h.SendHeartbeat(w)
 
 			return true
-		case msg := <-ch:
-			var vq redisRepo.ValkeyQuote
-			if err := json.Unmarshal([]byte(msg.Payload), &vq); err == nil {
-				eq := ToExternalQuoteFromValkey(&vq)
-				if payloadBytes, marshalErr := json.Marshal(eq); marshalErr == nil {
-					c.SSEvent("quote", string(payloadBytes))
-				} else {
-					log.Printf("[StreamQuotes] Failed to marshal quote: %v", marshalErr)
-					c.SSEvent("quote", msg.Payload)
-				}
-			} else {
-				log.Printf("[StreamQuotes] Failed to unmarshal message: %v", err)
-				c.SSEvent("quote", msg.Payload)
-			}
-			// Flush the response to ensure it's sent immediately
-			if f, ok := w.(http.Flusher); ok {
-				f.Flush()
+		case quote, ok := <-quotes:
+			if !ok {
+				// The hub is draining for shutdown; the client reconnects to another instance.
+				return false
 			}
+			send(quote)
 
 			return true
 		}
 	})
 }
 
+// streamSymbols collects the symbols to stream from the legacy "symbol" parameter and
+// "symbols", which may be repeated or comma-separated. No symbols means all tickers.
+func streamSymbols(c *gin.Context) []string {
+	params := append(c.QueryArray("symbols"), c.Query("symbol"))
+
+	var symbols []string
+	for _, param := range params {
+		for symbol := range strings.SplitSeq(param, ",") {
+			if symbol = strings.TrimSpace(symbol); symbol != "" {
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+
+	return symbols
+}
+
 // SendHeartbeat keeps the connection alive by sending a comment.
 func (h *RestHandler) SendHeartbeat(w io.Writer) {
 	_, _ = w.Write([]byte(": keep-alive\n\n"))
@@ -465,6 +505,81 @@ func (h *RestHandler) GetHistory(c *gin.Context) {
 	})
 }
 
+// GetIndicators handles requests for technical indicators over stored history.
+func (h *RestHandler) GetIndicators(c *gin.Context) {
+	types, err := service.ParseIndicatorTypes(c.Query("type"))
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, apperrors.ValidationErrorParams(err))
+
+		return
+	}
+
+	period := 0
+	if p := c.Query("period"); p != "" {
+		val, convErr := strconv.Atoi(p)
+		if convErr != nil {
+			status, errType, detail := apperrors.MatchError(apperrors.ErrInvalidIndicatorPeriod)
+			RespondWithProblem(c, status, errType, detail, apperrors.ValidationErrorParams(apperrors.ErrInvalidIndicatorPeriod))
+
+			return
+		}
+		period = val
+	}
+
+	limit := 0
+	if l := c.Query("limit"); l != "" {
+		if val, convErr := strconv.Atoi(l); convErr == nil {
+			limit = val
+		}
+	}
+
+	indicators, err := h.marketService.GetIndicators(c.Request.Context(), c.Param("symbol"), types, period, limit)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		invalidParams := apperrors.ValidationErrorParams(err)
+		RespondWithProblem(c, status, errType, detail, invalidParams)
+
+		return
+	}
+
+	protoIndicators := make([]*exchange.Indicator, len(indicators))
+	for i, ind := range indicators {
+		protoIndicators[i] = ToExternalIndicator(ind)
+	}
+
+	c.JSON(http.StatusOK, &exchange.GetIndicatorsResponse{
+		Indicators: protoIndicators,
+	})
+}
+
+// SearchInstruments handles searches of the instrument catalogue.
+func (h *RestHandler) SearchInstruments(c *gin.Context) {
+	limit := 0
+	if l := c.Query("limit"); l != "" {
+		if val, err := strconv.Atoi(l); err == nil {
+			limit = val
+		}
+	}
+
+	instruments, err := h.instService.Search(c.Request.Context(), c.Query("query"), limit)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	protoInstruments := make([]*exchange.Instrument, len(instruments))
+	for i, inst := range instruments {
+		protoInstruments[i] = ToExternalInstrument(inst)
+	}
+
+	c.JSON(http.StatusOK, &exchange.SearchInstrumentsResponse{
+		Instruments: protoInstruments,
+	})
+}
+
 // JoinLadder allows a user to join the active ladder.
 func (h *RestHandler) JoinLadder(c *gin.Context) {
 	userID, ok := h.getUserID(c)
@@ -484,6 +599,392 @@ func (h *RestHandler) JoinLadder(c *gin.Context) {
 	c.JSON(http.StatusOK, &ladder.JoinLadderResponse{})
 }
 
+// ListQuarantinedQuotes returns the quotes the fetcher held back for review.
+func (h *RestHandler) ListQuarantinedQuotes(c *gin.Context) {
+	entries, err := h.quarService.List(c.Request.Context())
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	quotes := make([]*exchange.QuarantinedQuote, len(entries))
+	for i, e := range entries {
+		quotes[i] = ToExternalQuarantinedQuote(e)
+	}
+
+	c.JSON(http.StatusOK, &exchange.ListQuarantinedQuotesResponse{Quotes: quotes})
+}
+
+// ReleaseQuarantinedQuote publishes a quarantined quote.
+func (h *RestHandler) ReleaseQuarantinedQuote(c *gin.Context) {
+	quote, err := h.quarService.Release(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.ReleaseQuarantinedQuoteResponse{Quote: ToExternalQuote(quote)})
+}
+
+// DiscardQuarantinedQuote drops a quarantined quote.
+func (h *RestHandler) DiscardQuarantinedQuote(c *gin.Context) {
+	if err := h.quarService.Discard(c.Request.Context(), c.Param("id")); err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.DiscardQuarantinedQuoteResponse{})
+}
+
+// CreateBasket defines a basket instrument.
+func (h *RestHandler) CreateBasket(c *gin.Context) {
+	var req exchange.CreateBasketRequest
+	if err := c.BindJSON(&req); err != nil {
+		status, errType, detail := apperrors.MatchError(apperrors.ErrInvalidRequestBody)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	components := toDomainBasketComponents(req.GetComponents())
+	basket, err := h.basketService.Create(c.Request.Context(), req.GetSymbol(), req.GetName(), components)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		invalidParams := apperrors.ValidationErrorParams(err)
+		RespondWithProblem(c, status, errType, detail, invalidParams)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.CreateBasketResponse{Basket: ToExternalBasket(basket)})
+}
+
+// ListBaskets returns all basket instruments.
+func (h *RestHandler) ListBaskets(c *gin.Context) {
+	baskets, err := h.basketService.List(c.Request.Context())
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	protoBaskets := make([]*exchange.Basket, len(baskets))
+	for i, b := range baskets {
+		protoBaskets[i] = ToExternalBasket(b)
+	}
+
+	c.JSON(http.StatusOK, &exchange.ListBasketsResponse{Baskets: protoBaskets})
+}
+
+// DeleteBasket deletes a basket that no ladder lists.
+func (h *RestHandler) DeleteBasket(c *gin.Context) {
+	if err := h.basketService.Delete(c.Request.Context(), c.Param("symbol")); err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.DeleteBasketResponse{})
+}
+
+// CreateIndex publishes a custom index owned by the authenticated user.
+func (h *RestHandler) CreateIndex(c *gin.Context) {
+	userID, ok := h.getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req exchange.CreateIndexRequest
+	if err := c.BindJSON(&req); err != nil {
+		status, errType, detail := apperrors.MatchError(apperrors.ErrInvalidRequestBody)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	basket, err := h.basketService.Publish(
+		c.Request.Context(),
+		userID,
+		req.GetSymbol(),
+		req.GetName(),
+		req.GetRebalance(),
+		toDomainBasketComponents(req.GetComponents()),
+	)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		invalidParams := apperrors.ValidationErrorParams(err)
+		RespondWithProblem(c, status, errType, detail, invalidParams)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.CreateIndexResponse{Basket: ToExternalBasket(basket)})
+}
+
+// ListIndices returns a page of the custom indices players have published.
+func (h *RestHandler) ListIndices(c *gin.Context) {
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	indices, err := h.basketService.ListIndices(c.Request.Context(), offset, limit)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	protoIndices := make([]*exchange.Index, len(indices))
+	for i, index := range indices {
+		protoIndices[i] = ToExternalIndex(index)
+	}
+
+	c.JSON(http.StatusOK, &exchange.ListIndicesResponse{Indices: protoIndices})
+}
+
+// GetIndex returns the public page of a custom index.
+func (h *RestHandler) GetIndex(c *gin.Context) {
+	limit := 100
+	if l := c.Query("limit"); l != "" {
+		if val, err := strconv.Atoi(l); err == nil {
+			limit = val
+		}
+	}
+
+	index, err := h.basketService.GetIndex(c.Request.Context(), c.Param("symbol"), limit)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	history := make([]*exchange.Quote, len(index.History))
+	for i, q := range index.History {
+		history[i] = ToExternalQuote(q)
+	}
+
+	c.JSON(http.StatusOK, &exchange.GetIndexResponse{
+		Index:     ToExternalIndex(index),
+		Followers: index.Followers,
+		History:   history,
+	})
+}
+
+// FollowIndex adds the authenticated user to the followers of a custom index.
+func (h *RestHandler) FollowIndex(c *gin.Context) {
+	userID, ok := h.getUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.basketService.Follow(c.Request.Context(), userID, c.Param("symbol")); err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.FollowIndexResponse{})
+}
+
+// UnfollowIndex removes the authenticated user from the followers of a custom index.
+func (h *RestHandler) UnfollowIndex(c *gin.Context) {
+	userID, ok := h.getUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.basketService.Unfollow(c.Request.Context(), userID, c.Param("symbol")); err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.UnfollowIndexResponse{})
+}
+
+// CreateOptionContract lists an option contract on an underlying of the active ladder.
+func (h *RestHandler) CreateOptionContract(c *gin.Context) {
+	var req exchange.CreateOptionContractRequest
+	if err := c.BindJSON(&req); err != nil {
+		status, errType, detail := apperrors.MatchError(apperrors.ErrInvalidRequestBody)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	contract, err := h.optService.Create(
+		c.Request.Context(),
+		req.GetUnderlying(),
+		req.GetType(),
+		decimal.NewFromFloat(req.GetStrike()),
+		req.GetExpiresAt().AsTime(),
+		decimal.NewFromFloat(req.GetVolatility()),
+	)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		invalidParams := apperrors.ValidationErrorParams(err)
+		RespondWithProblem(c, status, errType, detail, invalidParams)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.CreateOptionContractResponse{
+		Contract: ToExternalOptionContract(contract, nil),
+	})
+}
+
+// ListOptionContracts returns the option contracts of the active ladder with their premiums.
+func (h *RestHandler) ListOptionContracts(c *gin.Context) {
+	ctx := c.Request.Context()
+	contracts, err := h.optService.List(ctx)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	protoContracts := make([]*exchange.OptionContract, len(contracts))
+	for i, contract := range contracts {
+		protoContracts[i] = ToExternalOptionContract(contract, h.optService.Premium(ctx, contract))
+	}
+
+	c.JSON(http.StatusOK, &exchange.ListOptionContractsResponse{Contracts: protoContracts})
+}
+
+// CreatePredictionMarket opens a prediction market on a ticker of the active ladder.
+func (h *RestHandler) CreatePredictionMarket(c *gin.Context) {
+	var req exchange.CreatePredictionMarketRequest
+	if err := c.BindJSON(&req); err != nil {
+		status, errType, detail := apperrors.MatchError(apperrors.ErrInvalidRequestBody)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	market, err := h.predService.Create(
+		c.Request.Context(),
+		req.GetSymbol(),
+		decimal.NewFromFloat(req.GetThreshold()),
+		req.GetResolvesAt().AsTime(),
+		req.GetQuestion(),
+		decimal.NewFromFloat(req.GetLiquidity()),
+	)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		invalidParams := apperrors.ValidationErrorParams(err)
+		RespondWithProblem(c, status, errType, detail, invalidParams)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.CreatePredictionMarketResponse{Market: ToExternalPredictionMarket(market)})
+}
+
+// ListPredictionMarkets returns the prediction markets of the active ladder with their prices.
+func (h *RestHandler) ListPredictionMarkets(c *gin.Context) {
+	markets, err := h.predService.List(c.Request.Context())
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	protoMarkets := make([]*exchange.PredictionMarket, len(markets))
+	for i, market := range markets {
+		protoMarkets[i] = ToExternalPredictionMarket(market)
+	}
+
+	c.JSON(http.StatusOK, &exchange.ListPredictionMarketsResponse{Markets: protoMarkets})
+}
+
+// StakePrediction stakes cash of the authenticated user on an outcome of a prediction market.
+func (h *RestHandler) StakePrediction(c *gin.Context) {
+	userID, ok := h.getUserID(c)
+	if !ok {
+		return
+	}
+
+	marketID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(apperrors.ErrPredictionMarketNotFound)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	var req exchange.StakePredictionRequest
+	if errBind := c.BindJSON(&req); errBind != nil {
+		status, errType, detail := apperrors.MatchError(apperrors.ErrInvalidRequestBody)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	market, position, err := h.predService.Stake(c.Request.Context(), userID, marketID, req.GetOutcome(), req.GetAmount())
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		invalidParams := apperrors.ValidationErrorParams(err)
+		RespondWithProblem(c, status, errType, detail, invalidParams)
+
+		return
+	}
+
+	c.JSON(http.StatusOK, &exchange.StakePredictionResponse{
+		Market:   ToExternalPredictionMarket(market),
+		Position: ToExternalPredictionPosition(position),
+	})
+}
+
+// ListPredictionPositions returns the authenticated user's prediction positions in the active
+// ladder.
+func (h *RestHandler) ListPredictionPositions(c *gin.Context) {
+	userID, ok := h.getUserID(c)
+	if !ok {
+		return
+	}
+
+	positions, err := h.predService.Positions(c.Request.Context(), userID)
+	if err != nil {
+		status, errType, detail := apperrors.MatchError(err)
+		RespondWithProblem(c, status, errType, detail, nil)
+
+		return
+	}
+
+	protoPositions := make([]*exchange.PredictionPosition, len(positions))
+	for i, position := range positions {
+		protoPositions[i] = ToExternalPredictionPosition(position)
+	}
+
+	c.JSON(http.StatusOK, &exchange.ListPredictionPositionsResponse{Positions: protoPositions})
+}
+
+// toDomainBasketComponents maps requested basket components to domain components.
+func toDomainBasketComponents(components []*exchange.BasketComponent) []domain.BasketComponent {
+	result := make([]domain.BasketComponent, len(components))
+	for i, comp := range components {
+		result[i] = domain.BasketComponent{
+			Symbol: comp.GetSymbol(),
+			Weight: decimal.NewFromFloat(comp.GetWeight()),
+		}
+	}
+
+	return result
+}
+
 // GetUserID retrieves the authenticated user ID from context and aborts with a 500 status if missing.
 func (h *RestHandler) getUserID(c *gin.Context) (int64, bool) {
 	userID, ok := middleware.GetUserID(c)
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
@@ -97,6 +98,7 @@ type testEnv struct {
 	TradeService       *service.Trade
 	MarketService      *service.Market
 	LeaderboardService *service.Leaderboard
+	InstrumentService  *service.Instrument
 }
 
 // MockHistoryRepository mocks the history storage.
@@ -110,6 +112,14 @@ func (m *MockHistoryRepository) GetHistory(ctx context.Context, symbol string, l
 	return nil, nil
 }
 
+func (m *MockHistoryRepository) GetLatestQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error) {
+	return nil, nil
+}
+
+func (m *MockHistoryRepository) GetQuoteAt(ctx context.Context, symbol string, at time.Time) (*domain.Quote, error) {
+	return nil, pgx.ErrNoRows
+}
+
 func setupTestEnv(t *testing.T) *testEnv {
 	mr, _ := miniredis.Run()
 	valkeyClient := redis.NewClient(&redis.Options{
@@ -131,14 +141,27 @@ func setupTestEnv(t *testing.T) *testEnv {
 	marketRepo := redisRepo.NewMarketRepository(valkeyClient)
 	leaderboardRepo := redisRepo.NewLeaderboardRepository(valkeyClient)
 	transactor := postgreRepo.NewPgxTransactor(dbPool)
+	fxRates := service.NewFXRates(redisRepo.NewFXRateRepository(valkeyClient), time.Hour)
 	historyRepo := &MockHistoryRepository{}
 	rlRepo := redisRepo.NewRateLimitter(valkeyClient)
+	optionRepo := postgreRepo.NewOptionRepository(dbPool)
 
 	userService := service.NewUser(userRepo, portfolioRepo, ladderRepo)
-	tradeService := service.NewTrade(userRepo, portfolioRepo, marketRepo, ladderRepo, transactor)
-	ladderService := service.NewLadder(ladderRepo)
-	leaderboardService := service.NewLeaderboard(userRepo, portfolioRepo, marketRepo, ladderRepo, leaderboardRepo)
-	marketService := service.NewMarket(marketRepo, historyRepo, ladderRepo)
+	tradeService := service.NewTrade(userRepo, portfolioRepo, postgreRepo.NewFillRepository(dbPool), marketRepo, ladderRepo, optionRepo, fxRates, transactor)
+	ladderService := service.NewLadder(ladderRepo, fxRates)
+	marketService := service.NewMarket(marketRepo, historyRepo, ladderRepo, service.NewQuoteHub(marketRepo, 0))
+	quarantineService := service.NewQuarantine(redisRepo.NewQuarantineRepository(valkeyClient), marketRepo, historyRepo)
+	instrumentService := service.NewInstrument(postgreRepo.NewInstrumentRepository(dbPool))
+	basketService := service.NewBasket(postgreRepo.NewBasketRepository(dbPool), marketRepo, historyRepo, ladderRepo)
+	optionService := service.NewOptions(
+		optionRepo, marketRepo, historyRepo, ladderRepo, fxRates, service.OptionPricing{Volatility: 0.3},
+	)
+	predictionService := service.NewPredictions(
+		postgreRepo.NewPredictionRepository(dbPool), userRepo, historyRepo, ladderRepo, transactor, 100, time.Minute,
+	)
+	leaderboardService := service.NewLeaderboard(
+		userRepo, portfolioRepo, marketRepo, ladderRepo, leaderboardRepo, fxRates, predictionService,
+	)
 
 	cfg := &config.Config{
 		ServerPort: 8080,
@@ -146,9 +169,9 @@ func setupTestEnv(t *testing.T) *testEnv {
 		JWTSecret:  testSecret,
 	}
 
-	restHandler := handler.NewRestHandler(userService, tradeService, marketService, leaderboardService, ladderService, testSecret)
+	restHandler := handler.NewRestHandler(userService, tradeService, marketService, leaderboardService, ladderService, quarantineService, instrumentService, basketService, optionService, predictionService, testSecret)
 
-	router, err := api.NewRouter(restHandler, cfg, rlRepo)
+	router, err := api.NewRouter(restHandler, cfg, rlRepo, userService)
 	if err != nil {
 		t.Fatalf("Failed to create router: %v", err)
 	}
@@ -166,6 +189,7 @@ func setupTestEnv(t *testing.T) *testEnv {
 		TradeService:       tradeService,
 		MarketService:      marketService,
 		LeaderboardService: leaderboardService,
+		InstrumentService:  instrumentService,
 	}
 }
 
@@ -0,0 +1,63 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
+)
+
+func TestSearchInstruments(t *testing.T) {
+	env := setupTestEnv(t)
+	defer env.MiniRedis.Close()
+	defer env.DB.Close()
+
+	_, err := env.InstrumentService.Sync(ctx, []*domain.Instrument{
+		{Symbol: "AAPL", Source: "Finnhub", Name: "Apple Inc", AssetClass: domain.AssetClassStock, Currency: "USD", Exchange: "NASDAQ", TickSize: decimal.New(1, -2)},
+		{Symbol: "bitcoin", Source: "CoinGecko", Name: "Bitcoin", AssetClass: domain.AssetClassCrypto, Currency: "USD", Exchange: "CoinGecko", TickSize: decimal.New(1, -8)},
+	}, nil)
+	require.NoError(t, err)
+
+	search := func(t *testing.T, query string) []*exchange.Instrument {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/instruments?query="+query, nil)
+		env.Router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp struct {
+			Instruments []*exchange.Instrument `json:"instruments"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+		return resp.Instruments
+	}
+
+	t.Run("Prefix", func(t *testing.T) {
+		instruments := search(t, "app")
+		require.NotEmpty(t, instruments)
+		assert.Equal(t, "AAPL", instruments[0].GetSymbol())
+		assert.Equal(t, "Apple Inc", instruments[0].GetName())
+		assert.Equal(t, 0.01, instruments[0].GetTickSize())
+	})
+
+	t.Run("Fuzzy", func(t *testing.T) {
+		instruments := search(t, "bitcon")
+		require.NotEmpty(t, instruments)
+		assert.Equal(t, "bitcoin", instruments[0].GetSymbol())
+	})
+
+	t.Run("Ladder Carries Metadata", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/api/v1/ladder/active", nil)
+		env.Router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"name":"Apple Inc"`)
+	})
+}
@@ -0,0 +1,157 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+)
+
+type socketTestMessage struct {
+	Type    string                        `json:"type"`
+	ID      string                        `json:"id,omitempty"`
+	Symbols []string                      `json:"symbols,omitempty"`
+	Trade   *exchange.CreateTradeRequest  `json:"trade,omitempty"`
+	Quote   *exchange.Quote               `json:"quote,omitempty"`
+	Fill    *exchange.CreateTradeResponse `json:"fill,omitempty"`
+	Error   *apperrors.ProblemDetails     `json:"error,omitempty"`
+}
+
+func dialSocket(t *testing.T, serverURL, token string) *websocket.Conn {
+	header := http.Header{}
+	header.Add("Cookie", (&http.Cookie{Name: "auth_token", Value: token}).String())
+
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(serverURL, "http")+"/api/v1/ws", &websocket.DialOptions{
+		HTTPHeader: header,
+	})
+	require.NoError(t, err)
+
+	return conn
+}
+
+func exchangeSocket(t *testing.T, conn *websocket.Conn, msg socketTestMessage) socketTestMessage {
+	data, _ := json.Marshal(msg)
+	require.NoError(t, conn.Write(ctx, websocket.MessageText, data))
+
+	return readSocket(t, conn)
+}
+
+func readSocket(t *testing.T, conn *websocket.Conn) socketTestMessage {
+	_, data, err := conn.Read(ctx)
+	require.NoError(t, err)
+
+	var reply socketTestMessage
+	require.NoError(t, json.Unmarshal(data, &reply))
+
+	return reply
+}
+
+func TestSocket(t *testing.T) {
+	const (
+		symbol          = "AAPL"
+		balance float64 = 10000.0
+		price   float64 = 150.0
+	)
+
+	env := setupTestEnv(t)
+	defer env.MiniRedis.Close()
+	defer env.DB.Close()
+
+	quote := &redisRepo.ValkeyQuote{Symbol: symbol, Price: decimal.NewFromFloat(price), Timestamp: time.Now().Unix()}
+	quoteBytes, _ := json.Marshal(quote)
+	env.ValkeyClient.Set(ctx, "market:"+symbol, quoteBytes, 0)
+
+	_, token, _ := env.setupJoinedUser(t, balance)
+
+	server := httptest.NewServer(env.Router)
+	defer server.Close()
+
+	t.Run("Rejects Unauthenticated Clients", func(t *testing.T) {
+		_, resp, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(server.URL, "http")+"/api/v1/ws", nil)
+		assert.Error(t, err)
+		if assert.NotNil(t, resp) {
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		}
+	})
+
+	conn := dialSocket(t, server.URL, token)
+	defer func() { _ = conn.CloseNow() }()
+
+	t.Run("Ping", func(t *testing.T) {
+		reply := exchangeSocket(t, conn, socketTestMessage{Type: "ping", ID: "1"})
+		assert.Equal(t, "pong", reply.Type)
+		assert.Equal(t, "1", reply.ID)
+	})
+
+	t.Run("Trade", func(t *testing.T) {
+		reply := exchangeSocket(t, conn, socketTestMessage{Type: "trade", ID: "2", Trade: &exchange.CreateTradeRequest{
+			Symbol:   symbol,
+			Quantity: 2,
+			Action:   exchange.TradeAction_BUY,
+		}})
+		assert.Equal(t, "fill", reply.Type)
+		assert.Equal(t, "2", reply.ID)
+		if assert.NotNil(t, reply.Fill) {
+			assert.Equal(t, balance-2*price, reply.Fill.GetParticipant().GetUser().GetBalance())
+		}
+
+		reply = exchangeSocket(t, conn, socketTestMessage{Type: "trade", ID: "3", Trade: &exchange.CreateTradeRequest{
+			Symbol:   symbol,
+			Quantity: 1000,
+			Action:   exchange.TradeAction_BUY,
+		}})
+		assert.Equal(t, "error", reply.Type)
+		assert.Equal(t, "3", reply.ID)
+		if assert.NotNil(t, reply.Error) {
+			assert.Equal(t, apperrors.TypeInsufficientFunds, reply.Error.Type)
+		}
+	})
+
+	t.Run("Rejects Unknown Symbols and Bad Messages", func(t *testing.T) {
+		reply := exchangeSocket(t, conn, socketTestMessage{Type: "subscribe", ID: "4", Symbols: []string{"UNKNOWN"}})
+		assert.Equal(t, "error", reply.Type)
+		if assert.NotNil(t, reply.Error) {
+			assert.Equal(t, http.StatusNotFound, reply.Error.Status)
+		}
+
+		require.NoError(t, conn.Write(ctx, websocket.MessageText, []byte("{")))
+		reply = readSocket(t, conn)
+		assert.Equal(t, "error", reply.Type)
+	})
+
+	t.Run("Subscribe to All Quotes", func(t *testing.T) {
+		reply := exchangeSocket(t, conn, socketTestMessage{Type: "subscribe", ID: "5"})
+		assert.Equal(t, "subscribed", reply.Type)
+		assert.Equal(t, []string{"*"}, reply.Symbols)
+
+		assert.Eventually(t, func() bool {
+			return env.MiniRedis.PubSubNumPat() > 0
+		}, time.Second, 10*time.Millisecond)
+
+		err := env.MarketRepo.SaveQuote(ctx, &domain.Quote{Symbol: symbol, Price: decimal.NewFromInt(155), Timestamp: time.Now()})
+		require.NoError(t, err)
+
+		reply = readSocket(t, conn)
+		assert.Equal(t, "quote", reply.Type)
+		if assert.NotNil(t, reply.Quote) {
+			assert.Equal(t, symbol, reply.Quote.GetSymbol())
+			assert.Equal(t, 155.0, reply.Quote.GetPrice())
+		}
+
+		reply = exchangeSocket(t, conn, socketTestMessage{Type: "unsubscribe", ID: "6"})
+		assert.Equal(t, "subscribed", reply.Type)
+		assert.Empty(t, reply.Symbols)
+	})
+}
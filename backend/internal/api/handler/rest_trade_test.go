@@ -29,7 +29,7 @@ func TestBuyStock(t *testing.T) {
 	defer env.MiniRedis.Close()
 	defer env.DB.Close()
 
-	quote := &redisRepo.ValkeyQuote{Symbol: symbol, Price: price, Timestamp: time.Now().Unix()}
+	quote := &redisRepo.ValkeyQuote{Symbol: symbol, Price: decimal.NewFromFloat(price), Timestamp: time.Now().Unix()}
 	quoteBytes, _ := json.Marshal(quote)
 	env.ValkeyClient.Set(ctx, "market:"+symbol, quoteBytes, 0)
 
@@ -85,7 +85,7 @@ func TestSellStock(t *testing.T) {
 		expectedBalance           float64 = mockPrice*mockSellQuantity + mockStartBalance
 	)
 
-	quote := &redisRepo.ValkeyQuote{Symbol: "AAPL", Price: mockPrice, Timestamp: time.Now().Unix()}
+	quote := &redisRepo.ValkeyQuote{Symbol: "AAPL", Price: decimal.NewFromFloat(mockPrice), Timestamp: time.Now().Unix()}
 	quoteBytes, _ := json.Marshal(quote)
 	env.ValkeyClient.Set(ctx, "market:AAPL", quoteBytes, 0)
 
@@ -146,7 +146,7 @@ func TestInsufficientFunds(t *testing.T) {
 		mockBuyQuantity  = 1
 	)
 
-	quote := &redisRepo.ValkeyQuote{Symbol: "AAPL", Price: mockPrice, Timestamp: time.Now().Unix()}
+	quote := &redisRepo.ValkeyQuote{Symbol: "AAPL", Price: decimal.NewFromFloat(mockPrice), Timestamp: time.Now().Unix()}
 	quoteBytes, _ := json.Marshal(quote)
 	env.ValkeyClient.Set(ctx, "market:AAPL", quoteBytes, 0)
 
@@ -186,7 +186,7 @@ func TestSellAllStock(t *testing.T) {
 	defer env.MiniRedis.Close()
 	defer env.DB.Close()
 
-	quote := &redisRepo.ValkeyQuote{Symbol: symbol, Price: mockPrice, Timestamp: time.Now().Unix()}
+	quote := &redisRepo.ValkeyQuote{Symbol: symbol, Price: decimal.NewFromFloat(mockPrice), Timestamp: time.Now().Unix()}
 	quoteBytes, _ := json.Marshal(quote)
 	env.ValkeyClient.Set(ctx, "market:"+symbol, quoteBytes, 0)
 
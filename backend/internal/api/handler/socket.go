@@ -0,0 +1,309 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/gin-gonic/gin"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// Socket message types. Clients send subscribe, unsubscribe, trade and ping messages; the
+// server answers each with subscribed, fill, pong or error, echoing the request's id, and
+// pushes quote messages for the subscribed symbols.
+const (
+	socketSubscribe   = "subscribe"
+	socketUnsubscribe = "unsubscribe"
+	socketTrade       = "trade"
+	socketPing        = "ping"
+	socketSubscribed  = "subscribed"
+	socketQuote       = "quote"
+	socketFill        = "fill"
+	socketPong        = "pong"
+	socketError       = "error"
+)
+
+const (
+	// socketAllSymbols is the subscription key of a client streaming every ticker.
+	socketAllSymbols = "*"
+	// socketWriteTimeout is how long a client may stall a write before it is disconnected.
+	socketWriteTimeout = 5 * time.Second
+)
+
+// socketMessage is the JSON envelope of every WebSocket message in either direction.
+type socketMessage struct {
+	Type string `json:"type"`
+	// ID is chosen by the client and echoed in the reply to correlate it with the request.
+	ID string `json:"id,omitempty"`
+	// Symbols to (un)subscribe; empty means every ticker. Replies list the current subscriptions.
+	Symbols []string                      `json:"symbols,omitempty"`
+	Trade   *exchange.CreateTradeRequest  `json:"trade,omitempty"`
+	Quote   *exchange.Quote               `json:"quote,omitempty"`
+	Fill    *exchange.CreateTradeResponse `json:"fill,omitempty"`
+	Error   *apperrors.ProblemDetails     `json:"error,omitempty"`
+}
+
+// TradeLimiter rate limits trades submitted over a WebSocket.
+type TradeLimiter interface {
+	Allow(ctx context.Context, userID int64) bool
+}
+
+// Socket returns a handler that upgrades to a WebSocket carrying quotes and trades for the
+// authenticated user. Browsers may only connect from origins matching originPatterns.
+func (h *RestHandler) Socket(limiter TradeLimiter, originPatterns ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := h.getUserID(c)
+		if !ok {
+			return
+		}
+
+		conn, err := websocket.Accept(c.Writer, c.Request, &websocket.AcceptOptions{
+			OriginPatterns: originPatterns,
+		})
+		if err != nil {
+			// Accept has already written the error response.
+			log.Printf("[Socket] Failed to accept connection: %v", err)
+
+			return
+		}
+
+		s := &socketSession{
+			h:       h,
+			conn:    conn,
+			userID:  userID,
+			limiter: limiter,
+			path:    c.Request.URL.Path,
+			subs:    make(map[string]*socketSubscription),
+		}
+		s.run(c.Request.Context())
+	}
+}
+
+// socketSession serves one WebSocket connection.
+type socketSession struct {
+	h       *RestHandler
+	conn    *websocket.Conn
+	userID  int64
+	limiter TradeLimiter
+	path    string
+
+	subs map[string]*socketSubscription
+	wg   sync.WaitGroup
+}
+
+type socketSubscription struct {
+	sub    *service.QuoteSubscription
+	cancel context.CancelFunc
+}
+
+func (s *socketSession) run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer func() { _ = s.conn.CloseNow() }()
+
+	log.Printf("[Socket] User %d connected", s.userID)
+	defer func() {
+		s.unsubscribe(nil)
+		s.wg.Wait()
+		log.Printf("[Socket] User %d disconnected", s.userID)
+	}()
+
+	for {
+		typ, data, err := s.conn.Read(ctx)
+		if err != nil {
+			return
+		}
+
+		var msg socketMessage
+		if typ != websocket.MessageText || json.Unmarshal(data, &msg) != nil {
+			s.replyError(ctx, "", apperrors.ErrInvalidRequestBody)
+
+			continue
+		}
+
+		if err := s.handle(ctx, &msg); err != nil {
+			return
+		}
+	}
+}
+
+// handle answers a single client message. It only returns an error if the connection broke.
+func (s *socketSession) handle(ctx context.Context, msg *socketMessage) error {
+	switch msg.Type {
+	case socketSubscribe:
+		if err := s.subscribe(ctx, msg.Symbols); err != nil {
+			return s.replyError(ctx, msg.ID, err)
+		}
+
+		return s.write(ctx, &socketMessage{Type: socketSubscribed, ID: msg.ID, Symbols: s.symbols()})
+	case socketUnsubscribe:
+		s.unsubscribe(msg.Symbols)
+
+		return s.write(ctx, &socketMessage{Type: socketSubscribed, ID: msg.ID, Symbols: s.symbols()})
+	case socketTrade:
+		if msg.Trade == nil {
+			return s.replyError(ctx, msg.ID, apperrors.ErrInvalidRequestBody)
+		}
+		if s.limiter != nil && !s.limiter.Allow(ctx, s.userID) {
+			return s.write(ctx, &socketMessage{Type: socketError, ID: msg.ID, Error: &apperrors.ProblemDetails{
+				Type:     apperrors.TypeRateLimitExceeded,
+				Title:    apperrors.MappedTitle(apperrors.TypeRateLimitExceeded),
+				Status:   429,
+				Detail:   "API rate limit exceeded. Please try again later.",
+				Instance: s.path,
+			}})
+		}
+
+		fill, err := s.h.executeTrade(ctx, s.userID, msg.Trade)
+		if err != nil {
+			return s.replyError(ctx, msg.ID, err)
+		}
+
+		return s.write(ctx, &socketMessage{Type: socketFill, ID: msg.ID, Fill: fill})
+	case socketPing:
+		return s.write(ctx, &socketMessage{Type: socketPong, ID: msg.ID})
+	default:
+		return s.replyError(ctx, msg.ID, apperrors.ErrInvalidRequestBody)
+	}
+}
+
+// subscribe adds hub subscriptions for symbols, or for every ticker if symbols is empty.
+// Streaming every ticker replaces the per-symbol subscriptions, so no quote arrives twice.
+func (s *socketSession) subscribe(ctx context.Context, symbols []string) error {
+	if _, ok := s.subs[socketAllSymbols]; ok {
+		return nil
+	}
+
+	if len(symbols) == 0 {
+		sub, err := s.h.marketService.SubscribeToQuotes(ctx)
+		if err != nil {
+			return err
+		}
+		s.unsubscribe(nil)
+		s.track(ctx, socketAllSymbols, sub)
+
+		return nil
+	}
+
+	// Subscribe to every symbol before tracking any, so an unknown symbol rejects the whole request.
+	added := make(map[string]*service.QuoteSubscription, len(symbols))
+	for _, symbol := range symbols {
+		if _, ok := s.subs[symbol]; ok {
+			continue
+		}
+		if _, ok := added[symbol]; ok {
+			continue
+		}
+
+		sub, err := s.h.marketService.SubscribeToQuotes(ctx, symbol)
+		if err != nil {
+			for _, sub := range added {
+				sub.Close()
+			}
+
+			return err
+		}
+		added[symbol] = sub
+	}
+
+	for symbol, sub := range added {
+		s.track(ctx, symbol, sub)
+	}
+
+	return nil
+}
+
+// track forwards a subscription's quotes to the client until it is cancelled.
+func (s *socketSession) track(ctx context.Context, key string, sub *service.QuoteSubscription) {
+	subCtx, cancel := context.WithCancel(ctx)
+	s.subs[key] = &socketSubscription{sub: sub, cancel: cancel}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		for quote := range sub.Quotes() {
+			if err := s.write(ctx, &socketMessage{Type: socketQuote, Quote: ToExternalQuote(quote)}); err != nil {
+				_ = s.conn.CloseNow()
+
+				return
+			}
+		}
+
+		// The hub closed the feed without us unsubscribing: the server is shutting down.
+		if subCtx.Err() == nil {
+			_ = s.conn.Close(websocket.StatusGoingAway, apperrors.ErrShuttingDown.Error())
+		}
+	}()
+}
+
+// unsubscribe drops the given subscriptions, or all of them if symbols is empty.
+func (s *socketSession) unsubscribe(symbols []string) {
+	if len(symbols) == 0 {
+		symbols = s.symbols()
+	}
+
+	for _, symbol := range symbols {
+		if tracked, ok := s.subs[symbol]; ok {
+			tracked.cancel()
+			tracked.sub.Close()
+			delete(s.subs, symbol)
+		}
+	}
+}
+
+// symbols lists the current subscriptions in a stable order.
+func (s *socketSession) symbols() []string {
+	symbols := make([]string, 0, len(s.subs))
+	for symbol := range s.subs {
+		symbols = append(symbols, symbol)
+	}
+	slices.Sort(symbols)
+
+	return symbols
+}
+
+func (s *socketSession) replyError(ctx context.Context, id string, err error) error {
+	status, errType, detail := apperrors.MatchError(err)
+
+	return s.write(ctx, &socketMessage{Type: socketError, ID: id, Error: &apperrors.ProblemDetails{
+		Type:          errType,
+		Title:         apperrors.MappedTitle(errType),
+		Status:        status,
+		Detail:        detail,
+		Instance:      s.path,
+		InvalidParams: apperrors.ValidationErrorParams(err),
+	}})
+}
+
+// write sends a message, giving up on clients that stop reading.
+func (s *socketSession) write(ctx context.Context, msg *socketMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		log.Printf("[Socket] Failed to marshal %s message: %v", msg.Type, err)
+
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, socketWriteTimeout)
+	defer cancel()
+
+	if err := s.conn.Write(ctx, websocket.MessageText, data); err != nil {
+		if !errors.Is(err, context.Canceled) {
+			log.Printf("[Socket] Failed to write to user %d: %v", s.userID, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -1,8 +1,8 @@
 package handler
 
 import (
-	"time"
-
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
@@ -10,26 +10,8 @@ import (
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/ladder/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/leaderboard/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/proto/user/v1"
-	redis "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
 )
 
-// ToExternalQuoteFromValkey maps a ValkeyQuote to a Protobuf Quote.
-func ToExternalQuoteFromValkey(vq *redis.ValkeyQuote) *exchange.Quote {
-	if vq == nil {
-		return nil
-	}
-
-	return &exchange.Quote{
-		Symbol:        vq.Symbol,
-		Price:         vq.Price,
-		Change:        vq.Change,
-		ChangePercent: vq.ChangePercent,
-		Timestamp:     timestamppb.New(time.Unix(vq.Timestamp, 0)),
-		Source:        vq.Source,
-		IsClosed:      vq.IsClosed,
-	}
-}
-
 // ToExternalUser maps a domain User to a Protobuf User.
 func ToExternalUser(u *domain.User) *user.User {
 	if u == nil {
@@ -77,8 +59,15 @@ func ToExternalLadder(l *domain.Ladder) *ladder.Ladder {
 	allowed := make([]*ladder.TickerInfo, len(l.AllowedTickers))
 	for i, t := range l.AllowedTickers {
 		allowed[i] = &ladder.TickerInfo{
-			Symbol: t.Symbol,
-			Source: t.Source,
+			Symbol:     t.Symbol,
+			Source:     t.Source,
+			Name:       t.Name,
+			AssetClass: t.AssetClass,
+			Currency:   t.Currency,
+			Exchange:   t.Exchange,
+			TickSize:   t.TickSize.InexactFloat64(),
+			PipSize:    t.PipSize.InexactFloat64(),
+			FxRate:     t.FXRate.InexactFloat64(),
 		}
 	}
 
@@ -92,6 +81,50 @@ func ToExternalLadder(l *domain.Ladder) *ladder.Ladder {
 		CreatedAt:      timestamppb.New(l.CreatedAt),
 		InitialBalance: l.InitialBalance.InexactFloat64(),
 		AllowedTickers: allowed,
+		BaseCurrency:   l.Currency(),
+	}
+}
+
+// ToExternalIndicator maps a domain Indicator to a Protobuf Indicator.
+func ToExternalIndicator(ind *domain.Indicator) *exchange.Indicator {
+	if ind == nil {
+		return nil
+	}
+
+	points := make([]*exchange.IndicatorPoint, len(ind.Points))
+	for i, p := range ind.Points {
+		points[i] = &exchange.IndicatorPoint{
+			Timestamp: timestamppb.New(p.Timestamp),
+			Value:     p.Value,
+			Signal:    p.Signal,
+			Histogram: p.Histogram,
+			Upper:     p.Upper,
+			Lower:     p.Lower,
+		}
+	}
+
+	return &exchange.Indicator{
+		Type:   ind.Type,
+		Period: int32(ind.Period),
+		Points: points,
+	}
+}
+
+// ToExternalInstrument maps a domain Instrument to a Protobuf Instrument.
+func ToExternalInstrument(i *domain.Instrument) *exchange.Instrument {
+	if i == nil {
+		return nil
+	}
+
+	return &exchange.Instrument{
+		Symbol:     i.Symbol,
+		Source:     i.Source,
+		Name:       i.Name,
+		AssetClass: i.AssetClass,
+		Currency:   i.Currency,
+		Exchange:   i.Exchange,
+		TickSize:   i.TickSize.InexactFloat64(),
+		PipSize:    i.PipSize.InexactFloat64(),
 	}
 }
 
@@ -102,13 +135,169 @@ func ToExternalQuote(q *domain.Quote) *exchange.Quote {
 	}
 
 	return &exchange.Quote{
-		Symbol:        q.Symbol,
-		Price:         q.Price.InexactFloat64(),
-		Change:        q.Change.InexactFloat64(),
-		ChangePercent: q.ChangePercent.InexactFloat64(),
-		Timestamp:     timestamppb.New(q.Timestamp),
-		Source:        q.Source,
-		IsClosed:      q.IsClosed,
+		Symbol:         q.Symbol,
+		Price:          q.Price.InexactFloat64(),
+		Change:         q.Change.InexactFloat64(),
+		ChangePercent:  q.ChangePercent.InexactFloat64(),
+		Timestamp:      timestamppb.New(q.Timestamp),
+		Source:         q.Source,
+		IsClosed:       q.IsClosed,
+		PriceDecimal:   q.Price.StringFixed(quotePrecision(q)),
+		PricePrecision: quotePrecision(q),
+		Bid:            quoteSide(q, q.Bid),
+		Ask:            quoteSide(q, q.Ask),
+		PipSize:        optionalDecimal(q.PipSize),
+	}
+}
+
+// quoteSide formats the bid or ask of a two-sided quote with the quote's precision, or
+// returns an empty string for other quotes.
+func quoteSide(q *domain.Quote, side decimal.Decimal) string {
+	if !q.IsTwoSided() {
+		return ""
+	}
+
+	return side.StringFixed(quotePrecision(q))
+}
+
+// optionalDecimal formats a decimal that is unset when zero, returning an empty string for zero.
+func optionalDecimal(d decimal.Decimal) string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.String()
+}
+
+// quotePrecision returns the number of decimal places a quote is displayed with. Quotes
+// stored without a precision use the decimal places of their price, but at least two.
+func quotePrecision(q *domain.Quote) int32 {
+	if q.Precision > 0 {
+		return q.Precision
+	}
+
+	return max(-q.Price.Exponent(), 2)
+}
+
+// ToExternalQuarantinedQuote maps a domain QuarantinedQuote to a Protobuf QuarantinedQuote.
+func ToExternalQuarantinedQuote(q *domain.QuarantinedQuote) *exchange.QuarantinedQuote {
+	if q == nil {
+		return nil
+	}
+
+	return &exchange.QuarantinedQuote{
+		Id:            q.ID,
+		Quote:         ToExternalQuote(&q.Quote),
+		Reason:        q.Reason,
+		Detail:        q.Detail,
+		QuarantinedAt: timestamppb.New(q.QuarantinedAt),
+	}
+}
+
+// ToExternalBasket maps a domain Basket to a Protobuf Basket.
+func ToExternalBasket(b *domain.Basket) *exchange.Basket {
+	if b == nil {
+		return nil
+	}
+
+	components := make([]*exchange.BasketComponent, len(b.Components))
+	for i, c := range b.Components {
+		components[i] = &exchange.BasketComponent{
+			Symbol: c.Symbol,
+			Weight: c.Weight.InexactFloat64(),
+			Units:  c.Units.InexactFloat64(),
+		}
+	}
+
+	return &exchange.Basket{
+		Symbol:        b.Symbol,
+		Name:          b.Name,
+		BaseValue:     b.BaseValue.InexactFloat64(),
+		Components:    components,
+		CreatedAt:     timestamppb.New(b.CreatedAt),
+		Owner:         b.OwnerUsername,
+		Rebalance:     b.Rebalance,
+		RebalancedAt:  timestamppb.New(b.RebalancedAt),
+		FollowerCount: b.FollowerCount,
+	}
+}
+
+// ToExternalIndex maps domain IndexDetails to a Protobuf Index.
+func ToExternalIndex(i *domain.IndexDetails) *exchange.Index {
+	if i == nil {
+		return nil
+	}
+
+	return &exchange.Index{
+		Basket:        ToExternalBasket(i.Basket),
+		Quote:         ToExternalQuote(i.Quote),
+		ReturnPercent: i.ReturnPercent.InexactFloat64(),
+	}
+}
+
+// ToExternalOptionContract maps a domain OptionContract and its premium quote to a Protobuf
+// OptionContract.
+func ToExternalOptionContract(o *domain.OptionContract, premium *domain.Quote) *exchange.OptionContract {
+	if o == nil {
+		return nil
+	}
+
+	contract := &exchange.OptionContract{
+		Symbol:          o.Symbol,
+		Underlying:      o.Underlying,
+		Type:            o.Type,
+		Strike:          o.Strike.InexactFloat64(),
+		ExpiresAt:       timestamppb.New(o.ExpiresAt),
+		Volatility:      o.Volatility.InexactFloat64(),
+		Premium:         ToExternalQuote(premium),
+		SettlementPrice: o.SettlementPrice.InexactFloat64(),
+	}
+	if o.SettledAt != nil {
+		contract.SettledAt = timestamppb.New(*o.SettledAt)
+	}
+
+	return contract
+}
+
+// ToExternalPredictionMarket maps a domain PredictionMarket to a Protobuf PredictionMarket.
+func ToExternalPredictionMarket(m *domain.PredictionMarket) *exchange.PredictionMarket {
+	if m == nil {
+		return nil
+	}
+
+	market := &exchange.PredictionMarket{
+		Id:              m.ID,
+		Symbol:          m.Symbol,
+		Question:        m.Question,
+		Threshold:       m.Threshold.InexactFloat64(),
+		ResolvesAt:      timestamppb.New(m.ResolvesAt),
+		Liquidity:       m.Liquidity.InexactFloat64(),
+		YesPrice:        m.Price(domain.PredictionYes).InexactFloat64(),
+		NoPrice:         m.Price(domain.PredictionNo).InexactFloat64(),
+		YesShares:       m.YesShares.InexactFloat64(),
+		NoShares:        m.NoShares.InexactFloat64(),
+		Outcome:         m.Outcome,
+		ResolutionPrice: m.ResolutionPrice.InexactFloat64(),
+	}
+	if m.ResolvedAt != nil {
+		market.ResolvedAt = timestamppb.New(*m.ResolvedAt)
+	}
+
+	return market
+}
+
+// ToExternalPredictionPosition maps a domain PredictionPosition to a Protobuf
+// PredictionPosition.
+func ToExternalPredictionPosition(p *domain.PredictionPosition) *exchange.PredictionPosition {
+	if p == nil {
+		return nil
+	}
+
+	return &exchange.PredictionPosition{
+		MarketId:  p.MarketID,
+		YesShares: p.YesShares.InexactFloat64(),
+		NoShares:  p.NoShares.InexactFloat64(),
+		Staked:    p.Staked.InexactFloat64(),
 	}
 }
 
@@ -162,3 +351,23 @@ func ToExternalLeaderboardResponse(lr *domain.Leaderboard) *leaderboard.GetLeade
 		LastUpdate: lr.LastUpdate,
 	}
 }
+
+// UpdateUserPaths maps the paths of an UpdateUserRequest's update mask, in either JSON or
+// proto field naming, to the field names service.User.UpdateUser expects. Unknown paths are dropped.
+func UpdateUserPaths(mask *fieldmaskpb.FieldMask) []string {
+	var paths []string
+	for _, p := range mask.GetPaths() {
+		switch p {
+		case "firstName", "first_name":
+			paths = append(paths, "first_name")
+		case "lastName", "last_name":
+			paths = append(paths, "last_name")
+		case "website":
+			paths = append(paths, "website")
+		case "isPublic", "is_public":
+			paths = append(paths, "is_public")
+		}
+	}
+
+	return paths
+}
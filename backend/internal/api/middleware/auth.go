@@ -2,11 +2,13 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 	"github.com/tmythicator/ticker-rush/backend/internal/service"
 )
 
@@ -44,6 +46,35 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 	}
 }
 
+// UserGetter looks up users by ID.
+type UserGetter interface {
+	GetUser(ctx context.Context, id int64) (*domain.User, error)
+}
+
+// AdminMiddleware rejects requests from users without admin rights. It must run after AuthMiddleware.
+// The flag is read from the database rather than the token so that revoking it takes effect immediately.
+func AdminMiddleware(users UserGetter) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := GetUserID(c)
+		if !ok {
+			respondWithProblemDirectly(c, http.StatusUnauthorized, apperrors.TypeAuthRequired, apperrors.ErrAuthRequired.Error())
+			c.Abort()
+
+			return
+		}
+
+		u, err := users.GetUser(c.Request.Context(), userID)
+		if err != nil || !u.IsAdmin {
+			respondWithProblemDirectly(c, http.StatusForbidden, apperrors.TypeForbidden, apperrors.ErrAdminRequired.Error())
+			c.Abort()
+
+			return
+		}
+
+		c.Next()
+	}
+}
+
 func respondWithProblemDirectly(c *gin.Context, status int, errType string, detail string) {
 	c.Header("Content-Type", "application/problem+json")
 	c.JSON(status, apperrors.ProblemDetails{
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// mockUserGetter implements middleware.UserGetter for testing.
+type mockUserGetter map[int64]*domain.User
+
+func (m mockUserGetter) GetUser(_ context.Context, id int64) (*domain.User, error) {
+	u, ok := m[id]
+	if !ok {
+		return nil, apperrors.ErrPublicProfileNotFoundOrPrivate
+	}
+
+	return u, nil
+}
+
+func TestAdminMiddleware(t *testing.T) {
+	users := mockUserGetter{
+		1: {ID: 1, IsAdmin: true},
+		2: {ID: 2},
+	}
+
+	tests := []struct {
+		name   string
+		userID any
+		want   int
+	}{
+		{name: "Admin", userID: int64(1), want: http.StatusOK},
+		{name: "Regular User", userID: int64(2), want: http.StatusForbidden},
+		{name: "Unknown User", userID: int64(3), want: http.StatusForbidden},
+		{name: "Unauthenticated", userID: nil, want: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, w := setupTestGin()
+			r.Use(func(c *gin.Context) {
+				if tt.userID != nil {
+					c.Set(UserIDKey, tt.userID)
+				}
+			})
+			r.Use(AdminMiddleware(users))
+			r.GET("/admin", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req, _ := http.NewRequest(http.MethodGet, "/admin", nil)
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -18,35 +19,82 @@ type internalContextKey string
 const UserIDContextKey = internalContextKey("userID")
 
 // GrpcAuthInterceptor is a gRPC interceptor that validates the authorization token.
-func GrpcAuthInterceptor(jwtSecret string) grpc.UnaryServerInterceptor {
+// The full method names in publicMethods are served without authentication.
+func GrpcAuthInterceptor(jwtSecret string, publicMethods ...string) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req any,
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(ctx, req)
 		}
 
-		values := md["authorization"]
-		if len(values) == 0 {
-			return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+		newCtx, err := authenticate(ctx, jwtSecret)
+		if err != nil {
+			return nil, err
 		}
 
-		accessToken := values[0]
-		tokenString := strings.TrimPrefix(accessToken, "Bearer ")
+		return handler(newCtx, req)
+	}
+}
 
-		claims, err := service.ValidateToken(tokenString, jwtSecret)
+// GrpcStreamAuthInterceptor is the streaming counterpart of GrpcAuthInterceptor.
+func GrpcStreamAuthInterceptor(jwtSecret string, publicMethods ...string) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		newCtx, err := authenticate(ss.Context(), jwtSecret)
 		if err != nil {
-			return nil, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+			return err
 		}
 
-		newCtx := context.WithValue(ctx, UserIDContextKey, claims.UserID)
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: newCtx})
+	}
+}
 
-		return handler(newCtx, req)
+// authenticatedStream carries the authenticated context to stream handlers.
+type authenticatedStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+// Context returns the stream's context, including the authenticated user ID.
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate validates the bearer token in the metadata of ctx and returns a context
+// carrying the user ID.
+func authenticate(ctx context.Context, jwtSecret string) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "metadata is not provided")
 	}
+
+	values := md["authorization"]
+	if len(values) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "authorization token is not provided")
+	}
+
+	accessToken := values[0]
+	tokenString := strings.TrimPrefix(accessToken, "Bearer ")
+
+	claims, err := service.ValidateToken(tokenString, jwtSecret)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+	}
+
+	return context.WithValue(ctx, UserIDContextKey, claims.UserID), nil
 }
 
 // GetRequiredUserID retrieves the authenticated user's ID from a gRPC context
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+)
+
+// GrpcErrorInterceptor converts application errors returned by handlers into gRPC status
+// errors with matching codes, so servers can return service errors unchanged.
+func GrpcErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, apperrors.GRPCStatus(err)
+		}
+
+		return resp, nil
+	}
+}
+
+// GrpcStreamErrorInterceptor is the streaming counterpart of GrpcErrorInterceptor.
+func GrpcStreamErrorInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return apperrors.GRPCStatus(handler(srv, ss))
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcInterceptor returns a gRPC interceptor that rate limits unary calls like Limit does
+// HTTP requests. Calls to publicMethods are counted per peer address, others per user, so
+// it runs before GrpcAuthInterceptor and rejects floods of unauthenticated calls too. A
+// call without a valid token is counted against its peer address.
+func (rl *RateLimitter) GrpcInterceptor(jwtSecret string, publicMethods ...string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		idKey := fmt.Sprintf("ip:%s", peerHost(ctx))
+		if !slices.Contains(publicMethods, info.FullMethod) {
+			if authCtx, err := authenticate(ctx, jwtSecret); err == nil {
+				if userID, ok := authCtx.Value(UserIDContextKey).(int64); ok {
+					idKey = fmt.Sprintf("user:%d", userID)
+				}
+			}
+		}
+
+		rateVal, resetTime, err := rl.hit(ctx, idKey)
+		if err != nil {
+			log.Printf("[RateLimitter] store error: %v", err)
+
+			return handler(ctx, req)
+		}
+
+		if rateVal > int64(rl.limit) {
+			log.Printf("[RateLimitter] rate limit exceeded for client %s (requests: %d/%d)", idKey, rateVal, rl.limit)
+			retryAfter := resetTime - time.Now().Unix()
+			_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", fmt.Sprintf("%d", retryAfter)))
+
+			return nil, status.Error(codes.ResourceExhausted, "API rate limit exceeded. Please try again later.")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// peerHost returns the host of the client that made the call, without its port.
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
@@ -45,11 +45,7 @@ func (rl *RateLimitter) Limit() gin.HandlerFunc {
 			idKey = fmt.Sprintf("ip:%s", c.ClientIP())
 		}
 
-		timeBucket := time.Now().Unix() / int64(rl.window.Seconds())
-
-		rlKey := fmt.Sprintf("rate_limit:%s:%d", idKey, timeBucket)
-
-		rateVal, err := rl.store.Increment(c, rlKey, rl.window)
+		rateVal, resetTime, err := rl.hit(c, idKey)
 		if err != nil {
 			log.Printf("[RateLimitter] store error: %v", err)
 			c.Next()
@@ -57,7 +53,6 @@ func (rl *RateLimitter) Limit() gin.HandlerFunc {
 			return
 		}
 
-		resetTime := (timeBucket + 1) * int64(rl.window.Seconds())
 		rem := max(int64(rl.limit)-rateVal, 0)
 		c.Header("X-RateLimit-Limit", fmt.Sprintf("%d", rl.limit))
 		c.Header("X-RateLimit-Remaining", fmt.Sprintf("%d", rem))
@@ -81,3 +76,39 @@ func (rl *RateLimitter) Limit() gin.HandlerFunc {
 	}
 
 }
+
+// Allow counts one request of a user against the limit and reports whether it is within it.
+// It is meant for requests that do not pass through Limit, such as WebSocket messages, and
+// shares their budget with HTTP requests. Like Limit, it allows requests if the store fails.
+func (rl *RateLimitter) Allow(ctx context.Context, userID int64) bool {
+	idKey := fmt.Sprintf("user:%d", userID)
+
+	rateVal, _, err := rl.hit(ctx, idKey)
+	if err != nil {
+		log.Printf("[RateLimitter] store error: %v", err)
+
+		return true
+	}
+	if rateVal > int64(rl.limit) {
+		log.Printf("[RateLimitter] rate limit exceeded for client %s (requests: %d/%d)", idKey, rateVal, rl.limit)
+
+		return false
+	}
+
+	return true
+}
+
+// hit increments the request counter of idKey in the current window and returns the new
+// count and the unix time at which the window resets.
+func (rl *RateLimitter) hit(ctx context.Context, idKey string) (int64, int64, error) {
+	timeBucket := time.Now().Unix() / int64(rl.window.Seconds())
+
+	rlKey := fmt.Sprintf("rate_limit:%s:%d", idKey, timeBucket)
+
+	rateVal, err := rl.store.Increment(ctx, rlKey, rl.window)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return rateVal, (timeBucket + 1) * int64(rl.window.Seconds()), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -12,8 +13,15 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
 )
 
 // mockRateLimitStore implements middleware.RateLimit for testing.
@@ -222,3 +230,100 @@ func TestRateLimitter_FailOpen(t *testing.T) {
 		t.Errorf("expected body 'success', got %q", w.Body.String())
 	}
 }
+
+func TestRateLimitter_AllowUser(t *testing.T) {
+	var keys []string
+	count := int64(0)
+
+	store := &mockRateLimitStore{
+		incrFunc: func(ctx context.Context, key string, window time.Duration) (int64, error) {
+			keys = append(keys, key)
+			count++
+
+			return count, nil
+		},
+	}
+
+	limiter := NewRateLimitter(store, 2, time.Minute)
+
+	for i, want := range []bool{true, true, false} {
+		if got := limiter.Allow(context.Background(), 42); got != want {
+			t.Errorf("request %d: expected Allow to return %v, got %v", i+1, want, got)
+		}
+	}
+
+	if !strings.Contains(keys[0], "user:42") {
+		t.Errorf("expected rate limit key to contain 'user:42', got %s", keys[0])
+	}
+
+	failing := NewRateLimitter(&mockRateLimitStore{
+		incrFunc: func(ctx context.Context, key string, window time.Duration) (int64, error) {
+			return 0, errors.New("redis down")
+		},
+	}, 2, time.Minute)
+
+	if !failing.Allow(context.Background(), 42) {
+		t.Error("expected Allow to fail open when the store is down")
+	}
+}
+
+func TestRateLimitter_GrpcInterceptor(t *testing.T) {
+	const secret = "test-secret"
+	const publicMethod = "/test.Service/Login"
+
+	counts := make(map[string]int64)
+	var lastKey string
+	store := &mockRateLimitStore{
+		incrFunc: func(ctx context.Context, key string, window time.Duration) (int64, error) {
+			lastKey = key
+			counts[key]++
+
+			return counts[key], nil
+		},
+	}
+
+	interceptor := NewRateLimitter(store, 2, time.Minute).GrpcInterceptor(secret, publicMethod)
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+		return err
+	}
+
+	peerCtx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50000},
+	})
+
+	// Public calls are counted per peer address, whatever port the client connects from.
+	for i, wantErr := range []bool{false, false, true} {
+		err := call(peerCtx, publicMethod)
+		if (err != nil) != wantErr {
+			t.Fatalf("public call %d: unexpected error %v", i+1, err)
+		}
+	}
+	if !strings.Contains(lastKey, "rate_limit:ip:192.0.2.1:") {
+		t.Errorf("expected key to contain 'rate_limit:ip:192.0.2.1:', got %q", lastKey)
+	}
+	if code := status.Code(call(peerCtx, publicMethod)); code != codes.ResourceExhausted {
+		t.Errorf("expected ResourceExhausted, got %v", code)
+	}
+
+	// Authenticated calls are counted per user, not against the peer's budget.
+	token, err := service.GenerateToken(&domain.User{ID: 42, Username: "bot"}, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userCtx := metadata.NewIncomingContext(peerCtx, metadata.Pairs("authorization", "Bearer "+token))
+	if err := call(userCtx, "/test.Service/GetQuote"); err != nil {
+		t.Errorf("expected authenticated call to pass, got %v", err)
+	}
+	if !strings.Contains(lastKey, "rate_limit:user:42:") {
+		t.Errorf("expected key to contain 'rate_limit:user:42:', got %q", lastKey)
+	}
+
+	// A call without a valid token still counts against its peer address.
+	badCtx := metadata.NewIncomingContext(peerCtx, metadata.Pairs("authorization", "Bearer invalid"))
+	if code := status.Code(call(badCtx, "/test.Service/GetQuote")); code != codes.ResourceExhausted {
+		t.Errorf("expected ResourceExhausted for an unauthenticated call, got %v", code)
+	}
+}
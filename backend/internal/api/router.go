@@ -22,7 +22,12 @@ type Router struct {
 }
 
 // NewRouter creates a new API router.
-func NewRouter(handler *handler.RestHandler, cfg *config.Config, rateLimitRepo middleware.RateLimit) (*Router, error) {
+func NewRouter(
+	handler *handler.RestHandler,
+	cfg *config.Config,
+	rateLimitRepo middleware.RateLimit,
+	users middleware.UserGetter,
+) (*Router, error) {
 	engine := gin.Default()
 
 	if cfg.OtelEndpoint != "" {
@@ -54,8 +59,14 @@ func NewRouter(handler *handler.RestHandler, cfg *config.Config, rateLimitRepo m
 		v1.POST("/users", handler.CreateUser)
 		v1.GET("/ladder/active", handler.GetActiveLadder)
 		v1.GET("/quotes/:symbol/history", handler.GetHistory)
+		v1.GET("/quotes/:symbol/indicators", handler.GetIndicators)
+		v1.GET("/instruments", handler.SearchInstruments)
 		v1.GET("/leaderboard", handler.GetLeaderboard)
 		v1.GET("/users/:username", handler.GetPublicProfile)
+		v1.GET("/indices", handler.ListIndices)
+		v1.GET("/indices/:symbol", handler.GetIndex)
+		v1.GET("/options", handler.ListOptionContracts)
+		v1.GET("/predictions", handler.ListPredictionMarkets)
 
 		protected := v1.Group("/")
 		protected.Use(middleware.AuthMiddleware(cfg.JWTSecret))
@@ -68,6 +79,25 @@ func NewRouter(handler *handler.RestHandler, cfg *config.Config, rateLimitRepo m
 			protected.PATCH("/profile", handler.UpdateUser)
 			protected.DELETE("/profile", handler.DeleteUser)
 			protected.POST("/trades", handler.CreateTrade)
+			protected.POST("/indices", handler.CreateIndex)
+			protected.PUT("/indices/:symbol/followers", handler.FollowIndex)
+			protected.DELETE("/indices/:symbol/followers", handler.UnfollowIndex)
+			protected.GET("/predictions/positions", handler.ListPredictionPositions)
+			protected.POST("/predictions/:id/stakes", handler.StakePrediction)
+			protected.GET("/ws", handler.Socket(strictLimiter, fmt.Sprintf("localhost:%d", cfg.ClientPort)))
+
+			admin := protected.Group("/admin")
+			admin.Use(middleware.AdminMiddleware(users))
+			{
+				admin.GET("/quarantine", handler.ListQuarantinedQuotes)
+				admin.POST("/quarantine/:id/release", handler.ReleaseQuarantinedQuote)
+				admin.DELETE("/quarantine/:id", handler.DiscardQuarantinedQuote)
+				admin.POST("/baskets", handler.CreateBasket)
+				admin.GET("/baskets", handler.ListBaskets)
+				admin.DELETE("/baskets/:symbol", handler.DeleteBasket)
+				admin.POST("/options", handler.CreateOptionContract)
+				admin.POST("/predictions", handler.CreatePredictionMarket)
+			}
 		}
 	}
 
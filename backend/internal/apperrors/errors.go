@@ -40,6 +40,76 @@ var (
 	ErrInvalidWebsiteFormat = errors.New("website must be a valid URL starting with http:// or https://")
 	// ErrInvalidQuantity is returned when a trade quantity is invalid.
 	ErrInvalidQuantity = errors.New("quantity must be between 0.00000001 and 1,000,000,000")
+	// ErrAdminRequired is returned when a non-admin user calls an admin endpoint.
+	ErrAdminRequired = errors.New("admin privileges required")
+	// ErrQuoteNotQuarantined is returned when a quarantine entry does not exist.
+	ErrQuoteNotQuarantined = errors.New("quarantined quote not found")
+	// ErrQuarantineFull is returned when a quote cannot be quarantined because too many await review.
+	ErrQuarantineFull = errors.New("quarantine is full")
+	// ErrInvalidBasketSymbol is returned when a basket symbol does not match the required format.
+	ErrInvalidBasketSymbol = errors.New("basket symbol must be 2-20 uppercase letters, digits or dashes")
+	// ErrInvalidBasketComponents is returned when a basket has too few, too many, duplicate or
+	// nested components, or a mix of weighted and unweighted ones.
+	ErrInvalidBasketComponents = errors.New("basket needs 2-20 distinct non-basket components, all weighted or all unweighted")
+	// ErrBasketComponentUnpriced is returned when a basket component has no current quote.
+	ErrBasketComponentUnpriced = errors.New("basket component has no current price")
+	// ErrBasketComponentCurrency is returned when a basket component is not quoted in USD.
+	ErrBasketComponentCurrency = errors.New("basket components must be quoted in USD")
+	// ErrBasketExists is returned when a basket with the same symbol already exists.
+	ErrBasketExists = errors.New("basket already exists")
+	// ErrBasketInUse is returned when deleting a basket that a ladder still lists.
+	ErrBasketInUse = errors.New("basket is listed by a ladder")
+	// ErrBasketNotFound is returned when a basket does not exist.
+	ErrBasketNotFound = errors.New("basket not found")
+	// ErrInvalidRebalanceSchedule is returned when a rebalance schedule is not supported.
+	ErrInvalidRebalanceSchedule = errors.New("rebalance must be one of none, weekly, monthly or quarterly")
+	// ErrIndexNameRequired is returned when a custom index is published without a name.
+	ErrIndexNameRequired = errors.New("index name is required")
+	// ErrIndexComponentNotListed is returned when a custom index component is not traded in the
+	// active ladder.
+	ErrIndexComponentNotListed = errors.New("index components must be traded in the active ladder")
+	// ErrIndexLimitReached is returned when a user has published the maximum number of indices.
+	ErrIndexLimitReached = errors.New("maximum number of published indices reached")
+	// ErrIndexNotFound is returned when a custom index does not exist.
+	ErrIndexNotFound = errors.New("index not found")
+	// ErrInvalidOptionType is returned when an option type is neither call nor put.
+	ErrInvalidOptionType = errors.New("option type must be call or put")
+	// ErrInvalidOptionStrike is returned when an option strike is not positive.
+	ErrInvalidOptionStrike = errors.New("option strike must be positive")
+	// ErrInvalidOptionExpiry is returned when an option does not expire while the active ladder runs.
+	ErrInvalidOptionExpiry = errors.New("option must expire before the active ladder ends")
+	// ErrInvalidOptionVolatility is returned when an option volatility is negative.
+	ErrInvalidOptionVolatility = errors.New("option volatility must not be negative")
+	// ErrOptionUnderlyingNotListed is returned when an option underlying is not a USD-priced
+	// instrument of the active ladder.
+	ErrOptionUnderlyingNotListed = errors.New("option underlying must be a USD-priced instrument of the active ladder")
+	// ErrOptionExists is returned when an option with the same terms is already listed.
+	ErrOptionExists = errors.New("option already listed")
+	// ErrOptionNotFound is returned when an option contract does not exist.
+	ErrOptionNotFound = errors.New("option not found")
+	// ErrOptionExpired is returned when trading an option at or after its expiry.
+	ErrOptionExpired = errors.New("option has expired")
+	// ErrInvalidPredictionThreshold is returned when a prediction market threshold is not positive.
+	ErrInvalidPredictionThreshold = errors.New("prediction threshold must be positive")
+	// ErrInvalidPredictionResolution is returned when a prediction market resolves in the past or
+	// after the active ladder ends.
+	ErrInvalidPredictionResolution = errors.New("prediction must resolve before the active ladder ends")
+	// ErrInvalidPredictionLiquidity is returned when a prediction market liquidity is negative.
+	ErrInvalidPredictionLiquidity = errors.New("prediction liquidity must not be negative")
+	// ErrPredictionSymbolNotListed is returned when a prediction market concerns an instrument
+	// outside the active ladder.
+	ErrPredictionSymbolNotListed = errors.New("prediction symbol must be an instrument of the active ladder")
+	// ErrInvalidPredictionOutcome is returned when a stake is on neither yes nor no.
+	ErrInvalidPredictionOutcome = errors.New("prediction outcome must be yes or no")
+	// ErrInvalidStake is returned when a stake amount is not positive.
+	ErrInvalidStake = errors.New("stake amount must be positive")
+	// ErrPredictionMarketNotFound is returned when a prediction market does not exist in the
+	// active ladder.
+	ErrPredictionMarketNotFound = errors.New("prediction market not found")
+	// ErrPredictionMarketClosed is returned when staking on a market past its resolution time.
+	ErrPredictionMarketClosed = errors.New("prediction market is closed")
+	// ErrStaleFencingToken is returned when a write comes from a replica that is no longer the leader.
+	ErrStaleFencingToken = errors.New("stale fencing token: leadership was lost")
 	// ErrPublicProfileNotFoundOrPrivate is returned when a public profile is requested but not found or is private.
 	ErrPublicProfileNotFoundOrPrivate = errors.New("user not found or profile is private")
 
@@ -67,6 +137,15 @@ var (
 	ErrFailedToFetchActiveLadder = errors.New("failed to fetch active ladder")
 	// ErrMarketDataWarmingUp is returned when Redis has no quote cache yet.
 	ErrMarketDataWarmingUp = errors.New("market data warming up, please retry")
+	// ErrFXRateUnavailable is returned when no current FX rate converts an instrument's currency
+	// into the ladder's base currency.
+	ErrFXRateUnavailable = errors.New("exchange rate unavailable, please retry")
+	// ErrShuttingDown is returned when a stream is requested while the server drains.
+	ErrShuttingDown = errors.New("server is shutting down, please reconnect")
 	// ErrInternalAuthConfigurationError is returned when user ID context missing.
 	ErrInternalAuthConfigurationError = errors.New("internal authentication configuration error")
+	// ErrInvalidIndicator is returned when an unknown technical indicator is requested.
+	ErrInvalidIndicator = errors.New("indicator type must be one of sma, ema, rsi, macd, bollinger")
+	// ErrInvalidIndicatorPeriod is returned when an indicator period is out of range.
+	ErrInvalidIndicatorPeriod = errors.New("indicator period must be between 2 and 500")
 )
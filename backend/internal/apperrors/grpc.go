@@ -0,0 +1,64 @@
+package apperrors
+
+import (
+	"errors"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCStatus converts an application error into a gRPC status error, choosing the code that
+// corresponds to the HTTP status MatchError assigns. Validation errors carry their invalid
+// params as BadRequest field violations. Errors that already are gRPC statuses pass through.
+func GRPCStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return err
+	}
+
+	httpStatus, _, detail := MatchError(err)
+	st := status.New(grpcCode(httpStatus), detail)
+
+	params := ValidationErrorParams(err)
+	if len(params) == 0 {
+		return st.Err()
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(params))
+	for i, p := range params {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: p.Name, Description: p.Reason}
+	}
+	withDetails, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// grpcCode maps the HTTP statuses used by MatchError to gRPC codes.
+func grpcCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusPaymentRequired:
+		return codes.FailedPrecondition
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
@@ -79,7 +79,31 @@ func MatchError(err error) (int, string, string) {
 		errors.Is(err, ErrInvalidRequestBody),
 		errors.Is(err, ErrUsernameRequired),
 		errors.Is(err, ErrSymbolRequired),
-		errors.Is(err, ErrInvalidTradeAction):
+		errors.Is(err, ErrInvalidTradeAction),
+		errors.Is(err, ErrInvalidIndicator),
+		errors.Is(err, ErrInvalidIndicatorPeriod),
+		errors.Is(err, ErrInvalidBasketSymbol),
+		errors.Is(err, ErrInvalidBasketComponents),
+		errors.Is(err, ErrBasketComponentUnpriced),
+		errors.Is(err, ErrBasketComponentCurrency),
+		errors.Is(err, ErrBasketExists),
+		errors.Is(err, ErrBasketInUse),
+		errors.Is(err, ErrInvalidRebalanceSchedule),
+		errors.Is(err, ErrIndexNameRequired),
+		errors.Is(err, ErrIndexComponentNotListed),
+		errors.Is(err, ErrIndexLimitReached),
+		errors.Is(err, ErrInvalidOptionType),
+		errors.Is(err, ErrInvalidOptionStrike),
+		errors.Is(err, ErrInvalidOptionExpiry),
+		errors.Is(err, ErrInvalidOptionVolatility),
+		errors.Is(err, ErrOptionUnderlyingNotListed),
+		errors.Is(err, ErrOptionExists),
+		errors.Is(err, ErrInvalidPredictionThreshold),
+		errors.Is(err, ErrInvalidPredictionResolution),
+		errors.Is(err, ErrInvalidPredictionLiquidity),
+		errors.Is(err, ErrPredictionSymbolNotListed),
+		errors.Is(err, ErrInvalidPredictionOutcome),
+		errors.Is(err, ErrInvalidStake):
 		return http.StatusBadRequest, TypeValidation, err.Error()
 
 	case errors.Is(err, ErrAuthRequired),
@@ -88,11 +112,17 @@ func MatchError(err error) (int, string, string) {
 		return http.StatusUnauthorized, TypeAuthRequired, err.Error()
 
 	case errors.Is(err, ErrNotJoinedLadder),
-		errors.Is(err, ErrLadderNotActive):
+		errors.Is(err, ErrLadderNotActive),
+		errors.Is(err, ErrAdminRequired):
 		return http.StatusForbidden, TypeForbidden, err.Error()
 
 	case errors.Is(err, ErrPublicProfileNotFoundOrPrivate),
-		errors.Is(err, ErrSymbolNotAllowed):
+		errors.Is(err, ErrSymbolNotAllowed),
+		errors.Is(err, ErrQuoteNotQuarantined),
+		errors.Is(err, ErrBasketNotFound),
+		errors.Is(err, ErrIndexNotFound),
+		errors.Is(err, ErrOptionNotFound),
+		errors.Is(err, ErrPredictionMarketNotFound):
 		return http.StatusNotFound, TypeNotFound, err.Error()
 
 	case errors.Is(err, ErrInsufficientFunds):
@@ -101,10 +131,14 @@ func MatchError(err error) (int, string, string) {
 	case errors.Is(err, ErrInsufficientQuantity):
 		return http.StatusBadRequest, TypeInsufficientQty, err.Error()
 
-	case errors.Is(err, ErrMarketClosed):
+	case errors.Is(err, ErrMarketClosed),
+		errors.Is(err, ErrOptionExpired),
+		errors.Is(err, ErrPredictionMarketClosed):
 		return http.StatusForbidden, TypeMarketClosed, err.Error()
 
-	case errors.Is(err, ErrMarketDataWarmingUp):
+	case errors.Is(err, ErrMarketDataWarmingUp),
+		errors.Is(err, ErrFXRateUnavailable),
+		errors.Is(err, ErrShuttingDown):
 		return http.StatusServiceUnavailable, TypeInternalError, err.Error()
 
 	case errors.Is(err, ErrInternalAuthConfigurationError):
@@ -115,18 +149,47 @@ func MatchError(err error) (int, string, string) {
 	}
 }
 
+// invalidParamFields maps validation errors to the single request field they concern.
+var invalidParamFields = []struct {
+	err   error
+	field string
+}{
+	{ErrAGBNotAccepted, "agb_accepted"},
+	{ErrPasswordTooShort, "password"},
+	{ErrPasswordTooLong, "password"},
+	{ErrInvalidUsernameFormat, "username"},
+	{ErrUsernameNotAllowed, "username"},
+	{ErrInvalidWebsiteFormat, "website"},
+	{ErrInvalidQuantity, "quantity"},
+	{ErrInvalidIndicator, "type"},
+	{ErrInvalidIndicatorPeriod, "period"},
+	{ErrInvalidBasketSymbol, "symbol"},
+	{ErrBasketExists, "symbol"},
+	{ErrInvalidBasketComponents, "components"},
+	{ErrBasketComponentUnpriced, "components"},
+	{ErrBasketComponentCurrency, "components"},
+	{ErrIndexComponentNotListed, "components"},
+	{ErrInvalidRebalanceSchedule, "rebalance"},
+	{ErrIndexNameRequired, "name"},
+	{ErrInvalidOptionType, "type"},
+	{ErrInvalidOptionStrike, "strike"},
+	{ErrInvalidOptionExpiry, "expires_at"},
+	{ErrInvalidOptionVolatility, "volatility"},
+	{ErrOptionUnderlyingNotListed, "underlying"},
+	{ErrInvalidPredictionThreshold, "threshold"},
+	{ErrInvalidPredictionResolution, "resolves_at"},
+	{ErrInvalidPredictionLiquidity, "liquidity"},
+	{ErrPredictionSymbolNotListed, "symbol"},
+	{ErrInvalidPredictionOutcome, "outcome"},
+	{ErrInvalidStake, "amount"},
+}
+
 // ValidationErrorParams maps a domain validation error to one or more InvalidParams.
 func ValidationErrorParams(err error) []InvalidParam {
 	if err == nil {
 		return nil
 	}
 	switch {
-	case errors.Is(err, ErrAGBNotAccepted):
-		return []InvalidParam{{Name: "agb_accepted", Reason: err.Error()}}
-	case errors.Is(err, ErrPasswordTooShort), errors.Is(err, ErrPasswordTooLong):
-		return []InvalidParam{{Name: "password", Reason: err.Error()}}
-	case errors.Is(err, ErrInvalidUsernameFormat), errors.Is(err, ErrUsernameNotAllowed):
-		return []InvalidParam{{Name: "username", Reason: err.Error()}}
 	case errors.Is(err, ErrNameRequired):
 		return []InvalidParam{
 			{Name: "first_name", Reason: err.Error()},
@@ -139,11 +202,13 @@ func ValidationErrorParams(err error) []InvalidParam {
 			{Name: "first_name", Reason: err.Error()},
 			{Name: "last_name", Reason: err.Error()},
 		}
-	case errors.Is(err, ErrInvalidWebsiteFormat):
-		return []InvalidParam{{Name: "website", Reason: err.Error()}}
-	case errors.Is(err, ErrInvalidQuantity):
-		return []InvalidParam{{Name: "quantity", Reason: err.Error()}}
-	default:
-		return nil
 	}
+
+	for _, p := range invalidParamFields {
+		if errors.Is(err, p.err) {
+			return []InvalidParam{{Name: p.field, Reason: err.Error()}}
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,45 @@
+package clients
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// PricePoint is a price observed at a point in time.
+type PricePoint struct {
+	Time  time.Time
+	Price decimal.Decimal
+}
+
+// AggregateCandles groups time-ordered price points into candles of the given resolution,
+// for providers whose history endpoints only report prices. Periods without points produce
+// no candle, and the volume is unknown.
+func AggregateCandles(symbol, source string, points []PricePoint, resolution time.Duration) []*domain.Candle {
+	var candles []*domain.Candle
+
+	var current *domain.Candle
+	for _, p := range points {
+		start := p.Time.Truncate(resolution)
+		if current == nil || !current.Start.Equal(start) {
+			current = &domain.Candle{
+				Symbol:     symbol,
+				Source:     source,
+				Start:      start,
+				Resolution: resolution,
+				Open:       p.Price,
+				High:       p.Price,
+				Low:        p.Price,
+			}
+			candles = append(candles, current)
+		}
+
+		current.High = decimal.Max(current.High, p.Price)
+		current.Low = decimal.Min(current.Low, p.Price)
+		current.Close = p.Price
+	}
+
+	return candles
+}
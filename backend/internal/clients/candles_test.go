@@ -0,0 +1,39 @@
+package clients_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+)
+
+func TestAggregateCandles(t *testing.T) {
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+	point := func(minutes int, price string) clients.PricePoint {
+		return clients.PricePoint{Time: start.Add(time.Duration(minutes) * time.Minute), Price: decimal.RequireFromString(price)}
+	}
+
+	candles := clients.AggregateCandles("bitcoin", "CG", []clients.PricePoint{
+		point(0, "100"), point(5, "104"), point(10, "98"), point(55, "101"),
+		point(125, "110"),
+	}, time.Hour)
+
+	require.Len(t, candles, 2)
+
+	first := candles[0]
+	assert.Equal(t, start, first.Start)
+	assert.Equal(t, start.Add(time.Hour), first.End())
+	assert.Equal(t, "100", first.Open.String())
+	assert.Equal(t, "104", first.High.String())
+	assert.Equal(t, "98", first.Low.String())
+	assert.Equal(t, "101", first.Close.String())
+
+	// The hour without prices has no candle.
+	assert.Equal(t, start.Add(2*time.Hour), candles[1].Start)
+	assert.Equal(t, "110", candles[1].Close.String())
+	assert.Equal(t, "CG", candles[1].Source)
+}
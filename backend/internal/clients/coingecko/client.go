@@ -6,12 +6,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/shopspring/decimal"
 
-	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 )
 
 // Client is a client for the CoinGecko API.
@@ -34,16 +37,56 @@ func NewClient(apiKey string, timeout time.Duration) *Client {
 
 // Response represents the JSON response from CoinGecko.
 // Since the key is dynamic (the coin ID), we use a map, but the value is structured.
+// Prices are decoded as decimals so sub-cent coins keep their precision.
 type Response map[string]struct {
-	USD float64 `json:"usd"`
+	USD           decimal.Decimal `json:"usd"`
+	USD24hChange  decimal.Decimal `json:"usd_24h_change"`
+	LastUpdatedAt int64           `json:"last_updated_at"`
+}
+
+// CoinResponse represents the coin data endpoint's response, reduced to the fields we use.
+type CoinResponse struct {
+	ID     string `json:"id"`
+	Symbol string `json:"symbol"`
+	Name   string `json:"name"`
+}
+
+// MarketChartResponse represents the historical market chart of a coin, reduced to the
+// prices, which are [unix milliseconds, price] pairs.
+type MarketChartResponse struct {
+	Prices [][2]decimal.Decimal `json:"prices"`
 }
 
 // GetQuote fetches the price of a crypto asset.
-func (c *Client) GetQuote(ctx context.Context, symbol string) (*exchange.Quote, error) {
-	id := strings.TrimPrefix(symbol, "CG:")
+func (c *Client) GetQuote(ctx context.Context, symbol string) (*domain.Quote, error) {
+	quotes, err := c.GetQuotes(ctx, []string{symbol})
+	if err != nil {
+		return nil, err
+	}
 
-	url := fmt.Sprintf("%s/simple/price?ids=%s&vs_currencies=usd", c.baseURL, id)
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if len(quotes) == 0 {
+		return nil, fmt.Errorf("symbol not found or no USD price: %s", symbol)
+	}
+
+	return quotes[0], nil
+}
+
+// GetQuotes fetches the prices of several crypto assets in a single request.
+// Symbols CoinGecko does not know are omitted from the result.
+func (c *Client) GetQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error) {
+	ids := make([]string, len(symbols))
+	for i, s := range symbols {
+		ids[i] = domain.ProviderSymbol(s)
+	}
+
+	query := url.Values{}
+	query.Set("ids", strings.Join(ids, ","))
+	query.Set("vs_currencies", "usd")
+	query.Set("include_24hr_change", "true")
+	query.Set("include_last_updated_at", "true")
+
+	reqURL := fmt.Sprintf("%s/simple/price?%s", c.baseURL, query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -60,7 +103,7 @@ func (c *Client) GetQuote(ctx context.Context, symbol string) (*exchange.Quote,
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode == http.StatusTooManyRequests {
-		return nil, fmt.Errorf("rate limited (429)")
+		return nil, clients.NewRateLimitError(resp)
 	}
 
 	if resp.StatusCode != http.StatusOK {
@@ -72,17 +115,161 @@ func (c *Client) GetQuote(ctx context.Context, symbol string) (*exchange.Quote,
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	data, ok := result[id]
-	if !ok {
-		return nil, fmt.Errorf("symbol not found or no USD price: %s", id)
+	quotes := make([]*domain.Quote, 0, len(ids))
+	for i, id := range ids {
+		data, ok := result[id]
+		if !ok || data.USD.IsZero() {
+			continue
+		}
+
+		timestamp := data.LastUpdatedAt
+		if timestamp == 0 {
+			timestamp = time.Now().Unix()
+		}
+
+		quotes = append(quotes, &domain.Quote{
+			Symbol:        symbols[i],
+			Price:         data.USD,
+			Change:        absoluteChange(data.USD, data.USD24hChange),
+			ChangePercent: data.USD24hChange,
+			Timestamp:     time.Unix(timestamp, 0),
+			Source:        "CG",
+		})
 	}
 
-	timestamp := time.Now().Unix()
+	return quotes, nil
+}
+
+// GetProfile fetches the name of a crypto asset. Prices are quoted in USD.
+func (c *Client) GetProfile(ctx context.Context, symbol string) (*domain.Instrument, error) {
+	id := domain.ProviderSymbol(symbol)
+
+	query := url.Values{}
+	query.Set("localization", "false")
+	query.Set("tickers", "false")
+	query.Set("market_data", "false")
+	query.Set("community_data", "false")
+	query.Set("developer_data", "false")
 
-	return &exchange.Quote{
-		Symbol:    id,
-		Price:     data.USD,
-		Timestamp: timestamppb.New(time.Unix(timestamp, 0)),
-		Source:    "CG",
+	reqURL := fmt.Sprintf("%s/coins/%s?%s", c.baseURL, url.PathEscape(id), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "TickerRush/1.0")
+	if c.apiKey != "" {
+		req.Header.Set("x-cg-demo-api-key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, clients.NewRateLimitError(resp)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	var coin CoinResponse
+	if err := json.NewDecoder(resp.Body).Decode(&coin); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &domain.Instrument{
+		Symbol:     symbol,
+		Name:       coin.Name,
+		AssetClass: domain.AssetClassCrypto,
+		Currency:   "USD",
+		Exchange:   "CoinGecko",
+		TickSize:   decimal.New(1, -8),
 	}, nil
 }
+
+// GetCandles fetches the price history of a crypto asset in [from, to) and aggregates it
+// into candles. CoinGecko picks the granularity of the history from the length of the
+// range, see CandleWindow.
+func (c *Client) GetCandles(
+	ctx context.Context,
+	symbol string,
+	resolution time.Duration,
+	from, to time.Time,
+) ([]*domain.Candle, error) {
+	id := domain.ProviderSymbol(symbol)
+
+	query := url.Values{}
+	query.Set("vs_currency", "usd")
+	query.Set("from", strconv.FormatInt(from.Unix(), 10))
+	query.Set("to", strconv.FormatInt(to.Unix(), 10))
+
+	reqURL := fmt.Sprintf("%s/coins/%s/market_chart/range?%s", c.baseURL, url.PathEscape(id), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "TickerRush/1.0")
+	if c.apiKey != "" {
+		req.Header.Set("x-cg-demo-api-key", c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, clients.NewRateLimitError(resp)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	var chart MarketChartResponse
+	if err := json.NewDecoder(resp.Body).Decode(&chart); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	points := make([]clients.PricePoint, 0, len(chart.Prices))
+	for _, p := range chart.Prices {
+		ts := time.UnixMilli(p[0].IntPart())
+		if ts.Before(from) || !ts.Before(to) {
+			continue
+		}
+		points = append(points, clients.PricePoint{Time: ts, Price: p[1]})
+	}
+
+	return clients.AggregateCandles(symbol, "CG", points, resolution), nil
+}
+
+// CandleWindow returns the longest range GetCandles fetches in one request while still
+// getting prices at least as often as the resolution: CoinGecko reports 5-minute prices
+// for ranges up to a day and hourly prices for ranges up to 90 days.
+func (c *Client) CandleWindow(resolution time.Duration) time.Duration {
+	switch {
+	case resolution < time.Hour:
+		return 24 * time.Hour
+	case resolution < 24*time.Hour:
+		return 90 * 24 * time.Hour
+	default:
+		return 365 * 24 * time.Hour
+	}
+}
+
+// absoluteChange derives the 24h price change from the current price and the
+// percentage change, since CoinGecko only reports the latter.
+func absoluteChange(price, changePercent decimal.Decimal) decimal.Decimal {
+	hundred := decimal.NewFromInt(100)
+	if changePercent.LessThanOrEqual(hundred.Neg()) {
+		return decimal.Zero
+	}
+
+	return price.Mul(changePercent).Div(hundred.Add(changePercent))
+}
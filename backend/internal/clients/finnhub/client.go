@@ -7,20 +7,52 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"strings"
+	"net/url"
+	"strconv"
 	"time"
 
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/shopspring/decimal"
 
-	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 )
 
 // Response represents a stock quote from the Finnhub API.
+// Prices are decoded as decimals so no precision is lost to float64.
 type Response struct {
-	CurrentPrice  float64 `json:"c"`  // c = Current price
-	Change        float64 `json:"d"`  // d = Change
-	PercentChange float64 `json:"dp"` // dp = Percent change
-	Timestamp     int64   `json:"t"`  // t = Timestamp
+	CurrentPrice  decimal.Decimal `json:"c"`  // c = Current price
+	Change        decimal.Decimal `json:"d"`  // d = Change
+	PercentChange decimal.Decimal `json:"dp"` // dp = Percent change
+	Timestamp     int64           `json:"t"`  // t = Timestamp
+}
+
+// ProfileResponse represents a company profile from the Finnhub API.
+type ProfileResponse struct {
+	Name     string `json:"name"`
+	Exchange string `json:"exchange"`
+	Currency string `json:"currency"`
+}
+
+// CandleResponse represents historical candles from the Finnhub API, as parallel arrays.
+type CandleResponse struct {
+	Open   []decimal.Decimal `json:"o"`
+	High   []decimal.Decimal `json:"h"`
+	Low    []decimal.Decimal `json:"l"`
+	Close  []decimal.Decimal `json:"c"`
+	Volume []decimal.Decimal `json:"v"`
+	Time   []int64           `json:"t"`
+	Status string            `json:"s"` // "ok" or "no_data"
+}
+
+// candleResolutions maps candle resolutions to Finnhub's resolution parameter.
+var candleResolutions = map[time.Duration]string{
+	time.Minute:        "1",
+	5 * time.Minute:    "5",
+	15 * time.Minute:   "15",
+	30 * time.Minute:   "30",
+	time.Hour:          "60",
+	24 * time.Hour:     "D",
+	7 * 24 * time.Hour: "W",
 }
 
 // Client is a client for the Finnhub API.
@@ -40,8 +72,8 @@ func NewClient(apiKey string, timeout time.Duration) *Client {
 }
 
 // GetQuote fetches a stock quote for a given symbol.
-func (c *Client) GetQuote(ctx context.Context, symbol string) (*exchange.Quote, error) {
-	apiSymbol := strings.TrimPrefix(symbol, "FH:")
+func (c *Client) GetQuote(ctx context.Context, symbol string) (*domain.Quote, error) {
+	apiSymbol := domain.ProviderSymbol(symbol)
 
 	url := fmt.Sprintf("%s/quote?symbol=%s&token=%s", c.baseURL, apiSymbol, c.apiKey)
 
@@ -57,6 +89,10 @@ func (c *Client) GetQuote(ctx context.Context, symbol string) (*exchange.Quote,
 
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, clients.NewRateLimitError(resp)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("API status: %d", resp.StatusCode)
 	}
@@ -66,7 +102,7 @@ func (c *Client) GetQuote(ctx context.Context, symbol string) (*exchange.Quote,
 		return nil, fmt.Errorf("json error: %w", err)
 	}
 
-	if fq.CurrentPrice == 0 {
+	if fq.CurrentPrice.IsZero() {
 		return nil, errors.New("zero price received")
 	}
 
@@ -75,12 +111,146 @@ func (c *Client) GetQuote(ctx context.Context, symbol string) (*exchange.Quote,
 		ts = time.Now().Unix()
 	}
 
-	return &exchange.Quote{
-		Symbol:        apiSymbol,
+	return &domain.Quote{
+		Symbol:        symbol,
 		Price:         fq.CurrentPrice,
 		Change:        fq.Change,
 		ChangePercent: fq.PercentChange,
-		Timestamp:     timestamppb.New(time.Unix(ts, 0)),
+		Timestamp:     time.Unix(ts, 0),
 		Source:        "FH",
 	}, nil
 }
+
+// GetProfile fetches the company profile of a stock symbol.
+func (c *Client) GetProfile(ctx context.Context, symbol string) (*domain.Instrument, error) {
+	apiSymbol := domain.ProviderSymbol(symbol)
+
+	url := fmt.Sprintf("%s/stock/profile2?symbol=%s&token=%s", c.baseURL, apiSymbol, c.apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("network error: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, clients.NewRateLimitError(resp)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API status: %d", resp.StatusCode)
+	}
+
+	var profile ProfileResponse
+	if err := json.NewDecoder(resp.Body).Decode(&profile); err != nil {
+		return nil, fmt.Errorf("json error: %w", err)
+	}
+
+	// Finnhub answers unknown symbols with an empty object.
+	if profile.Name == "" {
+		return nil, fmt.Errorf("no profile for symbol: %s", apiSymbol)
+	}
+
+	currency := profile.Currency
+	if currency == "" {
+		currency = "USD"
+	}
+
+	return &domain.Instrument{
+		Symbol:     symbol,
+		Name:       profile.Name,
+		AssetClass: domain.AssetClassStock,
+		Currency:   currency,
+		Exchange:   profile.Exchange,
+		TickSize:   decimal.New(1, -2),
+	}, nil
+}
+
+// GetCandles fetches the candles of a stock symbol that start in [from, to).
+func (c *Client) GetCandles(
+	ctx context.Context,
+	symbol string,
+	resolution time.Duration,
+	from, to time.Time,
+) ([]*domain.Candle, error) {
+	apiSymbol := domain.ProviderSymbol(symbol)
+
+	res, ok := candleResolutions[resolution]
+	if !ok {
+		return nil, fmt.Errorf("unsupported candle resolution: %s", domain.ResolutionName(resolution))
+	}
+
+	query := url.Values{}
+	query.Set("symbol", apiSymbol)
+	query.Set("resolution", res)
+	query.Set("from", strconv.FormatInt(from.Unix(), 10))
+	query.Set("to", strconv.FormatInt(to.Unix()-1, 10))
+	query.Set("token", c.apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/stock/candle?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("network error: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, clients.NewRateLimitError(resp)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API status: %d", resp.StatusCode)
+	}
+
+	var fc CandleResponse
+	if err := json.NewDecoder(resp.Body).Decode(&fc); err != nil {
+		return nil, fmt.Errorf("json error: %w", err)
+	}
+
+	if fc.Status == "no_data" {
+		return nil, nil
+	}
+
+	n := len(fc.Time)
+	if len(fc.Open) != n || len(fc.High) != n || len(fc.Low) != n || len(fc.Close) != n || len(fc.Volume) != n {
+		return nil, errors.New("malformed candle response")
+	}
+
+	candles := make([]*domain.Candle, n)
+	for i, t := range fc.Time {
+		candles[i] = &domain.Candle{
+			Symbol:     symbol,
+			Source:     "FH",
+			Start:      time.Unix(t, 0),
+			Resolution: resolution,
+			Open:       fc.Open[i],
+			High:       fc.High[i],
+			Low:        fc.Low[i],
+			Close:      fc.Close[i],
+			Volume:     fc.Volume[i],
+		}
+	}
+
+	return candles, nil
+}
+
+// CandleWindow returns the longest range GetCandles fetches in one request: a month of
+// intraday candles or a year of daily ones.
+func (c *Client) CandleWindow(resolution time.Duration) time.Duration {
+	if resolution < 24*time.Hour {
+		return 30 * 24 * time.Hour
+	}
+
+	return 365 * 24 * time.Hour
+}
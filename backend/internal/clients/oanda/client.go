@@ -0,0 +1,313 @@
+// Package oanda provides a client for the OANDA v20 REST API, the source of currency pair quotes.
+package oanda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// DefaultBaseURL is the API of OANDA's practice environment, which free accounts can use.
+const DefaultBaseURL = "https://api-fxpractice.oanda.com"
+
+// PricingResponse represents the current prices of currency pairs, reduced to the fields
+// we use. Prices are decimal strings.
+type PricingResponse struct {
+	Prices []struct {
+		Instrument string    `json:"instrument"`
+		Time       time.Time `json:"time"`
+		Tradeable  bool      `json:"tradeable"`
+		Bids       []struct {
+			Price decimal.Decimal `json:"price"`
+		} `json:"bids"`
+		Asks []struct {
+			Price decimal.Decimal `json:"price"`
+		} `json:"asks"`
+	} `json:"prices"`
+}
+
+// InstrumentsResponse represents the instruments an account can trade, reduced to the
+// fields we use.
+type InstrumentsResponse struct {
+	Instruments []struct {
+		Name             string `json:"name"`
+		DisplayName      string `json:"displayName"`
+		Type             string `json:"type"`
+		PipLocation      int32  `json:"pipLocation"`
+		DisplayPrecision int32  `json:"displayPrecision"`
+	} `json:"instruments"`
+}
+
+// CandlesResponse represents mid-price candles of an instrument, reduced to the opens.
+type CandlesResponse struct {
+	Candles []struct {
+		Time time.Time `json:"time"`
+		Mid  struct {
+			Open decimal.Decimal `json:"o"`
+		} `json:"mid"`
+	} `json:"candles"`
+}
+
+// dailyOpen is the opening mid price of a currency pair's current trading day.
+type dailyOpen struct {
+	start time.Time
+	price decimal.Decimal
+}
+
+// Client is a client for the OANDA v20 REST API. Symbols are OANDA instrument names such
+// as EUR_USD, optionally qualified as instrument IDs.
+type Client struct {
+	baseURL    string
+	token      string
+	accountID  string
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	opens map[string]dailyOpen
+}
+
+// NewClient creates a new OANDA client for an account. An empty baseURL selects DefaultBaseURL.
+func NewClient(baseURL, token, accountID string, timeout time.Duration) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	return &Client{
+		baseURL:   strings.TrimSuffix(baseURL, "/"),
+		token:     token,
+		accountID: accountID,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+		opens: make(map[string]dailyOpen),
+	}
+}
+
+// GetQuote fetches the bid and ask of a currency pair.
+func (c *Client) GetQuote(ctx context.Context, symbol string) (*domain.Quote, error) {
+	quotes, err := c.GetQuotes(ctx, []string{symbol})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(quotes) == 0 {
+		return nil, fmt.Errorf("no price for instrument: %s", symbol)
+	}
+
+	return quotes[0], nil
+}
+
+// GetQuotes fetches the bids and asks of several currency pairs in a single request.
+// The price of each quote is the mid price, and its change is relative to the open of the
+// trading day. Pairs OANDA does not price are omitted from the result.
+func (c *Client) GetQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error) {
+	names := make([]string, len(symbols))
+	for i, s := range symbols {
+		names[i] = domain.ProviderSymbol(s)
+	}
+
+	query := url.Values{}
+	query.Set("instruments", strings.Join(names, ","))
+
+	var pricing PricingResponse
+	if err := c.get(ctx, fmt.Sprintf("/v3/accounts/%s/pricing", url.PathEscape(c.accountID)), query, &pricing); err != nil {
+		return nil, err
+	}
+
+	bySymbol := make(map[string]string, len(names))
+	for i, name := range names {
+		bySymbol[name] = symbols[i]
+	}
+
+	quotes := make([]*domain.Quote, 0, len(pricing.Prices))
+	for _, p := range pricing.Prices {
+		symbol, ok := bySymbol[p.Instrument]
+		if !ok || len(p.Bids) == 0 || len(p.Asks) == 0 {
+			continue
+		}
+
+		bid, ask := p.Bids[0].Price, p.Asks[0].Price
+		mid := bid.Add(ask).Div(decimal.NewFromInt(2))
+
+		quote := &domain.Quote{
+			Symbol:    symbol,
+			Price:     mid,
+			Bid:       bid,
+			Ask:       ask,
+			Timestamp: p.Time,
+			Source:    "OA",
+			// OANDA reports pairs as untradeable while the FX market is closed.
+			IsClosed: !p.Tradeable,
+		}
+
+		if open, err := c.dailyOpen(ctx, p.Instrument, time.Now()); err == nil && open.IsPositive() {
+			quote.Change = mid.Sub(open)
+			quote.ChangePercent = quote.Change.Div(open).Mul(decimal.NewFromInt(100))
+		}
+
+		quotes = append(quotes, quote)
+	}
+
+	return quotes, nil
+}
+
+// dailyOpen returns the opening mid price of the instrument's current trading day, which
+// is fetched once per day.
+func (c *Client) dailyOpen(ctx context.Context, name string, now time.Time) (decimal.Decimal, error) {
+	c.mu.Lock()
+	open, ok := c.opens[name]
+	c.mu.Unlock()
+
+	if ok && now.Before(open.start.Add(24*time.Hour)) {
+		return open.price, nil
+	}
+
+	query := url.Values{}
+	query.Set("granularity", "D")
+	query.Set("count", "1")
+	query.Set("price", "M")
+
+	var candles CandlesResponse
+	if err := c.get(ctx, fmt.Sprintf("/v3/instruments/%s/candles", url.PathEscape(name)), query, &candles); err != nil {
+		return decimal.Zero, err
+	}
+
+	if len(candles.Candles) == 0 {
+		return decimal.Zero, fmt.Errorf("no daily candle for instrument: %s", name)
+	}
+
+	open = dailyOpen{start: candles.Candles[0].Time, price: candles.Candles[0].Mid.Open}
+
+	c.mu.Lock()
+	c.opens[name] = open
+	c.mu.Unlock()
+
+	return open.price, nil
+}
+
+// usdQuotedCurrencies are the currencies OANDA quotes against USD as XXX_USD. All others
+// are quoted as USD_XXX, so their USD value is the inverse of the pair's price.
+var usdQuotedCurrencies = map[string]bool{"EUR": true, "GBP": true, "AUD": true, "NZD": true, "XAU": true, "XAG": true}
+
+// usdPair returns the OANDA instrument pairing the currency with USD, and whether its
+// price has to be inverted to get the USD value of one unit of the currency.
+func usdPair(currency string) (string, bool) {
+	if usdQuotedCurrencies[currency] {
+		return currency + "_USD", false
+	}
+
+	return "USD_" + currency, true
+}
+
+// GetRates fetches the USD value of one unit of each currency from the mid price of its
+// pair with USD. Rates are reported as current even while the FX market is closed, as the
+// last price is then the rate a conversion would use. Currencies OANDA does not price are
+// omitted from the result.
+func (c *Client) GetRates(ctx context.Context, currencies []string) ([]*domain.FXRate, error) {
+	pairs := make([]string, len(currencies))
+	for i, currency := range currencies {
+		pairs[i], _ = usdPair(currency)
+	}
+
+	quotes, err := c.GetQuotes(ctx, pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	byPair := make(map[string]*domain.Quote, len(quotes))
+	for _, q := range quotes {
+		byPair[q.Symbol] = q
+	}
+
+	rates := make([]*domain.FXRate, 0, len(quotes))
+	for _, currency := range currencies {
+		pair, inverse := usdPair(currency)
+		q, ok := byPair[pair]
+		if !ok || !q.Price.IsPositive() {
+			continue
+		}
+
+		rate := q.Price
+		if inverse {
+			rate = decimal.NewFromInt(1).DivRound(q.Price, 10)
+		}
+		rates = append(rates, &domain.FXRate{Currency: currency, Rate: rate, UpdatedAt: time.Now()})
+	}
+
+	return rates, nil
+}
+
+// GetProfile fetches the display name, precision and pip size of a currency pair. Pairs
+// are quoted in their second currency, e.g. USD for EUR_USD.
+func (c *Client) GetProfile(ctx context.Context, symbol string) (*domain.Instrument, error) {
+	name := domain.ProviderSymbol(symbol)
+
+	query := url.Values{}
+	query.Set("instruments", name)
+
+	var result InstrumentsResponse
+	if err := c.get(ctx, fmt.Sprintf("/v3/accounts/%s/instruments", url.PathEscape(c.accountID)), query, &result); err != nil {
+		return nil, err
+	}
+
+	if len(result.Instruments) == 0 {
+		return nil, fmt.Errorf("no profile for instrument: %s", name)
+	}
+	instrument := result.Instruments[0]
+
+	_, currency, found := strings.Cut(instrument.Name, "_")
+	if instrument.Type != "CURRENCY" || !found {
+		return nil, fmt.Errorf("not a currency pair: %s", name)
+	}
+
+	return &domain.Instrument{
+		Symbol:     symbol,
+		Name:       instrument.DisplayName,
+		AssetClass: domain.AssetClassForex,
+		Currency:   currency,
+		Exchange:   "OANDA",
+		TickSize:   decimal.New(1, -instrument.DisplayPrecision),
+		PipSize:    decimal.New(1, instrument.PipLocation),
+	}, nil
+}
+
+// get sends an authenticated GET request and decodes the JSON response into v.
+func (c *Client) get(ctx context.Context, path string, query url.Values, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept-Datetime-Format", "RFC3339")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return clients.NewRateLimitError(resp)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status: %d", resp.StatusCode)
+	}
+
+	if decodeErr := json.NewDecoder(resp.Body).Decode(v); decodeErr != nil {
+		return fmt.Errorf("failed to decode response: %w", decodeErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package oanda
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+func TestClient(t *testing.T) {
+	candleRequests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/accounts/acc-1/pricing", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Contains(t, r.URL.Query().Get("instruments"), "EUR_USD")
+		_, _ = w.Write([]byte(`{"prices":[{"instrument":"EUR_USD","time":"2026-03-02T10:00:00Z","tradeable":true,
+			"bids":[{"price":"1.08410"}],"asks":[{"price":"1.08430"}]},{"instrument":"USD_JPY","time":"2026-03-02T10:00:00Z",
+			"tradeable":true,"bids":[{"price":"159.990"}],"asks":[{"price":"160.010"}]}]}`))
+	})
+	mux.HandleFunc("/v3/instruments/EUR_USD/candles", func(w http.ResponseWriter, r *http.Request) {
+		candleRequests++
+		assert.Equal(t, "D", r.URL.Query().Get("granularity"))
+		_, _ = w.Write([]byte(`{"candles":[{"time":"` + time.Now().Add(-time.Hour).Format(time.RFC3339) + `","mid":{"o":"1.08000"}}]}`))
+	})
+	mux.HandleFunc("/v3/accounts/acc-1/instruments", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"instruments":[{"name":"USD_JPY","displayName":"USD/JPY","type":"CURRENCY",
+			"pipLocation":-2,"displayPrecision":3}]}`))
+	})
+	mux.HandleFunc("/v3/accounts/limited/pricing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := NewClient(server.URL, "token", "acc-1", time.Second)
+	ctx := context.Background()
+
+	t.Run("QuotesMidWithBidAndAsk", func(t *testing.T) {
+		quotes, err := client.GetQuotes(ctx, []string{"OA:EUR_USD", "OA:XAU_XAG"})
+		require.NoError(t, err)
+		require.Len(t, quotes, 1)
+
+		q := quotes[0]
+		assert.Equal(t, "OA:EUR_USD", q.Symbol)
+		assert.Equal(t, "1.0842", q.Price.String())
+		assert.Equal(t, "1.0841", q.Bid.String())
+		assert.Equal(t, "1.0843", q.Ask.String())
+		assert.Equal(t, "0.0042", q.Change.String())
+		assert.False(t, q.IsClosed)
+		assert.Equal(t, time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC), q.Timestamp.UTC())
+	})
+
+	t.Run("FetchesDailyOpenOnce", func(t *testing.T) {
+		_, err := client.GetQuote(ctx, "OA:EUR_USD")
+		require.NoError(t, err)
+		assert.Equal(t, 1, candleRequests)
+	})
+
+	t.Run("RatesAgainstUSD", func(t *testing.T) {
+		rates, err := client.GetRates(ctx, []string{"EUR", "JPY", "CHF"})
+		require.NoError(t, err)
+		require.Len(t, rates, 2)
+
+		assert.Equal(t, "EUR", rates[0].Currency)
+		assert.Equal(t, "1.0842", rates[0].Rate.String())
+		assert.Equal(t, "JPY", rates[1].Currency)
+		assert.Equal(t, "0.00625", rates[1].Rate.String())
+	})
+
+	t.Run("ProfileOfPair", func(t *testing.T) {
+		instrument, err := client.GetProfile(ctx, "OA:USD_JPY")
+		require.NoError(t, err)
+		assert.Equal(t, "OA:USD_JPY", instrument.Symbol)
+		assert.Equal(t, domain.AssetClassForex, instrument.AssetClass)
+		assert.Equal(t, "JPY", instrument.Currency)
+		assert.Equal(t, "0.001", instrument.TickSize.String())
+		assert.Equal(t, "0.01", instrument.PipSize.String())
+	})
+
+	t.Run("RateLimited", func(t *testing.T) {
+		limited := NewClient(server.URL, "token", "limited", time.Second)
+
+		_, err := limited.GetQuote(ctx, "EUR_USD")
+		assert.ErrorIs(t, err, clients.ErrRateLimited)
+	})
+}
@@ -0,0 +1,61 @@
+// Package clients contains helpers shared by the market data provider clients.
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrRateLimited is returned when a provider rejects a request with HTTP 429.
+var ErrRateLimited = errors.New("rate limited (429)")
+
+// RateLimitError is returned by provider clients when a request was rate limited.
+// RetryAfter is zero when the provider did not say how long to wait.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s, retry after %s", ErrRateLimited, e.RetryAfter)
+	}
+
+	return ErrRateLimited.Error()
+}
+
+// Unwrap allows errors.Is(err, ErrRateLimited).
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// NewRateLimitError builds a RateLimitError from the Retry-After header of a 429 response.
+func NewRateLimitError(resp *http.Response) *RateLimitError {
+	return &RateLimitError{RetryAfter: ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
+}
+
+// ParseRetryAfter parses a Retry-After header value given either in seconds
+// or as an HTTP date. It returns zero for missing or malformed values.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,264 @@
+// Package replay provides a quote provider that plays back recorded price series
+// from local CSV or Parquet files.
+package replay
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// Source is the quote source reported by the replay provider.
+const Source = "REPLAY"
+
+// ErrNoData is returned when there is no recording for a symbol.
+var ErrNoData = errors.New("no replay data")
+
+// Config configures the replay provider.
+type Config struct {
+	// Dir holds one recording per symbol, named <symbol>.csv or <symbol>.parquet.
+	Dir string
+	// Speed is how many seconds of recorded time pass per second of wall time.
+	Speed float64
+	// StartOffset skips this much of each recording before playback starts.
+	StartOffset time.Duration
+	// Epoch is the wall-clock time at which playback starts, usually the ladder start.
+	// Zero means the time the client was created.
+	Epoch time.Time
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+type point struct {
+	ts    time.Time
+	price decimal.Decimal
+}
+
+// parquetRow is the expected Parquet schema: a TIMESTAMP column and a DOUBLE column.
+type parquetRow struct {
+	Timestamp time.Time `parquet:"timestamp,timestamp"`
+	Price     float64   `parquet:"price"`
+}
+
+// Client replays recorded quotes, mapping their timestamps onto the ladder clock.
+type Client struct {
+	cfg    Config
+	mu     sync.Mutex
+	series map[string][]point
+}
+
+// NewClient creates a new replay provider.
+func NewClient(cfg Config) *Client {
+	if cfg.Speed <= 0 {
+		cfg.Speed = 1
+	}
+
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	if cfg.Epoch.IsZero() {
+		cfg.Epoch = cfg.Now()
+	}
+
+	return &Client{
+		cfg:    cfg,
+		series: make(map[string][]point),
+	}
+}
+
+// GetQuote returns the recorded quote of symbol at the current point of playback.
+func (c *Client) GetQuote(_ context.Context, symbol string) (*domain.Quote, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	points, err := c.load(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.quote(symbol, points, c.cfg.Now()), nil
+}
+
+// GetQuotes returns the current quotes of all symbols. Symbols without a recording are skipped.
+func (c *Client) GetQuotes(_ context.Context, symbols []string) ([]*domain.Quote, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.cfg.Now()
+	quotes := make([]*domain.Quote, 0, len(symbols))
+	for _, symbol := range symbols {
+		points, err := c.load(symbol)
+		if errors.Is(err, ErrNoData) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		quotes = append(quotes, c.quote(symbol, points, now))
+	}
+
+	return quotes, nil
+}
+
+func (c *Client) quote(symbol string, points []point, now time.Time) *domain.Quote {
+	start := points[0].ts.Add(c.cfg.StartOffset)
+	elapsed := max(now.Sub(c.cfg.Epoch), 0)
+	playhead := start.Add(time.Duration(float64(elapsed) * c.cfg.Speed))
+
+	open := points[at(points, start)]
+	current := points[at(points, playhead)]
+
+	ts := c.cfg.Epoch.Add(time.Duration(float64(current.ts.Sub(start)) / c.cfg.Speed))
+	if ts.Before(c.cfg.Epoch) {
+		ts = c.cfg.Epoch
+	}
+
+	change := current.price.Sub(open.price)
+
+	return &domain.Quote{
+		Symbol:        symbol,
+		Price:         current.price,
+		Change:        change,
+		ChangePercent: change.Div(open.price).Mul(decimal.NewFromInt(100)),
+		Timestamp:     ts,
+		Source:        Source,
+	}
+}
+
+// at returns the index of the last point recorded at or before t, or the first point if t precedes them all.
+func at(points []point, t time.Time) int {
+	i := sort.Search(len(points), func(i int) bool { return points[i].ts.After(t) })
+
+	return max(i-1, 0)
+}
+
+func (c *Client) load(symbol string) ([]point, error) {
+	if points, ok := c.series[symbol]; ok {
+		return points, nil
+	}
+
+	// Recordings are named after the provider symbol, e.g. AAPL.csv for FH:AAPL.
+	points, err := readSeries(c.cfg.Dir, domain.ProviderSymbol(symbol))
+	if err != nil {
+		return nil, err
+	}
+	c.series[symbol] = points
+
+	return points, nil
+}
+
+func readSeries(dir, symbol string) ([]point, error) {
+	base := filepath.Join(dir, filepath.Base(symbol))
+
+	points, err := readCSV(base + ".csv")
+	if errors.Is(err, fs.ErrNotExist) {
+		points, err = readParquet(base + ".parquet")
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w for %s in %s", ErrNoData, symbol, dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replay data for %s: %w", symbol, err)
+	}
+
+	points = slices.DeleteFunc(points, func(p point) bool { return !p.price.IsPositive() })
+	if len(points) == 0 {
+		return nil, fmt.Errorf("%w for %s: recording has no valid prices", ErrNoData, symbol)
+	}
+
+	slices.SortStableFunc(points, func(a, b point) int { return a.ts.Compare(b.ts) })
+
+	return points, nil
+}
+
+// readCSV reads a file with a "timestamp,price" header. Timestamps are RFC 3339 or Unix seconds.
+func readCSV(path string) ([]point, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	r := csv.NewReader(f)
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header of %s: %w", path, err)
+	}
+
+	tsCol, priceCol := slices.Index(header, "timestamp"), slices.Index(header, "price")
+	if tsCol < 0 || priceCol < 0 {
+		return nil, fmt.Errorf("%s must have timestamp and price columns", path)
+	}
+
+	var points []point
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		ts, err := parseTimestamp(record[tsCol])
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp in %s: %w", path, err)
+		}
+
+		price, err := decimal.NewFromString(strings.TrimSpace(record[priceCol]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid price in %s: %w", path, err)
+		}
+
+		points = append(points, point{ts: ts, price: price})
+	}
+
+	return points, nil
+}
+
+func parseTimestamp(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if secs, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func readParquet(path string) ([]point, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+
+	rows, err := parquet.ReadFile[parquetRow](path)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]point, len(rows))
+	for i, row := range rows {
+		points[i] = point{ts: row.Timestamp, price: decimal.NewFromFloat(row.Price)}
+	}
+
+	return points, nil
+}
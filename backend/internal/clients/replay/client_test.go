@@ -0,0 +1,83 @@
+package replay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Replay(t *testing.T) {
+	dir := t.TempDir()
+	recorded := time.Date(2020, 3, 9, 14, 30, 0, 0, time.UTC)
+
+	csv := "timestamp,price\n" +
+		"2020-03-09T14:30:00Z,100\n" +
+		"2020-03-09T14:31:00Z,90\n" +
+		"1583764320,80\n" // 14:32:00
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "AAPL.csv"), []byte(csv), 0o600))
+	require.NoError(t, parquet.WriteFile(filepath.Join(dir, "bitcoin.parquet"), []parquetRow{
+		{Timestamp: recorded, Price: 8000},
+		{Timestamp: recorded.Add(time.Minute), Price: 7000},
+	}))
+
+	epoch := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := epoch
+	client := NewClient(Config{Dir: dir, Speed: 2, Epoch: epoch, Now: func() time.Time { return now }})
+	ctx := context.Background()
+
+	t.Run("Maps Recorded Time Onto Ladder Clock", func(t *testing.T) {
+		now = epoch.Add(30 * time.Second)
+
+		q, err := client.GetQuote(ctx, "AAPL")
+		require.NoError(t, err)
+		assert.Equal(t, "90", q.Price.String())
+		assert.Equal(t, "-10", q.Change.String())
+		assert.Equal(t, epoch.Add(30*time.Second), q.Timestamp)
+		assert.Equal(t, Source, q.Source)
+	})
+
+	t.Run("Holds Last Price When Exhausted", func(t *testing.T) {
+		now = epoch.Add(time.Hour)
+
+		q, err := client.GetQuote(ctx, "AAPL")
+		require.NoError(t, err)
+		assert.Equal(t, "80", q.Price.String())
+		assert.Equal(t, epoch.Add(time.Minute), q.Timestamp)
+	})
+
+	t.Run("Reads Parquet And Skips Missing", func(t *testing.T) {
+		now = epoch
+
+		quotes, err := client.GetQuotes(ctx, []string{"bitcoin", "MSFT"})
+		require.NoError(t, err)
+		require.Len(t, quotes, 1)
+		assert.Equal(t, "8000", quotes[0].Price.String())
+
+		_, err = client.GetQuote(ctx, "MSFT")
+		assert.ErrorIs(t, err, ErrNoData)
+	})
+
+	t.Run("Reads Recording Of Instrument ID", func(t *testing.T) {
+		now = epoch
+
+		q, err := client.GetQuote(ctx, "FH:AAPL")
+		require.NoError(t, err)
+		assert.Equal(t, "FH:AAPL", q.Symbol)
+		assert.Equal(t, "100", q.Price.String())
+	})
+
+	t.Run("Start Offset", func(t *testing.T) {
+		offset := NewClient(Config{Dir: dir, StartOffset: time.Minute, Epoch: epoch, Now: func() time.Time { return epoch }})
+
+		q, err := offset.GetQuote(ctx, "AAPL")
+		require.NoError(t, err)
+		assert.Equal(t, "90", q.Price.String())
+		assert.True(t, q.Change.IsZero())
+	})
+}
@@ -0,0 +1,204 @@
+// Package synthetic provides an offline quote provider that simulates prices
+// with geometric Brownian motion.
+package synthetic
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// Source is the quote source reported by the synthetic provider.
+const Source = "SYN"
+
+const hoursPerYear = 24 * 365
+
+// Params describes the price process of a single symbol.
+// Drift and Volatility are annualized, e.g. 0.05 and 0.3 for 5% and 30%.
+type Params struct {
+	StartPrice float64
+	Drift      float64
+	Volatility float64
+}
+
+// Config configures the synthetic provider.
+type Config struct {
+	// Seed makes every symbol's price path reproducible. Zero picks a random seed.
+	Seed int64
+	// Step is the simulated time that passes between two quotes of a symbol.
+	Step time.Duration
+	// JumpProbability is the chance that a step includes a price shock.
+	JumpProbability float64
+	// JumpVolatility is the standard deviation of the log-size of a shock.
+	JumpVolatility float64
+	// Defaults applies to symbols that have no entry in Symbols.
+	Defaults Params
+	Symbols  map[string]Params
+}
+
+// DefaultParams is used for symbols without explicit configuration.
+var DefaultParams = Params{StartPrice: 100, Drift: 0.05, Volatility: 0.3}
+
+type path struct {
+	rng    *rand.Rand
+	params Params
+	price  float64
+}
+
+// Client generates quotes without talking to any external API.
+type Client struct {
+	cfg   Config
+	seed  uint64
+	mu    sync.Mutex
+	paths map[string]*path
+}
+
+// NewClient creates a new synthetic provider.
+func NewClient(cfg Config) *Client {
+	seed := uint64(cfg.Seed)
+	if cfg.Seed == 0 {
+		seed = rand.Uint64()
+	}
+
+	if cfg.Step <= 0 {
+		cfg.Step = 10 * time.Second
+	}
+
+	if cfg.Defaults == (Params{}) {
+		cfg.Defaults = DefaultParams
+	}
+
+	return &Client{
+		cfg:   cfg,
+		seed:  seed,
+		paths: make(map[string]*path),
+	}
+}
+
+// GetQuote advances the price path of symbol by one step.
+func (c *Client) GetQuote(_ context.Context, symbol string) (*domain.Quote, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.step(symbol, time.Now()), nil
+}
+
+// GetQuotes advances the price paths of all symbols by one step.
+func (c *Client) GetQuotes(_ context.Context, symbols []string) ([]*domain.Quote, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	quotes := make([]*domain.Quote, len(symbols))
+	for i, symbol := range symbols {
+		quotes[i] = c.step(symbol, now)
+	}
+
+	return quotes, nil
+}
+
+func (c *Client) step(symbol string, now time.Time) *domain.Quote {
+	p := c.path(symbol)
+
+	dt := c.cfg.Step.Hours() / hoursPerYear
+	vol := p.params.Volatility
+	logReturn := (p.params.Drift-vol*vol/2)*dt + vol*math.Sqrt(dt)*p.rng.NormFloat64()
+
+	if c.cfg.JumpProbability > 0 && p.rng.Float64() < c.cfg.JumpProbability {
+		logReturn += c.cfg.JumpVolatility * p.rng.NormFloat64()
+	}
+
+	p.price *= math.Exp(logReturn)
+
+	change := p.price - p.params.StartPrice
+
+	return &domain.Quote{
+		Symbol:        symbol,
+		Price:         decimal.NewFromFloat(p.price),
+		Change:        decimal.NewFromFloat(change),
+		ChangePercent: decimal.NewFromFloat(change / p.params.StartPrice * 100),
+		Timestamp:     now,
+		Source:        Source,
+	}
+}
+
+func (c *Client) path(symbol string) *path {
+	if p, ok := c.paths[symbol]; ok {
+		return p
+	}
+
+	// Settings may name the instrument ID or just its provider symbol.
+	params, ok := c.cfg.Symbols[symbol]
+	if !ok {
+		params, ok = c.cfg.Symbols[domain.ProviderSymbol(symbol)]
+	}
+	if !ok {
+		params = c.cfg.Defaults
+	}
+
+	// Each symbol gets its own stream so its path does not depend on which
+	// other symbols are requested or in what order.
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(symbol))
+
+	p := &path{
+		rng:    rand.New(rand.NewPCG(c.seed, h.Sum64())),
+		params: params,
+		price:  params.StartPrice,
+	}
+	c.paths[symbol] = p
+
+	return p
+}
+
+// ParseSymbolParams parses per-symbol settings of the form
+// "AAPL=190:0.08:0.25,bitcoin=65000:0.1:0.6", i.e. SYMBOL=price:drift:volatility.
+// Drift and volatility may be omitted, in which case defaults fill them in.
+func ParseSymbolParams(spec string, defaults Params) (map[string]Params, error) {
+	res := make(map[string]Params)
+
+	for entry := range strings.SplitSeq(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		symbol, values, ok := strings.Cut(entry, "=")
+		if !ok || symbol == "" {
+			return nil, fmt.Errorf("invalid synthetic symbol entry %q: expected SYMBOL=price[:drift[:volatility]]", entry)
+		}
+
+		params := defaults
+		fields := []*float64{&params.StartPrice, &params.Drift, &params.Volatility}
+		parts := strings.Split(values, ":")
+		if len(parts) > len(fields) {
+			return nil, fmt.Errorf("invalid synthetic symbol entry %q: too many values", entry)
+		}
+
+		for i, part := range parts {
+			v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid synthetic symbol entry %q: %w", entry, err)
+			}
+			*fields[i] = v
+		}
+
+		if params.StartPrice <= 0 || params.Volatility < 0 {
+			return nil, fmt.Errorf("invalid synthetic symbol entry %q: price must be positive and volatility non-negative", entry)
+		}
+
+		res[strings.TrimSpace(symbol)] = params
+	}
+
+	return res, nil
+}
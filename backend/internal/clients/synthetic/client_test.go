@@ -0,0 +1,54 @@
+package synthetic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SeededPathsAreReproducible(t *testing.T) {
+	ctx := context.Background()
+	cfg := Config{Seed: 42, Step: time.Hour, Symbols: map[string]Params{"AAPL": {StartPrice: 190, Drift: 0.08, Volatility: 0.25}}}
+
+	a := NewClient(cfg)
+	b := NewClient(cfg)
+
+	// b requests symbols in a different order and batches; paths must still match.
+	_, err := b.GetQuote(ctx, "MSFT")
+	require.NoError(t, err)
+
+	for range 50 {
+		qa, err := a.GetQuote(ctx, "AAPL")
+		require.NoError(t, err)
+		qb, err := b.GetQuotes(ctx, []string{"AAPL"})
+		require.NoError(t, err)
+
+		assert.True(t, qa.Price.Equal(qb[0].Price))
+		assert.True(t, qa.Price.IsPositive())
+		assert.Equal(t, Source, qa.Source)
+	}
+}
+
+func TestClient_ParamsOfInstrumentID(t *testing.T) {
+	client := NewClient(Config{Seed: 42, Symbols: map[string]Params{"AAPL": {StartPrice: 190, Volatility: 0.25}}})
+
+	q, err := client.GetQuote(context.Background(), "FH:AAPL")
+	require.NoError(t, err)
+	assert.Equal(t, "FH:AAPL", q.Symbol)
+	assert.InDelta(t, 190, q.Price.InexactFloat64(), 20)
+}
+
+func TestParseSymbolParams(t *testing.T) {
+	params, err := ParseSymbolParams(" AAPL=190:0.08:0.25, bitcoin=65000 ,", DefaultParams)
+	require.NoError(t, err)
+	assert.Equal(t, Params{StartPrice: 190, Drift: 0.08, Volatility: 0.25}, params["AAPL"])
+	assert.Equal(t, Params{StartPrice: 65000, Drift: DefaultParams.Drift, Volatility: DefaultParams.Volatility}, params["bitcoin"])
+
+	for _, spec := range []string{"AAPL", "AAPL=abc", "AAPL=1:2:3:4", "AAPL=-5"} {
+		_, err := ParseSymbolParams(spec, DefaultParams)
+		assert.Error(t, err, spec)
+	}
+}
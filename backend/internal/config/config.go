@@ -18,12 +18,52 @@ type Config struct {
 	RedisPort                    int           `env:"REDIS_PORT" envDefault:"6379"`
 	ClientPort                   int           `env:"CLIENT_PORT" envDefault:"5173"`
 	FinnhubFetchInterval         time.Duration `env:"FINNHUB_FETCH_INTERVAL" envDefault:"10s"`
+	FinnhubFetchBurst            int           `env:"FINNHUB_FETCH_BURST" envDefault:"1"`
 	FinnhubKey                   string        `env:"FINNHUB_API_KEY"`
 	FinnhubTimeout               time.Duration `env:"FINNHUB_TIMEOUT" envDefault:"10s"`
 	CoingeckoKey                 string        `env:"COINGECKO_API_KEY"`
 	CoingeckoTimeout             time.Duration `env:"COINGECKO_TIMEOUT" envDefault:"10s"`
 	CoingeckoFetchInterval       time.Duration `env:"COINGECKO_FETCH_INTERVAL" envDefault:"10s"`
+	CoingeckoFetchBurst          int           `env:"COINGECKO_FETCH_BURST" envDefault:"1"`
+	OandaToken                   string        `env:"OANDA_API_TOKEN"`
+	OandaAccountID               string        `env:"OANDA_ACCOUNT_ID"`
+	OandaURL                     string        `env:"OANDA_API_URL"`
+	OandaTimeout                 time.Duration `env:"OANDA_TIMEOUT" envDefault:"10s"`
+	OandaFetchInterval           time.Duration `env:"OANDA_FETCH_INTERVAL" envDefault:"5s"`
+	OandaFetchBurst              int           `env:"OANDA_FETCH_BURST" envDefault:"1"`
+	MarketFetcherMaxBackoff      time.Duration `env:"MARKET_FETCHER_MAX_BACKOFF" envDefault:"5m"`
+	MarketFetcherLeaseTTL        time.Duration `env:"MARKET_FETCHER_LEASE_TTL" envDefault:"10s"`
+	MarketDataProvider           string        `env:"MARKET_DATA_PROVIDER" envDefault:"live"`
+	SyntheticFetchInterval       time.Duration `env:"SYNTHETIC_FETCH_INTERVAL" envDefault:"5s"`
+	SyntheticSeed                int64         `env:"SYNTHETIC_SEED" envDefault:"0"`
+	SyntheticStep                time.Duration `env:"SYNTHETIC_STEP" envDefault:"1m"`
+	SyntheticSymbols             string        `env:"SYNTHETIC_SYMBOLS"`
+	SyntheticJumpProbability     float64       `env:"SYNTHETIC_JUMP_PROBABILITY" envDefault:"0"`
+	SyntheticJumpVolatility      float64       `env:"SYNTHETIC_JUMP_VOLATILITY" envDefault:"0.05"`
+	ReplayDir                    string        `env:"REPLAY_DIR" envDefault:"replay"`
+	ReplaySpeed                  float64       `env:"REPLAY_SPEED" envDefault:"1"`
+	ReplayStartOffset            time.Duration `env:"REPLAY_START_OFFSET" envDefault:"0s"`
+	ReplayEpoch                  time.Time     `env:"REPLAY_EPOCH"`
 	MarketFetcherRefreshInterval time.Duration `env:"MARKET_FETCHER_REFRESH_INTERVAL" envDefault:"1m"`
+	StockMaxDeviation            float64       `env:"STOCK_MAX_DEVIATION" envDefault:"0.15"`
+	CryptoMaxDeviation           float64       `env:"CRYPTO_MAX_DEVIATION" envDefault:"0.3"`
+	FXMaxDeviation               float64       `env:"FX_MAX_DEVIATION" envDefault:"0.05"`
+	FXRateMaxAge                 time.Duration `env:"FX_RATE_MAX_AGE" envDefault:"1h"`
+	FXRefreshInterval            time.Duration `env:"FX_REFRESH_INTERVAL" envDefault:"1m"`
+	FXStaticRates                string        `env:"FX_STATIC_RATES" envDefault:"EUR=1.08,GBP=1.27,JPY=0.0067"`
+	BasketPriceInterval          time.Duration `env:"BASKET_PRICE_INTERVAL" envDefault:"5s"`
+	OptionPriceInterval          time.Duration `env:"OPTION_PRICE_INTERVAL" envDefault:"5s"`
+	OptionExpiryInterval         time.Duration `env:"OPTION_EXPIRY_INTERVAL" envDefault:"1m"`
+	OptionVolatility             float64       `env:"OPTION_VOLATILITY" envDefault:"0.3"`
+	OptionHistoricalVolatility   bool          `env:"OPTION_HISTORICAL_VOLATILITY" envDefault:"true"`
+	OptionRiskFreeRate           float64       `env:"OPTION_RISK_FREE_RATE" envDefault:"0.04"`
+	PredictionLiquidity          float64       `env:"PREDICTION_LIQUIDITY" envDefault:"100"`
+	PredictionResolveInterval    time.Duration `env:"PREDICTION_RESOLVE_INTERVAL" envDefault:"1m"`
+	QuoteMaxAge                  time.Duration `env:"QUOTE_MAX_AGE" envDefault:"15m"`
+	QuoteValidationWindow        int           `env:"QUOTE_VALIDATION_WINDOW" envDefault:"20"`
+	QuoteAcceptLevelShifts       bool          `env:"QUOTE_ACCEPT_LEVEL_SHIFTS" envDefault:"false"`
+	QuoteWarmupInterval          time.Duration `env:"QUOTE_WARMUP_INTERVAL" envDefault:"1m"`
+	QuoteStreamBuffer            int           `env:"QUOTE_STREAM_BUFFER" envDefault:"32"`
 	PostgresUser                 string        `env:"POSTGRES_USER" envDefault:"postgres"`
 	PostgresPass                 string        `env:"POSTGRES_PASSWORD" envDefault:"postgres"`
 	PostgresDB                   string        `env:"POSTGRES_DB" envDefault:"ticker_rush"`
@@ -47,6 +87,12 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("failed to parse config: %w", err)
 	}
 
+	switch cfg.MarketDataProvider {
+	case MarketDataProviderLive, MarketDataProviderSynthetic, MarketDataProviderReplay:
+	default:
+		return nil, fmt.Errorf("unknown MARKET_DATA_PROVIDER %q", cfg.MarketDataProvider)
+	}
+
 	log.Println("Configuration loaded:")
 	log.Printf("  TICKERS: %v", cfg.Tickers)
 	log.Printf("  SERVER_PORT: %d", cfg.ServerPort)
@@ -54,12 +100,52 @@ func LoadConfig() (*Config, error) {
 	log.Printf("  REDIS_PORT: %d", cfg.RedisPort)
 	log.Printf("  CLIENT_PORT: %d", cfg.ClientPort)
 	log.Printf("  FINNHUB_FETCH_INTERVAL: %s", cfg.FinnhubFetchInterval)
+	log.Printf("  FINNHUB_FETCH_BURST: %d", cfg.FinnhubFetchBurst)
 	log.Printf("  FINNHUB_API_KEY: %s", maskString(cfg.FinnhubKey))
 	log.Printf("  FINNHUB_TIMEOUT: %s", cfg.FinnhubTimeout)
 	log.Printf("  COINGECKO_API_KEY: %s", maskString(cfg.CoingeckoKey))
 	log.Printf("  COINGECKO_TIMEOUT: %s", cfg.CoingeckoTimeout)
 	log.Printf("  COINGECKO_FETCH_INTERVAL: %s", cfg.CoingeckoFetchInterval)
+	log.Printf("  COINGECKO_FETCH_BURST: %d", cfg.CoingeckoFetchBurst)
+	log.Printf("  OANDA_API_TOKEN: %s", maskString(cfg.OandaToken))
+	log.Printf("  OANDA_ACCOUNT_ID: %s", cfg.OandaAccountID)
+	log.Printf("  OANDA_API_URL: %s", cfg.OandaURL)
+	log.Printf("  OANDA_TIMEOUT: %s", cfg.OandaTimeout)
+	log.Printf("  OANDA_FETCH_INTERVAL: %s", cfg.OandaFetchInterval)
+	log.Printf("  OANDA_FETCH_BURST: %d", cfg.OandaFetchBurst)
+	log.Printf("  MARKET_FETCHER_MAX_BACKOFF: %s", cfg.MarketFetcherMaxBackoff)
+	log.Printf("  MARKET_FETCHER_LEASE_TTL: %s", cfg.MarketFetcherLeaseTTL)
+	log.Printf("  MARKET_DATA_PROVIDER: %s", cfg.MarketDataProvider)
+	log.Printf("  SYNTHETIC_FETCH_INTERVAL: %s", cfg.SyntheticFetchInterval)
+	log.Printf("  SYNTHETIC_SEED: %d", cfg.SyntheticSeed)
+	log.Printf("  SYNTHETIC_STEP: %s", cfg.SyntheticStep)
+	log.Printf("  SYNTHETIC_SYMBOLS: %s", cfg.SyntheticSymbols)
+	log.Printf("  SYNTHETIC_JUMP_PROBABILITY: %g", cfg.SyntheticJumpProbability)
+	log.Printf("  SYNTHETIC_JUMP_VOLATILITY: %g", cfg.SyntheticJumpVolatility)
+	log.Printf("  REPLAY_DIR: %s", cfg.ReplayDir)
+	log.Printf("  REPLAY_SPEED: %g", cfg.ReplaySpeed)
+	log.Printf("  REPLAY_START_OFFSET: %s", cfg.ReplayStartOffset)
+	log.Printf("  REPLAY_EPOCH: %s", cfg.ReplayEpoch)
 	log.Printf("  MARKET_FETCHER_REFRESH_INTERVAL: %s", cfg.MarketFetcherRefreshInterval)
+	log.Printf("  STOCK_MAX_DEVIATION: %g", cfg.StockMaxDeviation)
+	log.Printf("  CRYPTO_MAX_DEVIATION: %g", cfg.CryptoMaxDeviation)
+	log.Printf("  FX_MAX_DEVIATION: %g", cfg.FXMaxDeviation)
+	log.Printf("  FX_RATE_MAX_AGE: %s", cfg.FXRateMaxAge)
+	log.Printf("  FX_REFRESH_INTERVAL: %s", cfg.FXRefreshInterval)
+	log.Printf("  FX_STATIC_RATES: %s", cfg.FXStaticRates)
+	log.Printf("  BASKET_PRICE_INTERVAL: %s", cfg.BasketPriceInterval)
+	log.Printf("  OPTION_PRICE_INTERVAL: %s", cfg.OptionPriceInterval)
+	log.Printf("  OPTION_EXPIRY_INTERVAL: %s", cfg.OptionExpiryInterval)
+	log.Printf("  OPTION_VOLATILITY: %g", cfg.OptionVolatility)
+	log.Printf("  OPTION_HISTORICAL_VOLATILITY: %t", cfg.OptionHistoricalVolatility)
+	log.Printf("  OPTION_RISK_FREE_RATE: %g", cfg.OptionRiskFreeRate)
+	log.Printf("  PREDICTION_LIQUIDITY: %g", cfg.PredictionLiquidity)
+	log.Printf("  PREDICTION_RESOLVE_INTERVAL: %s", cfg.PredictionResolveInterval)
+	log.Printf("  QUOTE_MAX_AGE: %s", cfg.QuoteMaxAge)
+	log.Printf("  QUOTE_VALIDATION_WINDOW: %d", cfg.QuoteValidationWindow)
+	log.Printf("  QUOTE_ACCEPT_LEVEL_SHIFTS: %v", cfg.QuoteAcceptLevelShifts)
+	log.Printf("  QUOTE_WARMUP_INTERVAL: %s", cfg.QuoteWarmupInterval)
+	log.Printf("  QUOTE_STREAM_BUFFER: %d", cfg.QuoteStreamBuffer)
 	log.Printf("  POSTGRES_USER: %s", cfg.PostgresUser)
 	log.Printf("  POSTGRES_PASSWORD: %s", maskString(cfg.PostgresPass))
 	log.Printf("  POSTGRES_DB: %s", cfg.PostgresDB)
@@ -86,6 +172,31 @@ func maskString(s string) string {
 	return s[:2] + "****" + s[len(s)-2:]
 }
 
+// Market data providers selectable via MARKET_DATA_PROVIDER.
+const (
+	// MarketDataProviderLive fetches quotes from Finnhub, CoinGecko and, if configured, OANDA.
+	MarketDataProviderLive = "live"
+	// MarketDataProviderSynthetic replaces every live provider with simulated prices.
+	MarketDataProviderSynthetic = "synthetic"
+	// MarketDataProviderReplay replaces every live provider with recorded prices from REPLAY_DIR.
+	MarketDataProviderReplay = "replay"
+)
+
+// UseLiveMarketData reports whether the fetcher talks to the live providers.
+func (c *Config) UseLiveMarketData() bool {
+	return c.MarketDataProvider == MarketDataProviderLive
+}
+
+// UseSyntheticMarketData reports whether the fetcher should run without live providers.
+func (c *Config) UseSyntheticMarketData() bool {
+	return c.MarketDataProvider == MarketDataProviderSynthetic
+}
+
+// UseReplayMarketData reports whether the fetcher should replay recorded prices.
+func (c *Config) UseReplayMarketData() bool {
+	return c.MarketDataProvider == MarketDataProviderReplay
+}
+
 // ValidateFinnhubKey checks if the finnhub key is valid.
 func (c *Config) ValidateFinnhubKey() error {
 	if c.FinnhubKey == "" {
@@ -95,6 +206,12 @@ func (c *Config) ValidateFinnhubKey() error {
 	return nil
 }
 
+// HasOanda reports whether OANDA credentials are configured, without which currency pairs
+// are not quoted from live data.
+func (c *Config) HasOanda() bool {
+	return c.OandaToken != "" && c.OandaAccountID != ""
+}
+
 // DatabaseURL returns the PostgreSQL connection string.
 func (c *Config) DatabaseURL() string {
 	return fmt.Sprintf(
@@ -28,6 +28,18 @@ func TestLoadConfig_Defaults(t *testing.T) {
 	assert.Equal(t, "ticker_rush", cfg.PostgresDB)
 	assert.Equal(t, 5432, cfg.PostgresPort)
 	assert.Equal(t, 1*time.Minute, cfg.MarketFetcherRefreshInterval)
+	assert.Equal(t, 1, cfg.FinnhubFetchBurst)
+	assert.Equal(t, 5*time.Minute, cfg.MarketFetcherMaxBackoff)
+	assert.Equal(t, 10*time.Second, cfg.MarketFetcherLeaseTTL)
+	assert.Equal(t, "live", cfg.MarketDataProvider)
+	assert.False(t, cfg.UseSyntheticMarketData())
+	assert.Equal(t, time.Minute, cfg.SyntheticStep)
+	assert.Equal(t, 1.0, cfg.ReplaySpeed)
+	assert.True(t, cfg.ReplayEpoch.IsZero())
+	assert.Equal(t, 0.15, cfg.StockMaxDeviation)
+	assert.Equal(t, 15*time.Minute, cfg.QuoteMaxAge)
+	assert.Equal(t, time.Minute, cfg.QuoteWarmupInterval)
+	assert.Equal(t, 32, cfg.QuoteStreamBuffer)
 	assert.Equal(t, "secret", cfg.JWTSecret)
 	// FinnhubKey is required but has no default, so it will be empty here
 	assert.Empty(t, cfg.FinnhubKey)
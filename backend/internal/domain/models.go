@@ -2,6 +2,9 @@
 package domain
 
 import (
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -30,10 +33,120 @@ type PortfolioItem struct {
 	AveragePrice decimal.Decimal
 }
 
-// TickerInfo represents ticker symbol configurations allowed in ladders.
+// TickerInfo represents ticker symbol configurations allowed in ladders, together with the
+// instrument metadata from the catalogue. The metadata is empty for uncatalogued tickers.
 type TickerInfo struct {
-	Symbol string
-	Source string
+	// Symbol is the instrument ID, see InstrumentID.
+	Symbol     string
+	Source     string
+	Name       string
+	AssetClass string
+	Currency   string
+	Exchange   string
+	TickSize   decimal.Decimal
+	PipSize    decimal.Decimal
+	// FXRate converts prices of the instrument into the ladder's base currency. It is only
+	// set where tickers are served to clients, and zero if no rate is known.
+	FXRate decimal.Decimal
+}
+
+// PriceCurrency returns the currency the ticker is quoted in, assuming uncatalogued tickers
+// are quoted in USD.
+func (t *TickerInfo) PriceCurrency() string {
+	if t.Currency == "" {
+		return CurrencyUSD
+	}
+
+	return t.Currency
+}
+
+// Market data sources of ladder tickers and catalogued instruments.
+const (
+	SourceFinnhub   = "Finnhub"
+	SourceCoinGecko = "CoinGecko"
+	SourceOANDA     = "OANDA"
+	SourceSynthetic = "Synthetic"
+	SourceBasket    = "Basket"
+	SourceOptions   = "Options"
+)
+
+// sourcePrefixes are the instrument ID prefixes of the market data sources.
+var sourcePrefixes = map[string]string{
+	SourceFinnhub:   "FH",
+	SourceCoinGecko: "CG",
+	SourceOANDA:     "OA",
+	SourceSynthetic: "SYN",
+	SourceBasket:    "BK",
+	SourceOptions:   "OPT",
+}
+
+// InstrumentID returns the canonical ID of a provider symbol, "<prefix>:<symbol>" as in
+// "FH:AAPL" or "CG:bitcoin". Instruments are identified by their ID in Valkey, the quote
+// history, portfolios and the API, so that providers quoting the same symbol do not
+// collide. Sources without a known prefix use their name as the prefix, and symbols that
+// are already qualified are returned unchanged.
+func InstrumentID(source, symbol string) string {
+	if strings.Contains(symbol, ":") {
+		return symbol
+	}
+
+	prefix, ok := sourcePrefixes[source]
+	if !ok {
+		prefix = source
+	}
+
+	return prefix + ":" + symbol
+}
+
+// InstrumentSource returns the source an instrument ID belongs to, e.g. "Finnhub" for
+// "FH:AAPL". IDs with an unknown prefix return the prefix, and unqualified symbols return "".
+func InstrumentSource(id string) string {
+	prefix, _, found := strings.Cut(id, ":")
+	if !found {
+		return ""
+	}
+
+	for source, p := range sourcePrefixes {
+		if p == prefix {
+			return source
+		}
+	}
+
+	return prefix
+}
+
+// ProviderSymbol returns the symbol an instrument ID stands for at its provider, e.g. "AAPL"
+// for "FH:AAPL". Unqualified symbols are returned unchanged.
+func ProviderSymbol(id string) string {
+	if _, symbol, found := strings.Cut(id, ":"); found {
+		return symbol
+	}
+
+	return id
+}
+
+// Asset classes of catalogued instruments.
+const (
+	AssetClassStock  = "stock"
+	AssetClassCrypto = "crypto"
+	AssetClassForex  = "forex"
+	AssetClassBasket = "basket"
+)
+
+// Instrument is an entry of the instrument catalogue, describing what a ticker symbol stands for.
+type Instrument struct {
+	// Symbol is the instrument ID, see InstrumentID.
+	Symbol     string
+	Source     string
+	Name       string
+	AssetClass string
+	Currency   string
+	Exchange   string
+	// TickSize is the smallest price increment quoted for the instrument.
+	TickSize decimal.Decimal
+	// PipSize is the conventional unit of price movement of a currency pair, e.g. 0.0001
+	// for EUR/USD. It is zero for other asset classes.
+	PipSize decimal.Decimal
 }
 
 // Ladder represents a competition cycle.
@@ -46,9 +159,63 @@ type Ladder struct {
 	IsActive       bool
 	CreatedAt      time.Time
 	InitialBalance decimal.Decimal
+	// BaseCurrency is the currency balances and net worth are kept in.
+	BaseCurrency   string
 	AllowedTickers []TickerInfo
 }
 
+// Currency returns the ladder's base currency, USD if none is set.
+func (l *Ladder) Currency() string {
+	if l.BaseCurrency == "" {
+		return CurrencyUSD
+	}
+
+	return l.BaseCurrency
+}
+
+// Ticker returns the allowed ticker with the given instrument ID.
+func (l *Ladder) Ticker(symbol string) (*TickerInfo, bool) {
+	for i := range l.AllowedTickers {
+		if l.AllowedTickers[i].Symbol == symbol {
+			return &l.AllowedTickers[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// CurrencyUSD is the default base currency of ladders and the currency FX rates are cached
+// against.
+const CurrencyUSD = "USD"
+
+// FXRate is the USD value of one unit of a currency, as last fetched from an FX provider.
+type FXRate struct {
+	Currency  string
+	Rate      decimal.Decimal
+	UpdatedAt time.Time
+}
+
+// Sides of a fill.
+const (
+	FillSideBuy  = "buy"
+	FillSideSell = "sell"
+)
+
+// Fill is an executed trade. The price is in the instrument's currency, and the amount is
+// what was debited or credited in the ladder's base currency at FXRate.
+type Fill struct {
+	LadderID   int64
+	UserID     int64
+	Symbol     string
+	Side       string
+	Quantity   decimal.Decimal
+	Price      decimal.Decimal
+	Currency   string
+	FXRate     decimal.Decimal
+	Amount     decimal.Decimal
+	ExecutedAt time.Time
+}
+
 // LadderParticipant represents a user's standing in a ladder.
 type LadderParticipant struct {
 	LadderID     int64
@@ -68,6 +235,327 @@ type Quote struct {
 	Timestamp     time.Time
 	Source        string
 	IsClosed      bool
+	// Precision is the number of decimal places the price is quoted to.
+	Precision int32
+	// Bid and Ask are the best bid and ask of instruments quoted two-sided, such as currency
+	// pairs, in which case Price is the mid price. They are zero for other instruments.
+	Bid decimal.Decimal
+	Ask decimal.Decimal
+	// PipSize is the pip size of currency pairs, see Instrument.PipSize.
+	PipSize decimal.Decimal
+	// EventID is the quote's entry ID ("<ms>-<seq>") in its symbol's replay stream, if it has one.
+	EventID string
+	// Payload is the quote as published, an encoded exchange.StreamQuotesResponse including
+	// the event ID. It is only set for quotes received from the live feed.
+	Payload []byte
+}
+
+// IsTwoSided reports whether the quote has a bid and an ask.
+func (q *Quote) IsTwoSided() bool {
+	return q.Bid.IsPositive() && q.Ask.IsPositive()
+}
+
+// BuyPrice returns the price a buy order fills at: the ask of two-sided quotes, otherwise
+// the price.
+func (q *Quote) BuyPrice() decimal.Decimal {
+	if q.IsTwoSided() {
+		return q.Ask
+	}
+
+	return q.Price
+}
+
+// SellPrice returns the price a sell order fills at: the bid of two-sided quotes, otherwise
+// the price.
+func (q *Quote) SellPrice() decimal.Decimal {
+	if q.IsTwoSided() {
+		return q.Bid
+	}
+
+	return q.Price
+}
+
+// Candle is an OHLCV bar summarising a symbol's prices over one resolution period.
+type Candle struct {
+	Symbol     string
+	Source     string
+	Start      time.Time
+	Resolution time.Duration
+	Open       decimal.Decimal
+	High       decimal.Decimal
+	Low        decimal.Decimal
+	Close      decimal.Decimal
+	Volume     decimal.Decimal
+}
+
+// End returns the time the candle closes.
+func (c *Candle) End() time.Time {
+	return c.Start.Add(c.Resolution)
+}
+
+// BackfillCheckpoint records how far a historical backfill of a symbol has been loaded.
+// Backfills are identified by symbol, resolution and the start of the requested range.
+type BackfillCheckpoint struct {
+	Symbol      string
+	Source      string
+	Resolution  time.Duration
+	RangeStart  time.Time
+	LoadedUntil time.Time
+}
+
+// ResolutionName formats a candle resolution as "<n>m", "<n>h" or "<n>d".
+func ResolutionName(resolution time.Duration) string {
+	switch {
+	case resolution%(24*time.Hour) == 0:
+		return strconv.FormatInt(int64(resolution/(24*time.Hour)), 10) + "d"
+	case resolution%time.Hour == 0:
+		return strconv.FormatInt(int64(resolution/time.Hour), 10) + "h"
+	default:
+		return strconv.FormatInt(int64(resolution/time.Minute), 10) + "m"
+	}
+}
+
+// ParseResolution parses a candle resolution in the format of ResolutionName.
+// Resolutions must be positive whole minutes.
+func ParseResolution(name string) (time.Duration, bool) {
+	if len(name) < 2 {
+		return 0, false
+	}
+
+	n, err := strconv.ParseInt(name[:len(name)-1], 10, 64)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+
+	switch name[len(name)-1] {
+	case 'm':
+		return time.Duration(n) * time.Minute, true
+	case 'h':
+		return time.Duration(n) * time.Hour, true
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour, true
+	default:
+		return 0, false
+	}
+}
+
+// Fence identifies a writer elected through a lease. Stores reject writes whose
+// token is older than the latest one issued for the lease.
+type Fence struct {
+	Lease string
+	Token int64
+}
+
+// Reasons a quote can be quarantined by the fetcher.
+const (
+	QuarantineReasonDeviation  = "deviation"
+	QuarantineReasonLevelShift = "level_shift"
+	QuarantineReasonStale      = "stale"
+)
+
+// QuarantinedQuote is a quote held back by the fetcher's sanity checks until an admin reviews it.
+type QuarantinedQuote struct {
+	ID            string
+	Quote         Quote
+	Reason        string
+	Detail        string
+	QuarantinedAt time.Time
+}
+
+// Basket is a composite instrument priced from the quotes of its components, such as an
+// equal-weight basket of tech stocks.
+type Basket struct {
+	// Symbol is the instrument ID, e.g. "BK:TR-TECH5".
+	Symbol string
+	Name   string
+	// BaseValue is the price of the basket when it was created.
+	BaseValue  decimal.Decimal
+	Components []BasketComponent
+	// OwnerID is the player who published the basket as a custom index, or 0 for baskets
+	// defined by admins.
+	OwnerID       int64
+	OwnerUsername string
+	// Rebalance is how often the units are reset to restore the original weights.
+	Rebalance     string
+	RebalancedAt  time.Time
+	FollowerCount int64
+	CreatedAt     time.Time
+}
+
+// NextRebalance returns when the basket is next due to be rebalanced, or the zero time if it
+// is never rebalanced.
+func (b *Basket) NextRebalance() time.Time {
+	switch b.Rebalance {
+	case RebalanceWeekly:
+		return b.RebalancedAt.AddDate(0, 0, 7)
+	case RebalanceMonthly:
+		return b.RebalancedAt.AddDate(0, 1, 0)
+	case RebalanceQuarterly:
+		return b.RebalancedAt.AddDate(0, 3, 0)
+	default:
+		return time.Time{}
+	}
+}
+
+// Rebalance schedules of baskets.
+const (
+	RebalanceNone      = "none"
+	RebalanceWeekly    = "weekly"
+	RebalanceMonthly   = "monthly"
+	RebalanceQuarterly = "quarterly"
+)
+
+// IndexDetails is the public view of a custom index: its current quote, its return since
+// creation, its followers and its price history.
+type IndexDetails struct {
+	Basket *Basket
+	Quote  *Quote
+	// ReturnPercent is the change of the price against the base value, in percent.
+	ReturnPercent decimal.Decimal
+	Followers     []string
+	History       []*Quote
+}
+
+// BasketComponent is an instrument held by a basket.
+type BasketComponent struct {
+	Symbol string
+	// Weight is the component's share of the basket's value at creation; weights sum to 1.
+	Weight decimal.Decimal
+	// Units is the fixed quantity of the component held per unit of the basket.
+	Units decimal.Decimal
+}
+
+// Option types.
+const (
+	OptionTypeCall = "call"
+	OptionTypePut  = "put"
+)
+
+// OptionContract is a cash-settled European option listed in a ladder. Its premium is
+// quoted per unit of the underlying, and it settles at its intrinsic value at expiry.
+type OptionContract struct {
+	// Symbol is the instrument ID, e.g. "OPT:AAPL-261120-C200".
+	Symbol     string
+	LadderID   int64
+	Underlying string
+	Type       string
+	Strike     decimal.Decimal
+	ExpiresAt  time.Time
+	// Volatility is the annualized volatility the premium is priced with, or zero to use the
+	// configured or historical volatility of the underlying.
+	Volatility decimal.Decimal
+	// SettlementPrice is the underlying's price at expiry, once the contract is settled.
+	SettlementPrice decimal.Decimal
+	SettledAt       *time.Time
+	CreatedAt       time.Time
+}
+
+// IntrinsicValue returns what the contract is worth if exercised at the given price of the
+// underlying.
+func (o *OptionContract) IntrinsicValue(underlying decimal.Decimal) decimal.Decimal {
+	if o.Type == OptionTypePut {
+		return decimal.Max(o.Strike.Sub(underlying), decimal.Zero)
+	}
+
+	return decimal.Max(underlying.Sub(o.Strike), decimal.Zero)
+}
+
+// Prediction market outcomes.
+const (
+	PredictionYes = "yes"
+	PredictionNo  = "no"
+)
+
+// predictionPricePrecision is the number of decimal places share prices are quoted to.
+const predictionPricePrecision = 4
+
+// PredictionMarket is a binary market in a ladder on whether an instrument's price is above a
+// threshold at the resolution time. Its YES and NO shares are priced by a logarithmic market
+// scoring rule, and every winning share pays out one unit of the ladder's base currency.
+type PredictionMarket struct {
+	ID       int64
+	LadderID int64
+	// Symbol is the instrument ID of the ladder ticker the question is about.
+	Symbol     string
+	Question   string
+	Threshold  decimal.Decimal
+	ResolvesAt time.Time
+	// Liquidity is the market maker's liquidity parameter b; the higher it is, the less
+	// stakes move prices.
+	Liquidity decimal.Decimal
+	YesShares decimal.Decimal
+	NoShares  decimal.Decimal
+	// Outcome is PredictionYes or PredictionNo once the market is resolved, empty before.
+	Outcome string
+	// ResolutionPrice is the instrument's recorded price at the resolution time.
+	ResolutionPrice decimal.Decimal
+	ResolvedAt      *time.Time
+	CreatedAt       time.Time
+}
+
+// IsOpen reports whether stakes are still accepted at the given time.
+func (m *PredictionMarket) IsOpen(now time.Time) bool {
+	return m.Outcome == "" && now.Before(m.ResolvesAt)
+}
+
+// Price returns the price of one share of an outcome under the logarithmic market scoring
+// rule, which is also the market's implied probability of it. Resolved markets price the
+// winning outcome at 1.
+func (m *PredictionMarket) Price(outcome string) decimal.Decimal {
+	if m.Outcome != "" {
+		if m.Outcome == outcome {
+			return decimal.NewFromInt(1)
+		}
+
+		return decimal.Zero
+	}
+
+	shares, other := m.YesShares, m.NoShares
+	if outcome == PredictionNo {
+		shares, other = other, shares
+	}
+	exponent := other.Sub(shares).InexactFloat64() / m.Liquidity.InexactFloat64()
+
+	return decimal.NewFromFloat(1 / (1 + math.Exp(exponent))).Round(predictionPricePrecision)
+}
+
+// PredictionPosition is a player's shares in a prediction market and the cash staked on them.
+type PredictionPosition struct {
+	MarketID  int64
+	UserID    int64
+	YesShares decimal.Decimal
+	NoShares  decimal.Decimal
+	Staked    decimal.Decimal
+}
+
+// Technical indicator types.
+const (
+	IndicatorSMA       = "sma"
+	IndicatorEMA       = "ema"
+	IndicatorRSI       = "rsi"
+	IndicatorMACD      = "macd"
+	IndicatorBollinger = "bollinger"
+)
+
+// Indicator is a technical indicator series computed over a symbol's stored quotes.
+type Indicator struct {
+	Type string
+	// Period is the lookback in quotes. MACD uses the fixed 12/26/9 periods and reports 0.
+	Period int
+	Points []IndicatorPoint
+}
+
+// IndicatorPoint is an indicator's value at a stored quote.
+type IndicatorPoint struct {
+	Timestamp time.Time
+	// Value is the SMA, EMA, RSI, MACD line or middle Bollinger band.
+	Value float64
+	// Signal and Histogram are set for MACD.
+	Signal    float64
+	Histogram float64
+	// Upper and Lower are the Bollinger bands.
+	Upper float64
+	Lower float64
 }
 
 // LeaderboardEntry represents a single rank entry on the leaderboard.
@@ -84,6 +572,30 @@ type Leaderboard struct {
 	LastUpdate int64
 }
 
+// DefaultPricePrecision is the number of decimal places prices of instruments without a
+// catalogued tick size are quoted to.
+const DefaultPricePrecision = 8
+
+// PricePrecision returns the number of decimal places of a tick size, or
+// DefaultPricePrecision for an unset tick size.
+func PricePrecision(tickSize decimal.Decimal) int32 {
+	if !tickSize.IsPositive() {
+		return DefaultPricePrecision
+	}
+
+	return max(-tickSize.Exponent(), 0)
+}
+
+// RoundToTick rounds a price to the nearest multiple of tickSize. Without a tick size the
+// price is rounded to DefaultPricePrecision decimal places.
+func RoundToTick(price, tickSize decimal.Decimal) decimal.Decimal {
+	if !tickSize.IsPositive() {
+		return price.Round(DefaultPricePrecision)
+	}
+
+	return price.Div(tickSize).Round(0).Mul(tickSize).Round(PricePrecision(tickSize))
+}
+
 // MarketStalenessThreshold defines the maximum time allowed since the last update
 // before the market data is considered closed or stale.
 const MarketStalenessThreshold = 30 * time.Minute
@@ -92,3 +604,39 @@ const MarketStalenessThreshold = 30 * time.Minute
 func CalculateIsClosed(originalClosed bool, timestamp time.Time) bool {
 	return originalClosed || time.Since(timestamp) > MarketStalenessThreshold
 }
+
+// QuoteEventIDAfter reports whether the replay stream entry ID a comes after b.
+// Invalid IDs never come after anything.
+func QuoteEventIDAfter(a, b string) bool {
+	aMs, aSeq, okA := parseQuoteEventID(a)
+	bMs, bSeq, okB := parseQuoteEventID(b)
+	if !okA || !okB {
+		return false
+	}
+
+	return aMs > bMs || (aMs == bMs && aSeq > bSeq)
+}
+
+// IsQuoteEventID reports whether id is a well-formed replay stream entry ID.
+func IsQuoteEventID(id string) bool {
+	_, _, ok := parseQuoteEventID(id)
+
+	return ok
+}
+
+func parseQuoteEventID(id string) (uint64, uint64, bool) {
+	msPart, seqPart, found := strings.Cut(id, "-")
+	if !found {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return ms, seq, true
+}
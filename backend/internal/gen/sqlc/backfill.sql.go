@@ -0,0 +1,59 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: backfill.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getBackfillCheckpoint = `-- name: GetBackfillCheckpoint :one
+SELECT loaded_until
+FROM backfill_checkpoints
+WHERE symbol = $1 AND resolution = $2 AND range_start = $3
+`
+
+type GetBackfillCheckpointParams struct {
+	Symbol     string
+	Resolution string
+	RangeStart pgtype.Timestamptz
+}
+
+func (q *Queries) GetBackfillCheckpoint(ctx context.Context, arg GetBackfillCheckpointParams) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getBackfillCheckpoint, arg.Symbol, arg.Resolution, arg.RangeStart)
+	var loaded_until pgtype.Timestamptz
+	err := row.Scan(&loaded_until)
+	return loaded_until, err
+}
+
+const upsertBackfillCheckpoint = `-- name: UpsertBackfillCheckpoint :exec
+INSERT INTO backfill_checkpoints (symbol, resolution, range_start, source, loaded_until, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+ON CONFLICT (symbol, resolution, range_start) DO UPDATE SET
+    source = EXCLUDED.source,
+    loaded_until = EXCLUDED.loaded_until,
+    updated_at = NOW()
+`
+
+type UpsertBackfillCheckpointParams struct {
+	Symbol      string
+	Resolution  string
+	RangeStart  pgtype.Timestamptz
+	Source      string
+	LoadedUntil pgtype.Timestamptz
+}
+
+func (q *Queries) UpsertBackfillCheckpoint(ctx context.Context, arg UpsertBackfillCheckpointParams) error {
+	_, err := q.db.Exec(ctx, upsertBackfillCheckpoint,
+		arg.Symbol,
+		arg.Resolution,
+		arg.RangeStart,
+		arg.Source,
+		arg.LoadedUntil,
+	)
+	return err
+}
@@ -0,0 +1,465 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: baskets.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const countBasketsByOwner = `-- name: CountBasketsByOwner :one
+SELECT COUNT(*) FROM baskets
+WHERE owner_id = $1
+`
+
+func (q *Queries) CountBasketsByOwner(ctx context.Context, ownerID pgtype.Int8) (int64, error) {
+	row := q.db.QueryRow(ctx, countBasketsByOwner, ownerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteBasket = `-- name: DeleteBasket :execrows
+DELETE FROM baskets
+WHERE symbol = $1
+`
+
+func (q *Queries) DeleteBasket(ctx context.Context, symbol string) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteBasket, symbol)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const followBasket = `-- name: FollowBasket :exec
+INSERT INTO basket_followers (basket_symbol, user_id)
+VALUES ($1, $2)
+ON CONFLICT (basket_symbol, user_id) DO NOTHING
+`
+
+type FollowBasketParams struct {
+	BasketSymbol string
+	UserID       int64
+}
+
+func (q *Queries) FollowBasket(ctx context.Context, arg FollowBasketParams) error {
+	_, err := q.db.Exec(ctx, followBasket, arg.BasketSymbol, arg.UserID)
+	return err
+}
+
+const getBasket = `-- name: GetBasket :one
+SELECT b.symbol, b.name, b.base_value, b.owner_id, COALESCE(u.username, '')::text AS owner_username,
+       b.rebalance, b.rebalanced_at, b.created_at,
+       (SELECT COUNT(*) FROM basket_followers f WHERE f.basket_symbol = b.symbol) AS follower_count
+FROM baskets b
+LEFT JOIN users u ON u.id = b.owner_id
+WHERE b.symbol = $1
+`
+
+type GetBasketRow struct {
+	Symbol        string
+	Name          string
+	BaseValue     decimal.Decimal
+	OwnerID       pgtype.Int8
+	OwnerUsername string
+	Rebalance     string
+	RebalancedAt  pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	FollowerCount int64
+}
+
+func (q *Queries) GetBasket(ctx context.Context, symbol string) (GetBasketRow, error) {
+	row := q.db.QueryRow(ctx, getBasket, symbol)
+	var i GetBasketRow
+	err := row.Scan(
+		&i.Symbol,
+		&i.Name,
+		&i.BaseValue,
+		&i.OwnerID,
+		&i.OwnerUsername,
+		&i.Rebalance,
+		&i.RebalancedAt,
+		&i.CreatedAt,
+		&i.FollowerCount,
+	)
+	return i, err
+}
+
+const getBasketComponents = `-- name: GetBasketComponents :many
+SELECT component_symbol, weight, units
+FROM basket_components
+WHERE basket_symbol = $1
+ORDER BY component_symbol
+`
+
+type GetBasketComponentsRow struct {
+	ComponentSymbol string
+	Weight          decimal.Decimal
+	Units           decimal.Decimal
+}
+
+func (q *Queries) GetBasketComponents(ctx context.Context, basketSymbol string) ([]GetBasketComponentsRow, error) {
+	rows, err := q.db.Query(ctx, getBasketComponents, basketSymbol)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBasketComponentsRow
+	for rows.Next() {
+		var i GetBasketComponentsRow
+		if err := rows.Scan(&i.ComponentSymbol, &i.Weight, &i.Units); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const insertBasket = `-- name: InsertBasket :execrows
+INSERT INTO baskets (symbol, name, base_value, owner_id, rebalance)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (symbol) DO NOTHING
+`
+
+type InsertBasketParams struct {
+	Symbol    string
+	Name      string
+	BaseValue decimal.Decimal
+	OwnerID   pgtype.Int8
+	Rebalance string
+}
+
+func (q *Queries) InsertBasket(ctx context.Context, arg InsertBasketParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertBasket,
+		arg.Symbol,
+		arg.Name,
+		arg.BaseValue,
+		arg.OwnerID,
+		arg.Rebalance,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertBasketComponent = `-- name: InsertBasketComponent :exec
+INSERT INTO basket_components (basket_symbol, component_symbol, weight, units)
+VALUES ($1, $2, $3, $4)
+`
+
+type InsertBasketComponentParams struct {
+	BasketSymbol    string
+	ComponentSymbol string
+	Weight          decimal.Decimal
+	Units           decimal.Decimal
+}
+
+func (q *Queries) InsertBasketComponent(ctx context.Context, arg InsertBasketComponentParams) error {
+	_, err := q.db.Exec(ctx, insertBasketComponent,
+		arg.BasketSymbol,
+		arg.ComponentSymbol,
+		arg.Weight,
+		arg.Units,
+	)
+	return err
+}
+
+const isTickerInLadder = `-- name: IsTickerInLadder :one
+SELECT EXISTS (SELECT 1 FROM ladder_tickers WHERE stock_symbol = $1)
+`
+
+func (q *Queries) IsTickerInLadder(ctx context.Context, stockSymbol string) (bool, error) {
+	row := q.db.QueryRow(ctx, isTickerInLadder, stockSymbol)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listBasketComponents = `-- name: ListBasketComponents :many
+SELECT basket_symbol, component_symbol, weight, units
+FROM basket_components
+ORDER BY basket_symbol, component_symbol
+`
+
+func (q *Queries) ListBasketComponents(ctx context.Context) ([]BasketComponent, error) {
+	rows, err := q.db.Query(ctx, listBasketComponents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BasketComponent
+	for rows.Next() {
+		var i BasketComponent
+		if err := rows.Scan(
+			&i.BasketSymbol,
+			&i.ComponentSymbol,
+			&i.Weight,
+			&i.Units,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBasketFollowers = `-- name: ListBasketFollowers :many
+SELECT u.username
+FROM basket_followers f
+JOIN users u ON u.id = f.user_id
+WHERE f.basket_symbol = $1
+ORDER BY f.followed_at
+`
+
+func (q *Queries) ListBasketFollowers(ctx context.Context, basketSymbol string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listBasketFollowers, basketSymbol)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var username string
+		if err := rows.Scan(&username); err != nil {
+			return nil, err
+		}
+		items = append(items, username)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listBaskets = `-- name: ListBaskets :many
+SELECT b.symbol, b.name, b.base_value, b.owner_id, COALESCE(u.username, '')::text AS owner_username,
+       b.rebalance, b.rebalanced_at, b.created_at,
+       (SELECT COUNT(*) FROM basket_followers f WHERE f.basket_symbol = b.symbol) AS follower_count
+FROM baskets b
+LEFT JOIN users u ON u.id = b.owner_id
+ORDER BY b.symbol
+`
+
+type ListBasketsRow struct {
+	Symbol        string
+	Name          string
+	BaseValue     decimal.Decimal
+	OwnerID       pgtype.Int8
+	OwnerUsername string
+	Rebalance     string
+	RebalancedAt  pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	FollowerCount int64
+}
+
+func (q *Queries) ListBaskets(ctx context.Context) ([]ListBasketsRow, error) {
+	rows, err := q.db.Query(ctx, listBaskets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListBasketsRow
+	for rows.Next() {
+		var i ListBasketsRow
+		if err := rows.Scan(
+			&i.Symbol,
+			&i.Name,
+			&i.BaseValue,
+			&i.OwnerID,
+			&i.OwnerUsername,
+			&i.Rebalance,
+			&i.RebalancedAt,
+			&i.CreatedAt,
+			&i.FollowerCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listComponentsOfBaskets = `-- name: ListComponentsOfBaskets :many
+SELECT basket_symbol, component_symbol, weight, units
+FROM basket_components
+WHERE basket_symbol = ANY($1::text[])
+ORDER BY basket_symbol, component_symbol
+`
+
+func (q *Queries) ListComponentsOfBaskets(ctx context.Context, symbols []string) ([]BasketComponent, error) {
+	rows, err := q.db.Query(ctx, listComponentsOfBaskets, symbols)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BasketComponent
+	for rows.Next() {
+		var i BasketComponent
+		if err := rows.Scan(
+			&i.BasketSymbol,
+			&i.ComponentSymbol,
+			&i.Weight,
+			&i.Units,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listIndices = `-- name: ListIndices :many
+SELECT b.symbol, b.name, b.base_value, b.owner_id, COALESCE(u.username, '')::text AS owner_username,
+       b.rebalance, b.rebalanced_at, b.created_at,
+       (SELECT COUNT(*) FROM basket_followers f WHERE f.basket_symbol = b.symbol) AS follower_count
+FROM baskets b
+LEFT JOIN users u ON u.id = b.owner_id
+WHERE b.owner_id IS NOT NULL
+ORDER BY b.symbol
+LIMIT $1 OFFSET $2
+`
+
+type ListIndicesParams struct {
+	Limit  int32
+	Offset int32
+}
+
+type ListIndicesRow struct {
+	Symbol        string
+	Name          string
+	BaseValue     decimal.Decimal
+	OwnerID       pgtype.Int8
+	OwnerUsername string
+	Rebalance     string
+	RebalancedAt  pgtype.Timestamptz
+	CreatedAt     pgtype.Timestamptz
+	FollowerCount int64
+}
+
+func (q *Queries) ListIndices(ctx context.Context, arg ListIndicesParams) ([]ListIndicesRow, error) {
+	rows, err := q.db.Query(ctx, listIndices, arg.Limit, arg.Offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListIndicesRow
+	for rows.Next() {
+		var i ListIndicesRow
+		if err := rows.Scan(
+			&i.Symbol,
+			&i.Name,
+			&i.BaseValue,
+			&i.OwnerID,
+			&i.OwnerUsername,
+			&i.Rebalance,
+			&i.RebalancedAt,
+			&i.CreatedAt,
+			&i.FollowerCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listInstrumentCurrencies = `-- name: ListInstrumentCurrencies :many
+SELECT symbol, currency
+FROM instruments
+WHERE symbol = ANY($1::text[])
+`
+
+type ListInstrumentCurrenciesRow struct {
+	Symbol   string
+	Currency string
+}
+
+func (q *Queries) ListInstrumentCurrencies(ctx context.Context, symbols []string) ([]ListInstrumentCurrenciesRow, error) {
+	rows, err := q.db.Query(ctx, listInstrumentCurrencies, symbols)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInstrumentCurrenciesRow
+	for rows.Next() {
+		var i ListInstrumentCurrenciesRow
+		if err := rows.Scan(&i.Symbol, &i.Currency); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setBasketRebalancedAt = `-- name: SetBasketRebalancedAt :exec
+UPDATE baskets
+SET rebalanced_at = $2
+WHERE symbol = $1
+`
+
+type SetBasketRebalancedAtParams struct {
+	Symbol       string
+	RebalancedAt pgtype.Timestamptz
+}
+
+func (q *Queries) SetBasketRebalancedAt(ctx context.Context, arg SetBasketRebalancedAtParams) error {
+	_, err := q.db.Exec(ctx, setBasketRebalancedAt, arg.Symbol, arg.RebalancedAt)
+	return err
+}
+
+const unfollowBasket = `-- name: UnfollowBasket :exec
+DELETE FROM basket_followers
+WHERE basket_symbol = $1 AND user_id = $2
+`
+
+type UnfollowBasketParams struct {
+	BasketSymbol string
+	UserID       int64
+}
+
+func (q *Queries) UnfollowBasket(ctx context.Context, arg UnfollowBasketParams) error {
+	_, err := q.db.Exec(ctx, unfollowBasket, arg.BasketSymbol, arg.UserID)
+	return err
+}
+
+const updateBasketComponentUnits = `-- name: UpdateBasketComponentUnits :exec
+UPDATE basket_components
+SET units = $3
+WHERE basket_symbol = $1 AND component_symbol = $2
+`
+
+type UpdateBasketComponentUnitsParams struct {
+	BasketSymbol    string
+	ComponentSymbol string
+	Units           decimal.Decimal
+}
+
+func (q *Queries) UpdateBasketComponentUnits(ctx context.Context, arg UpdateBasketComponentUnitsParams) error {
+	_, err := q.db.Exec(ctx, updateBasketComponentUnits, arg.BasketSymbol, arg.ComponentSymbol, arg.Units)
+	return err
+}
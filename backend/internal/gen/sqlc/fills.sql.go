@@ -0,0 +1,47 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: fills.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const insertLadderFill = `-- name: InsertLadderFill :exec
+INSERT INTO ladder_fills (ladder_id, user_id, stock_symbol, side, quantity, price, currency, fx_rate, amount, executed_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+type InsertLadderFillParams struct {
+	LadderID    int64
+	UserID      int64
+	StockSymbol string
+	Side        string
+	Quantity    decimal.Decimal
+	Price       decimal.Decimal
+	Currency    string
+	FxRate      decimal.Decimal
+	Amount      decimal.Decimal
+	ExecutedAt  pgtype.Timestamptz
+}
+
+func (q *Queries) InsertLadderFill(ctx context.Context, arg InsertLadderFillParams) error {
+	_, err := q.db.Exec(ctx, insertLadderFill,
+		arg.LadderID,
+		arg.UserID,
+		arg.StockSymbol,
+		arg.Side,
+		arg.Quantity,
+		arg.Price,
+		arg.Currency,
+		arg.FxRate,
+		arg.Amount,
+		arg.ExecutedAt,
+	)
+	return err
+}
@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: instruments.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+const deleteInstrument = `-- name: DeleteInstrument :exec
+DELETE FROM instruments
+WHERE symbol = $1
+`
+
+func (q *Queries) DeleteInstrument(ctx context.Context, symbol string) error {
+	_, err := q.db.Exec(ctx, deleteInstrument, symbol)
+	return err
+}
+
+const listInstruments = `-- name: ListInstruments :many
+SELECT symbol, source, name, asset_class, currency, exchange, tick_size, pip_size
+FROM instruments
+ORDER BY symbol
+LIMIT $1
+`
+
+type ListInstrumentsRow struct {
+	Symbol     string
+	Source     string
+	Name       string
+	AssetClass string
+	Currency   string
+	Exchange   string
+	TickSize   decimal.Decimal
+	PipSize    decimal.Decimal
+}
+
+func (q *Queries) ListInstruments(ctx context.Context, maxResults int32) ([]ListInstrumentsRow, error) {
+	rows, err := q.db.Query(ctx, listInstruments, maxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInstrumentsRow
+	for rows.Next() {
+		var i ListInstrumentsRow
+		if err := rows.Scan(
+			&i.Symbol,
+			&i.Source,
+			&i.Name,
+			&i.AssetClass,
+			&i.Currency,
+			&i.Exchange,
+			&i.TickSize,
+			&i.PipSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrackedTickers = `-- name: ListTrackedTickers :many
+SELECT DISTINCT stock_symbol, source
+FROM ladder_tickers
+ORDER BY stock_symbol
+`
+
+type ListTrackedTickersRow struct {
+	StockSymbol string
+	Source      string
+}
+
+func (q *Queries) ListTrackedTickers(ctx context.Context) ([]ListTrackedTickersRow, error) {
+	rows, err := q.db.Query(ctx, listTrackedTickers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTrackedTickersRow
+	for rows.Next() {
+		var i ListTrackedTickersRow
+		if err := rows.Scan(&i.StockSymbol, &i.Source); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchInstruments = `-- name: SearchInstruments :many
+SELECT symbol, source, name, asset_class, currency, exchange, tick_size, pip_size
+FROM instruments
+WHERE symbol ILIKE $1::text
+   OR symbol ILIKE '%:' || $1::text
+   OR name ILIKE $1::text
+   OR similarity(symbol, $2::text) > 0.3
+   OR similarity(name, $2::text) > 0.3
+ORDER BY lower(substr(symbol, strpos(symbol, ':') + 1)) = lower($2::text) OR lower(symbol) = lower($2::text) DESC,
+         symbol ILIKE $1::text OR symbol ILIKE '%:' || $1::text DESC,
+         name ILIKE $1::text DESC,
+         greatest(similarity(symbol, $2::text), similarity(name, $2::text)) DESC,
+         symbol
+LIMIT $3
+`
+
+type SearchInstrumentsParams struct {
+	Pattern    string
+	Query      string
+	MaxResults int32
+}
+
+type SearchInstrumentsRow struct {
+	Symbol     string
+	Source     string
+	Name       string
+	AssetClass string
+	Currency   string
+	Exchange   string
+	TickSize   decimal.Decimal
+	PipSize    decimal.Decimal
+}
+
+// Prefix matches on symbol or name rank first, then trigram similarity catches typos.
+// The pattern is a LIKE prefix pattern with wildcards in the query already escaped.
+// Symbols are instrument IDs; the query matches them with or without the source prefix.
+func (q *Queries) SearchInstruments(ctx context.Context, arg SearchInstrumentsParams) ([]SearchInstrumentsRow, error) {
+	rows, err := q.db.Query(ctx, searchInstruments, arg.Pattern, arg.Query, arg.MaxResults)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchInstrumentsRow
+	for rows.Next() {
+		var i SearchInstrumentsRow
+		if err := rows.Scan(
+			&i.Symbol,
+			&i.Source,
+			&i.Name,
+			&i.AssetClass,
+			&i.Currency,
+			&i.Exchange,
+			&i.TickSize,
+			&i.PipSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertInstrument = `-- name: UpsertInstrument :exec
+INSERT INTO instruments (symbol, source, name, asset_class, currency, exchange, tick_size, pip_size, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+ON CONFLICT (symbol) DO UPDATE
+SET source = EXCLUDED.source,
+    name = EXCLUDED.name,
+    asset_class = EXCLUDED.asset_class,
+    currency = EXCLUDED.currency,
+    exchange = EXCLUDED.exchange,
+    tick_size = EXCLUDED.tick_size,
+    pip_size = EXCLUDED.pip_size,
+    updated_at = NOW()
+`
+
+type UpsertInstrumentParams struct {
+	Symbol     string
+	Source     string
+	Name       string
+	AssetClass string
+	Currency   string
+	Exchange   string
+	TickSize   decimal.Decimal
+	PipSize    decimal.Decimal
+}
+
+func (q *Queries) UpsertInstrument(ctx context.Context, arg UpsertInstrumentParams) error {
+	_, err := q.db.Exec(ctx, upsertInstrument,
+		arg.Symbol,
+		arg.Source,
+		arg.Name,
+		arg.AssetClass,
+		arg.Currency,
+		arg.Exchange,
+		arg.TickSize,
+		arg.PipSize,
+	)
+	return err
+}
@@ -30,9 +30,9 @@ func (q *Queries) AddLadderTicker(ctx context.Context, arg AddLadderTickerParams
 }
 
 const createLadder = `-- name: CreateLadder :one
-INSERT INTO ladders (name, type, start_time, end_time, initial_balance, is_active)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, name, type, start_time, end_time, initial_balance, is_active, created_at
+INSERT INTO ladders (name, type, start_time, end_time, initial_balance, base_currency, is_active)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 `
 
 type CreateLadderParams struct {
@@ -41,6 +41,7 @@ type CreateLadderParams struct {
 	StartTime      pgtype.Timestamptz
 	EndTime        pgtype.Timestamptz
 	InitialBalance decimal.Decimal
+	BaseCurrency   string
 	IsActive       bool
 }
 
@@ -51,6 +52,7 @@ type CreateLadderRow struct {
 	StartTime      pgtype.Timestamptz
 	EndTime        pgtype.Timestamptz
 	InitialBalance decimal.Decimal
+	BaseCurrency   string
 	IsActive       bool
 	CreatedAt      pgtype.Timestamptz
 }
@@ -62,6 +64,7 @@ func (q *Queries) CreateLadder(ctx context.Context, arg CreateLadderParams) (Cre
 		arg.StartTime,
 		arg.EndTime,
 		arg.InitialBalance,
+		arg.BaseCurrency,
 		arg.IsActive,
 	)
 	var i CreateLadderRow
@@ -72,6 +75,7 @@ func (q *Queries) CreateLadder(ctx context.Context, arg CreateLadderParams) (Cre
 		&i.StartTime,
 		&i.EndTime,
 		&i.InitialBalance,
+		&i.BaseCurrency,
 		&i.IsActive,
 		&i.CreatedAt,
 	)
@@ -105,7 +109,7 @@ func (q *Queries) DeleteLadderPortfolioItemsByLadder(ctx context.Context, ladder
 }
 
 const getActiveLadder = `-- name: GetActiveLadder :one
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 WHERE is_active = TRUE
 ORDER BY start_time DESC
@@ -119,6 +123,7 @@ type GetActiveLadderRow struct {
 	StartTime      pgtype.Timestamptz
 	EndTime        pgtype.Timestamptz
 	InitialBalance decimal.Decimal
+	BaseCurrency   string
 	IsActive       bool
 	CreatedAt      pgtype.Timestamptz
 }
@@ -133,6 +138,7 @@ func (q *Queries) GetActiveLadder(ctx context.Context) (GetActiveLadderRow, erro
 		&i.StartTime,
 		&i.EndTime,
 		&i.InitialBalance,
+		&i.BaseCurrency,
 		&i.IsActive,
 		&i.CreatedAt,
 	)
@@ -140,7 +146,7 @@ func (q *Queries) GetActiveLadder(ctx context.Context) (GetActiveLadderRow, erro
 }
 
 const getExpiredActiveLadders = `-- name: GetExpiredActiveLadders :many
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 WHERE is_active = TRUE AND end_time <= $1
 `
@@ -152,6 +158,7 @@ type GetExpiredActiveLaddersRow struct {
 	StartTime      pgtype.Timestamptz
 	EndTime        pgtype.Timestamptz
 	InitialBalance decimal.Decimal
+	BaseCurrency   string
 	IsActive       bool
 	CreatedAt      pgtype.Timestamptz
 }
@@ -172,6 +179,7 @@ func (q *Queries) GetExpiredActiveLadders(ctx context.Context, endTime pgtype.Ti
 			&i.StartTime,
 			&i.EndTime,
 			&i.InitialBalance,
+			&i.BaseCurrency,
 			&i.IsActive,
 			&i.CreatedAt,
 		); err != nil {
@@ -186,7 +194,7 @@ func (q *Queries) GetExpiredActiveLadders(ctx context.Context, endTime pgtype.Ti
 }
 
 const getLadder = `-- name: GetLadder :one
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 WHERE id = $1
 `
@@ -198,6 +206,7 @@ type GetLadderRow struct {
 	StartTime      pgtype.Timestamptz
 	EndTime        pgtype.Timestamptz
 	InitialBalance decimal.Decimal
+	BaseCurrency   string
 	IsActive       bool
 	CreatedAt      pgtype.Timestamptz
 }
@@ -212,12 +221,76 @@ func (q *Queries) GetLadder(ctx context.Context, id int64) (GetLadderRow, error)
 		&i.StartTime,
 		&i.EndTime,
 		&i.InitialBalance,
+		&i.BaseCurrency,
 		&i.IsActive,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
+const getLadderBasketComponents = `-- name: GetLadderBasketComponents :many
+SELECT DISTINCT bc.component_symbol,
+       COALESCE(i.tick_size, 0)::numeric AS tick_size,
+       COALESCE(i.pip_size, 0)::numeric AS pip_size
+FROM ladder_tickers lt
+JOIN basket_components bc ON bc.basket_symbol = lt.stock_symbol
+LEFT JOIN instruments i ON i.symbol = bc.component_symbol
+WHERE lt.ladder_id = $1
+ORDER BY bc.component_symbol
+`
+
+type GetLadderBasketComponentsRow struct {
+	ComponentSymbol string
+	TickSize        pgtype.Numeric
+	PipSize         pgtype.Numeric
+}
+
+func (q *Queries) GetLadderBasketComponents(ctx context.Context, ladderID int64) ([]GetLadderBasketComponentsRow, error) {
+	rows, err := q.db.Query(ctx, getLadderBasketComponents, ladderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLadderBasketComponentsRow
+	for rows.Next() {
+		var i GetLadderBasketComponentsRow
+		if err := rows.Scan(&i.ComponentSymbol, &i.TickSize, &i.PipSize); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLadderHeldSymbols = `-- name: GetLadderHeldSymbols :many
+SELECT DISTINCT stock_symbol
+FROM ladder_portfolio_items
+WHERE ladder_id = $1 AND quantity > 0
+`
+
+func (q *Queries) GetLadderHeldSymbols(ctx context.Context, ladderID int64) ([]string, error) {
+	rows, err := q.db.Query(ctx, getLadderHeldSymbols, ladderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var stock_symbol string
+		if err := rows.Scan(&stock_symbol); err != nil {
+			return nil, err
+		}
+		items = append(items, stock_symbol)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getLadderLeaderboard = `-- name: GetLadderLeaderboard :many
 SELECT lp.ladder_id, lp.user_id, lp.final_balance, lp.final_rank, lp.joined_at, u.username
 FROM ladder_participants lp
@@ -427,14 +500,27 @@ func (q *Queries) GetLadderPortfolioItemForUpdate(ctx context.Context, arg GetLa
 }
 
 const getLadderTickers = `-- name: GetLadderTickers :many
-SELECT stock_symbol, source
-FROM ladder_tickers
-WHERE ladder_id = $1
+SELECT lt.stock_symbol, lt.source,
+       COALESCE(i.name, '')::text AS name,
+       COALESCE(i.asset_class, '')::text AS asset_class,
+       COALESCE(i.currency, '')::text AS currency,
+       COALESCE(i.exchange, '')::text AS exchange,
+       COALESCE(i.tick_size, 0)::numeric AS tick_size,
+       COALESCE(i.pip_size, 0)::numeric AS pip_size
+FROM ladder_tickers lt
+LEFT JOIN instruments i ON i.symbol = lt.stock_symbol
+WHERE lt.ladder_id = $1
 `
 
 type GetLadderTickersRow struct {
 	StockSymbol string
 	Source      string
+	Name        string
+	AssetClass  string
+	Currency    string
+	Exchange    string
+	TickSize    pgtype.Numeric
+	PipSize     pgtype.Numeric
 }
 
 func (q *Queries) GetLadderTickers(ctx context.Context, ladderID int64) ([]GetLadderTickersRow, error) {
@@ -446,7 +532,16 @@ func (q *Queries) GetLadderTickers(ctx context.Context, ladderID int64) ([]GetLa
 	var items []GetLadderTickersRow
 	for rows.Next() {
 		var i GetLadderTickersRow
-		if err := rows.Scan(&i.StockSymbol, &i.Source); err != nil {
+		if err := rows.Scan(
+			&i.StockSymbol,
+			&i.Source,
+			&i.Name,
+			&i.AssetClass,
+			&i.Currency,
+			&i.Exchange,
+			&i.TickSize,
+			&i.PipSize,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -458,7 +553,7 @@ func (q *Queries) GetLadderTickers(ctx context.Context, ladderID int64) ([]GetLa
 }
 
 const getPendingLaddersToActivate = `-- name: GetPendingLaddersToActivate :many
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 WHERE is_active = FALSE AND start_time <= $1 AND end_time > $1
 `
@@ -470,6 +565,7 @@ type GetPendingLaddersToActivateRow struct {
 	StartTime      pgtype.Timestamptz
 	EndTime        pgtype.Timestamptz
 	InitialBalance decimal.Decimal
+	BaseCurrency   string
 	IsActive       bool
 	CreatedAt      pgtype.Timestamptz
 }
@@ -490,6 +586,7 @@ func (q *Queries) GetPendingLaddersToActivate(ctx context.Context, startTime pgt
 			&i.StartTime,
 			&i.EndTime,
 			&i.InitialBalance,
+			&i.BaseCurrency,
 			&i.IsActive,
 			&i.CreatedAt,
 		); err != nil {
@@ -563,7 +660,7 @@ func (q *Queries) JoinLadderParticipant(ctx context.Context, arg JoinLadderParti
 }
 
 const listLadders = `-- name: ListLadders :many
-SELECT id, name, type, start_time, end_time, initial_balance, is_active, created_at
+SELECT id, name, type, start_time, end_time, initial_balance, base_currency, is_active, created_at
 FROM ladders
 ORDER BY start_time DESC
 `
@@ -575,6 +672,7 @@ type ListLaddersRow struct {
 	StartTime      pgtype.Timestamptz
 	EndTime        pgtype.Timestamptz
 	InitialBalance decimal.Decimal
+	BaseCurrency   string
 	IsActive       bool
 	CreatedAt      pgtype.Timestamptz
 }
@@ -595,6 +693,7 @@ func (q *Queries) ListLadders(ctx context.Context) ([]ListLaddersRow, error) {
 			&i.StartTime,
 			&i.EndTime,
 			&i.InitialBalance,
+			&i.BaseCurrency,
 			&i.IsActive,
 			&i.CreatedAt,
 		); err != nil {
@@ -81,3 +81,60 @@ func (q *Queries) GetHistoryForSymbol(ctx context.Context, arg GetHistoryForSymb
 	}
 	return items, nil
 }
+
+const getLatestQuotes = `-- name: GetLatestQuotes :many
+SELECT DISTINCT ON (symbol) symbol, price, source, created_at
+FROM market_quotes
+WHERE symbol = ANY($1::text[])
+ORDER BY symbol, created_at DESC
+`
+
+func (q *Queries) GetLatestQuotes(ctx context.Context, symbols []string) ([]MarketQuote, error) {
+	rows, err := q.db.Query(ctx, getLatestQuotes, symbols)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MarketQuote
+	for rows.Next() {
+		var i MarketQuote
+		if err := rows.Scan(
+			&i.Symbol,
+			&i.Price,
+			&i.Source,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQuoteAt = `-- name: GetQuoteAt :one
+SELECT symbol, price, source, created_at
+FROM market_quotes
+WHERE symbol = $1 AND created_at <= $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetQuoteAtParams struct {
+	Symbol    string
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) GetQuoteAt(ctx context.Context, arg GetQuoteAtParams) (MarketQuote, error) {
+	row := q.db.QueryRow(ctx, getQuoteAt, arg.Symbol, arg.CreatedAt)
+	var i MarketQuote
+	err := row.Scan(
+		&i.Symbol,
+		&i.Price,
+		&i.Source,
+		&i.CreatedAt,
+	)
+	return i, err
+}
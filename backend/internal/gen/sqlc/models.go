@@ -9,6 +9,50 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+type BackfillCheckpoint struct {
+	Symbol      string
+	Resolution  string
+	RangeStart  pgtype.Timestamptz
+	Source      string
+	LoadedUntil pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type Basket struct {
+	Symbol       string
+	Name         string
+	BaseValue    decimal.Decimal
+	CreatedAt    pgtype.Timestamptz
+	OwnerID      pgtype.Int8
+	Rebalance    string
+	RebalancedAt pgtype.Timestamptz
+}
+
+type BasketComponent struct {
+	BasketSymbol    string
+	ComponentSymbol string
+	Weight          decimal.Decimal
+	Units           decimal.Decimal
+}
+
+type BasketFollower struct {
+	BasketSymbol string
+	UserID       int64
+	FollowedAt   pgtype.Timestamptz
+}
+
+type Instrument struct {
+	Symbol     string
+	Source     string
+	Name       string
+	AssetClass string
+	Currency   string
+	Exchange   string
+	TickSize   decimal.Decimal
+	UpdatedAt  pgtype.Timestamptz
+	PipSize    decimal.Decimal
+}
+
 type Ladder struct {
 	ID             int64
 	Name           string
@@ -18,6 +62,21 @@ type Ladder struct {
 	InitialBalance decimal.Decimal
 	CreatedAt      pgtype.Timestamptz
 	IsActive       bool
+	BaseCurrency   string
+}
+
+type LadderFill struct {
+	ID          int64
+	LadderID    int64
+	UserID      int64
+	StockSymbol string
+	Side        string
+	Quantity    decimal.Decimal
+	Price       decimal.Decimal
+	Currency    string
+	FxRate      decimal.Decimal
+	Amount      decimal.Decimal
+	ExecutedAt  pgtype.Timestamptz
 }
 
 type LadderParticipant struct {
@@ -50,6 +109,43 @@ type MarketQuote struct {
 	CreatedAt pgtype.Timestamptz
 }
 
+type OptionContract struct {
+	Symbol           string
+	LadderID         int64
+	UnderlyingSymbol string
+	OptionType       string
+	Strike           decimal.Decimal
+	ExpiresAt        pgtype.Timestamptz
+	Volatility       pgtype.Numeric
+	SettlementPrice  pgtype.Numeric
+	SettledAt        pgtype.Timestamptz
+	CreatedAt        pgtype.Timestamptz
+}
+
+type PredictionMarket struct {
+	ID              int64
+	LadderID        int64
+	Symbol          string
+	Question        string
+	Threshold       decimal.Decimal
+	ResolvesAt      pgtype.Timestamptz
+	Liquidity       decimal.Decimal
+	YesShares       decimal.Decimal
+	NoShares        decimal.Decimal
+	Outcome         pgtype.Text
+	ResolutionPrice pgtype.Numeric
+	ResolvedAt      pgtype.Timestamptz
+	CreatedAt       pgtype.Timestamptz
+}
+
+type PredictionPosition struct {
+	MarketID  int64
+	UserID    int64
+	YesShares decimal.Decimal
+	NoShares  decimal.Decimal
+	Staked    decimal.Decimal
+}
+
 type User struct {
 	ID            int64
 	Username      string
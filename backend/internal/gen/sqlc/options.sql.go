@@ -0,0 +1,201 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: options.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const creditOptionSettlement = `-- name: CreditOptionSettlement :exec
+UPDATE ladder_participants p
+SET balance = p.balance + i.quantity * $1::numeric
+FROM ladder_portfolio_items i
+WHERE i.ladder_id = p.ladder_id
+  AND i.user_id = p.user_id
+  AND i.ladder_id = $2
+  AND i.stock_symbol = $3
+`
+
+type CreditOptionSettlementParams struct {
+	Payout   pgtype.Numeric
+	LadderID int64
+	Symbol   string
+}
+
+func (q *Queries) CreditOptionSettlement(ctx context.Context, arg CreditOptionSettlementParams) error {
+	_, err := q.db.Exec(ctx, creditOptionSettlement, arg.Payout, arg.LadderID, arg.Symbol)
+	return err
+}
+
+const deleteOptionHoldings = `-- name: DeleteOptionHoldings :exec
+DELETE FROM ladder_portfolio_items
+WHERE ladder_id = $1 AND stock_symbol = $2
+`
+
+type DeleteOptionHoldingsParams struct {
+	LadderID    int64
+	StockSymbol string
+}
+
+func (q *Queries) DeleteOptionHoldings(ctx context.Context, arg DeleteOptionHoldingsParams) error {
+	_, err := q.db.Exec(ctx, deleteOptionHoldings, arg.LadderID, arg.StockSymbol)
+	return err
+}
+
+const getOptionContract = `-- name: GetOptionContract :one
+SELECT symbol, ladder_id, underlying_symbol, option_type, strike, expires_at, volatility, settlement_price, settled_at, created_at
+FROM option_contracts
+WHERE symbol = $1
+`
+
+func (q *Queries) GetOptionContract(ctx context.Context, symbol string) (OptionContract, error) {
+	row := q.db.QueryRow(ctx, getOptionContract, symbol)
+	var i OptionContract
+	err := row.Scan(
+		&i.Symbol,
+		&i.LadderID,
+		&i.UnderlyingSymbol,
+		&i.OptionType,
+		&i.Strike,
+		&i.ExpiresAt,
+		&i.Volatility,
+		&i.SettlementPrice,
+		&i.SettledAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertOptionContract = `-- name: InsertOptionContract :execrows
+INSERT INTO option_contracts (symbol, ladder_id, underlying_symbol, option_type, strike, expires_at, volatility)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (symbol) DO NOTHING
+`
+
+type InsertOptionContractParams struct {
+	Symbol           string
+	LadderID         int64
+	UnderlyingSymbol string
+	OptionType       string
+	Strike           decimal.Decimal
+	ExpiresAt        pgtype.Timestamptz
+	Volatility       pgtype.Numeric
+}
+
+func (q *Queries) InsertOptionContract(ctx context.Context, arg InsertOptionContractParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertOptionContract,
+		arg.Symbol,
+		arg.LadderID,
+		arg.UnderlyingSymbol,
+		arg.OptionType,
+		arg.Strike,
+		arg.ExpiresAt,
+		arg.Volatility,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listExpiredOptionContracts = `-- name: ListExpiredOptionContracts :many
+SELECT symbol, ladder_id, underlying_symbol, option_type, strike, expires_at, volatility, settlement_price, settled_at, created_at
+FROM option_contracts
+WHERE settled_at IS NULL AND expires_at <= $1
+ORDER BY expires_at
+`
+
+func (q *Queries) ListExpiredOptionContracts(ctx context.Context, expiresAt pgtype.Timestamptz) ([]OptionContract, error) {
+	rows, err := q.db.Query(ctx, listExpiredOptionContracts, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OptionContract
+	for rows.Next() {
+		var i OptionContract
+		if err := rows.Scan(
+			&i.Symbol,
+			&i.LadderID,
+			&i.UnderlyingSymbol,
+			&i.OptionType,
+			&i.Strike,
+			&i.ExpiresAt,
+			&i.Volatility,
+			&i.SettlementPrice,
+			&i.SettledAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOptionContracts = `-- name: ListOptionContracts :many
+SELECT symbol, ladder_id, underlying_symbol, option_type, strike, expires_at, volatility, settlement_price, settled_at, created_at
+FROM option_contracts
+WHERE ladder_id = $1
+ORDER BY underlying_symbol, expires_at, option_type, strike
+`
+
+func (q *Queries) ListOptionContracts(ctx context.Context, ladderID int64) ([]OptionContract, error) {
+	rows, err := q.db.Query(ctx, listOptionContracts, ladderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OptionContract
+	for rows.Next() {
+		var i OptionContract
+		if err := rows.Scan(
+			&i.Symbol,
+			&i.LadderID,
+			&i.UnderlyingSymbol,
+			&i.OptionType,
+			&i.Strike,
+			&i.ExpiresAt,
+			&i.Volatility,
+			&i.SettlementPrice,
+			&i.SettledAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markOptionContractSettled = `-- name: MarkOptionContractSettled :execrows
+UPDATE option_contracts
+SET settlement_price = $2, settled_at = $3
+WHERE symbol = $1 AND settled_at IS NULL
+`
+
+type MarkOptionContractSettledParams struct {
+	Symbol          string
+	SettlementPrice pgtype.Numeric
+	SettledAt       pgtype.Timestamptz
+}
+
+func (q *Queries) MarkOptionContractSettled(ctx context.Context, arg MarkOptionContractSettledParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markOptionContractSettled, arg.Symbol, arg.SettlementPrice, arg.SettledAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
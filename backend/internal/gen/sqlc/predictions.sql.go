@@ -0,0 +1,330 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: predictions.sql
+
+package sqlc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const addPredictionPosition = `-- name: AddPredictionPosition :one
+INSERT INTO prediction_positions (market_id, user_id, yes_shares, no_shares, staked)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (market_id, user_id) DO UPDATE
+SET yes_shares = prediction_positions.yes_shares + EXCLUDED.yes_shares,
+    no_shares = prediction_positions.no_shares + EXCLUDED.no_shares,
+    staked = prediction_positions.staked + EXCLUDED.staked
+RETURNING market_id, user_id, yes_shares, no_shares, staked
+`
+
+type AddPredictionPositionParams struct {
+	MarketID  int64
+	UserID    int64
+	YesShares decimal.Decimal
+	NoShares  decimal.Decimal
+	Staked    decimal.Decimal
+}
+
+func (q *Queries) AddPredictionPosition(ctx context.Context, arg AddPredictionPositionParams) (PredictionPosition, error) {
+	row := q.db.QueryRow(ctx, addPredictionPosition,
+		arg.MarketID,
+		arg.UserID,
+		arg.YesShares,
+		arg.NoShares,
+		arg.Staked,
+	)
+	var i PredictionPosition
+	err := row.Scan(
+		&i.MarketID,
+		&i.UserID,
+		&i.YesShares,
+		&i.NoShares,
+		&i.Staked,
+	)
+	return i, err
+}
+
+const creditPredictionPayouts = `-- name: CreditPredictionPayouts :exec
+UPDATE ladder_participants lp
+SET balance = lp.balance + CASE WHEN $1::text = 'yes' THEN p.yes_shares ELSE p.no_shares END
+FROM prediction_positions p
+WHERE p.user_id = lp.user_id
+  AND p.market_id = $2
+  AND lp.ladder_id = $3
+`
+
+type CreditPredictionPayoutsParams struct {
+	Outcome  string
+	MarketID int64
+	LadderID int64
+}
+
+func (q *Queries) CreditPredictionPayouts(ctx context.Context, arg CreditPredictionPayoutsParams) error {
+	_, err := q.db.Exec(ctx, creditPredictionPayouts, arg.Outcome, arg.MarketID, arg.LadderID)
+	return err
+}
+
+const getPredictionMarketForUpdate = `-- name: GetPredictionMarketForUpdate :one
+SELECT id, ladder_id, symbol, question, threshold, resolves_at, liquidity, yes_shares, no_shares, outcome, resolution_price, resolved_at, created_at
+FROM prediction_markets
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetPredictionMarketForUpdate(ctx context.Context, id int64) (PredictionMarket, error) {
+	row := q.db.QueryRow(ctx, getPredictionMarketForUpdate, id)
+	var i PredictionMarket
+	err := row.Scan(
+		&i.ID,
+		&i.LadderID,
+		&i.Symbol,
+		&i.Question,
+		&i.Threshold,
+		&i.ResolvesAt,
+		&i.Liquidity,
+		&i.YesShares,
+		&i.NoShares,
+		&i.Outcome,
+		&i.ResolutionPrice,
+		&i.ResolvedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const insertPredictionMarket = `-- name: InsertPredictionMarket :one
+INSERT INTO prediction_markets (ladder_id, symbol, question, threshold, resolves_at, liquidity)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at
+`
+
+type InsertPredictionMarketParams struct {
+	LadderID   int64
+	Symbol     string
+	Question   string
+	Threshold  decimal.Decimal
+	ResolvesAt pgtype.Timestamptz
+	Liquidity  decimal.Decimal
+}
+
+type InsertPredictionMarketRow struct {
+	ID        int64
+	CreatedAt pgtype.Timestamptz
+}
+
+func (q *Queries) InsertPredictionMarket(ctx context.Context, arg InsertPredictionMarketParams) (InsertPredictionMarketRow, error) {
+	row := q.db.QueryRow(ctx, insertPredictionMarket,
+		arg.LadderID,
+		arg.Symbol,
+		arg.Question,
+		arg.Threshold,
+		arg.ResolvesAt,
+		arg.Liquidity,
+	)
+	var i InsertPredictionMarketRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
+const listDuePredictionMarkets = `-- name: ListDuePredictionMarkets :many
+SELECT id, ladder_id, symbol, question, threshold, resolves_at, liquidity, yes_shares, no_shares, outcome, resolution_price, resolved_at, created_at
+FROM prediction_markets
+WHERE outcome IS NULL AND resolves_at <= $1
+ORDER BY resolves_at
+`
+
+func (q *Queries) ListDuePredictionMarkets(ctx context.Context, resolvesAt pgtype.Timestamptz) ([]PredictionMarket, error) {
+	rows, err := q.db.Query(ctx, listDuePredictionMarkets, resolvesAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PredictionMarket
+	for rows.Next() {
+		var i PredictionMarket
+		if err := rows.Scan(
+			&i.ID,
+			&i.LadderID,
+			&i.Symbol,
+			&i.Question,
+			&i.Threshold,
+			&i.ResolvesAt,
+			&i.Liquidity,
+			&i.YesShares,
+			&i.NoShares,
+			&i.Outcome,
+			&i.ResolutionPrice,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOpenPredictionPositions = `-- name: ListOpenPredictionPositions :many
+SELECT p.market_id, p.user_id, p.yes_shares, p.no_shares, p.staked
+FROM prediction_positions p
+JOIN prediction_markets m ON m.id = p.market_id
+WHERE m.ladder_id = $1 AND m.outcome IS NULL
+ORDER BY p.user_id, p.market_id
+`
+
+func (q *Queries) ListOpenPredictionPositions(ctx context.Context, ladderID int64) ([]PredictionPosition, error) {
+	rows, err := q.db.Query(ctx, listOpenPredictionPositions, ladderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PredictionPosition
+	for rows.Next() {
+		var i PredictionPosition
+		if err := rows.Scan(
+			&i.MarketID,
+			&i.UserID,
+			&i.YesShares,
+			&i.NoShares,
+			&i.Staked,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPredictionMarkets = `-- name: ListPredictionMarkets :many
+SELECT id, ladder_id, symbol, question, threshold, resolves_at, liquidity, yes_shares, no_shares, outcome, resolution_price, resolved_at, created_at
+FROM prediction_markets
+WHERE ladder_id = $1
+ORDER BY resolves_at, id
+`
+
+func (q *Queries) ListPredictionMarkets(ctx context.Context, ladderID int64) ([]PredictionMarket, error) {
+	rows, err := q.db.Query(ctx, listPredictionMarkets, ladderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PredictionMarket
+	for rows.Next() {
+		var i PredictionMarket
+		if err := rows.Scan(
+			&i.ID,
+			&i.LadderID,
+			&i.Symbol,
+			&i.Question,
+			&i.Threshold,
+			&i.ResolvesAt,
+			&i.Liquidity,
+			&i.YesShares,
+			&i.NoShares,
+			&i.Outcome,
+			&i.ResolutionPrice,
+			&i.ResolvedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPredictionPositions = `-- name: ListPredictionPositions :many
+SELECT p.market_id, p.user_id, p.yes_shares, p.no_shares, p.staked
+FROM prediction_positions p
+JOIN prediction_markets m ON m.id = p.market_id
+WHERE m.ladder_id = $1 AND p.user_id = $2
+ORDER BY m.resolves_at, m.id
+`
+
+type ListPredictionPositionsParams struct {
+	LadderID int64
+	UserID   int64
+}
+
+func (q *Queries) ListPredictionPositions(ctx context.Context, arg ListPredictionPositionsParams) ([]PredictionPosition, error) {
+	rows, err := q.db.Query(ctx, listPredictionPositions, arg.LadderID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PredictionPosition
+	for rows.Next() {
+		var i PredictionPosition
+		if err := rows.Scan(
+			&i.MarketID,
+			&i.UserID,
+			&i.YesShares,
+			&i.NoShares,
+			&i.Staked,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markPredictionMarketResolved = `-- name: MarkPredictionMarketResolved :execrows
+UPDATE prediction_markets
+SET outcome = $2, resolution_price = $3, resolved_at = $4
+WHERE id = $1 AND outcome IS NULL
+`
+
+type MarkPredictionMarketResolvedParams struct {
+	ID              int64
+	Outcome         pgtype.Text
+	ResolutionPrice pgtype.Numeric
+	ResolvedAt      pgtype.Timestamptz
+}
+
+func (q *Queries) MarkPredictionMarketResolved(ctx context.Context, arg MarkPredictionMarketResolvedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markPredictionMarketResolved,
+		arg.ID,
+		arg.Outcome,
+		arg.ResolutionPrice,
+		arg.ResolvedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updatePredictionMarketShares = `-- name: UpdatePredictionMarketShares :exec
+UPDATE prediction_markets
+SET yes_shares = $2, no_shares = $3
+WHERE id = $1
+`
+
+type UpdatePredictionMarketSharesParams struct {
+	ID        int64
+	YesShares decimal.Decimal
+	NoShares  decimal.Decimal
+}
+
+func (q *Queries) UpdatePredictionMarketShares(ctx context.Context, arg UpdatePredictionMarketSharesParams) error {
+	_, err := q.db.Exec(ctx, updatePredictionMarketShares, arg.ID, arg.YesShares, arg.NoShares)
+	return err
+}
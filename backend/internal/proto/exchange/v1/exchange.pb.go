@@ -78,7 +78,7 @@ func (TradeAction) EnumDescriptor() ([]byte, []int) {
 // Real-time stock price data.
 type Quote struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Stock ticker symbol.
+	// Instrument ID, the provider symbol qualified with its source, e.g. FH:AAPL or CG:bitcoin.
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	// Current price in USD.
 	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
@@ -91,7 +91,18 @@ type Quote struct {
 	// Price data provider source.
 	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
 	// Indicates if the market is closed for this stock.
-	IsClosed      bool `protobuf:"varint,7,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
+	IsClosed bool `protobuf:"varint,7,opt,name=is_closed,json=isClosed,proto3" json:"is_closed,omitempty"`
+	// Exact current price as a decimal string, e.g. "0.00001235".
+	PriceDecimal string `protobuf:"bytes,8,opt,name=price_decimal,json=priceDecimal,proto3" json:"price_decimal,omitempty"`
+	// Number of decimal places the instrument is quoted to.
+	PricePrecision int32 `protobuf:"varint,9,opt,name=price_precision,json=pricePrecision,proto3" json:"price_precision,omitempty"`
+	// Best bid as a decimal string for instruments quoted two-sided, such as currency pairs;
+	// empty otherwise. The price is then the mid price.
+	Bid string `protobuf:"bytes,10,opt,name=bid,proto3" json:"bid,omitempty"`
+	// Best ask as a decimal string; empty unless the instrument is quoted two-sided.
+	Ask string `protobuf:"bytes,11,opt,name=ask,proto3" json:"ask,omitempty"`
+	// Pip size of currency pairs as a decimal string, e.g. "0.0001"; empty otherwise.
+	PipSize       string `protobuf:"bytes,12,opt,name=pip_size,json=pipSize,proto3" json:"pip_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -175,10 +186,45 @@ func (x *Quote) GetIsClosed() bool {
 	return false
 }
 
+func (x *Quote) GetPriceDecimal() string {
+	if x != nil {
+		return x.PriceDecimal
+	}
+	return ""
+}
+
+func (x *Quote) GetPricePrecision() int32 {
+	if x != nil {
+		return x.PricePrecision
+	}
+	return 0
+}
+
+func (x *Quote) GetBid() string {
+	if x != nil {
+		return x.Bid
+	}
+	return ""
+}
+
+func (x *Quote) GetAsk() string {
+	if x != nil {
+		return x.Ask
+	}
+	return ""
+}
+
+func (x *Quote) GetPipSize() string {
+	if x != nil {
+		return x.PipSize
+	}
+	return ""
+}
+
 // Request to fetch a stock quote.
 type GetQuoteRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Stock ticker symbol.
+	// Instrument ID, e.g. FH:AAPL.
 	Symbol        string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -270,7 +316,7 @@ func (x *GetQuoteResponse) GetQuote() *Quote {
 // Request to retrieve price history.
 type GetHistoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Stock ticker symbol.
+	// Instrument ID, e.g. FH:AAPL.
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	// Maximum number of historical records to return.
 	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -368,29 +414,35 @@ func (x *GetHistoryResponse) GetHistory() []*Quote {
 	return nil
 }
 
-// Request to establish a real-time quote stream.
-type StreamQuotesRequest struct {
+// Request to compute technical indicators.
+type GetIndicatorsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional ticker symbol to filter updates. If empty, streams all tickers.
-	Symbol        string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Instrument ID, e.g. FH:AAPL.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Comma-separated indicator types: sma, ema, rsi, macd, bollinger.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Lookback in quotes, between 2 and 500. Defaults to 14 for RSI and 20 otherwise; MACD always uses 12/26/9.
+	Period int32 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// Maximum number of points per indicator, 100 by default.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamQuotesRequest) Reset() {
-	*x = StreamQuotesRequest{}
+func (x *GetIndicatorsRequest) Reset() {
+	*x = GetIndicatorsRequest{}
 	mi := &file_exchange_v1_exchange_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamQuotesRequest) String() string {
+func (x *GetIndicatorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamQuotesRequest) ProtoMessage() {}
+func (*GetIndicatorsRequest) ProtoMessage() {}
 
-func (x *StreamQuotesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetIndicatorsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_exchange_v1_exchange_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -402,41 +454,62 @@ func (x *StreamQuotesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamQuotesRequest.ProtoReflect.Descriptor instead.
-func (*StreamQuotesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIndicatorsRequest.ProtoReflect.Descriptor instead.
+func (*GetIndicatorsRequest) Descriptor() ([]byte, []int) {
 	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StreamQuotesRequest) GetSymbol() string {
+func (x *GetIndicatorsRequest) GetSymbol() string {
 	if x != nil {
 		return x.Symbol
 	}
 	return ""
 }
 
-// Response payload for real-time quote stream.
-type StreamQuotesResponse struct {
+func (x *GetIndicatorsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetIndicatorsRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *GetIndicatorsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Response containing one series per requested indicator, in request order.
+type GetIndicatorsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Live stock quote update.
-	Quote         *Quote `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	// Computed indicators.
+	Indicators    []*Indicator `protobuf:"bytes,1,rep,name=indicators,proto3" json:"indicators,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamQuotesResponse) Reset() {
-	*x = StreamQuotesResponse{}
+func (x *GetIndicatorsResponse) Reset() {
+	*x = GetIndicatorsResponse{}
 	mi := &file_exchange_v1_exchange_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamQuotesResponse) String() string {
+func (x *GetIndicatorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamQuotesResponse) ProtoMessage() {}
+func (*GetIndicatorsResponse) ProtoMessage() {}
 
-func (x *StreamQuotesResponse) ProtoReflect() protoreflect.Message {
+func (x *GetIndicatorsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_exchange_v1_exchange_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -448,45 +521,45 @@ func (x *StreamQuotesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamQuotesResponse.ProtoReflect.Descriptor instead.
-func (*StreamQuotesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIndicatorsResponse.ProtoReflect.Descriptor instead.
+func (*GetIndicatorsResponse) Descriptor() ([]byte, []int) {
 	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *StreamQuotesResponse) GetQuote() *Quote {
+func (x *GetIndicatorsResponse) GetIndicators() []*Indicator {
 	if x != nil {
-		return x.Quote
+		return x.Indicators
 	}
 	return nil
 }
 
-// Request payload to place a trade.
-type CreateTradeRequest struct {
+// A technical indicator series.
+type Indicator struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Stock ticker symbol to trade.
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// Quantity of shares to trade.
-	Quantity float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	// Action to perform (Buy or Sell).
-	Action        TradeAction `protobuf:"varint,3,opt,name=action,proto3,enum=exchange.v1.TradeAction" json:"action,omitempty"`
+	// Indicator type.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Lookback in quotes; 0 for MACD.
+	Period int32 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// Indicator values, oldest first.
+	Points        []*IndicatorPoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTradeRequest) Reset() {
-	*x = CreateTradeRequest{}
+func (x *Indicator) Reset() {
+	*x = Indicator{}
 	mi := &file_exchange_v1_exchange_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTradeRequest) String() string {
+func (x *Indicator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTradeRequest) ProtoMessage() {}
+func (*Indicator) ProtoMessage() {}
 
-func (x *CreateTradeRequest) ProtoReflect() protoreflect.Message {
+func (x *Indicator) ProtoReflect() protoreflect.Message {
 	mi := &file_exchange_v1_exchange_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -498,55 +571,65 @@ func (x *CreateTradeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTradeRequest.ProtoReflect.Descriptor instead.
-func (*CreateTradeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Indicator.ProtoReflect.Descriptor instead.
+func (*Indicator) Descriptor() ([]byte, []int) {
 	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateTradeRequest) GetSymbol() string {
+func (x *Indicator) GetType() string {
 	if x != nil {
-		return x.Symbol
+		return x.Type
 	}
 	return ""
 }
 
-func (x *CreateTradeRequest) GetQuantity() float64 {
+func (x *Indicator) GetPeriod() int32 {
 	if x != nil {
-		return x.Quantity
+		return x.Period
 	}
 	return 0
 }
 
-func (x *CreateTradeRequest) GetAction() TradeAction {
+func (x *Indicator) GetPoints() []*IndicatorPoint {
 	if x != nil {
-		return x.Action
+		return x.Points
 	}
-	return TradeAction_UNSPECIFIED
+	return nil
 }
 
-// Response payload for a trade transaction.
-type CreateTradeResponse struct {
+// An indicator's value at a stored quote.
+type IndicatorPoint struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Updated standing and portfolio of the participant.
-	Participant   *v1.LadderParticipant `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// Timestamp of the quote.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// SMA, EMA, RSI, MACD line or middle Bollinger band.
+	Value float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	// MACD signal line.
+	Signal float64 `protobuf:"fixed64,3,opt,name=signal,proto3" json:"signal,omitempty"`
+	// MACD histogram.
+	Histogram float64 `protobuf:"fixed64,4,opt,name=histogram,proto3" json:"histogram,omitempty"`
+	// Upper Bollinger band.
+	Upper float64 `protobuf:"fixed64,5,opt,name=upper,proto3" json:"upper,omitempty"`
+	// Lower Bollinger band.
+	Lower         float64 `protobuf:"fixed64,6,opt,name=lower,proto3" json:"lower,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTradeResponse) Reset() {
-	*x = CreateTradeResponse{}
+func (x *IndicatorPoint) Reset() {
+	*x = IndicatorPoint{}
 	mi := &file_exchange_v1_exchange_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTradeResponse) String() string {
+func (x *IndicatorPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTradeResponse) ProtoMessage() {}
+func (*IndicatorPoint) ProtoMessage() {}
 
-func (x *CreateTradeResponse) ProtoReflect() protoreflect.Message {
+func (x *IndicatorPoint) ProtoReflect() protoreflect.Message {
 	mi := &file_exchange_v1_exchange_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -558,65 +641,3133 @@ func (x *CreateTradeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTradeResponse.ProtoReflect.Descriptor instead.
-func (*CreateTradeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IndicatorPoint.ProtoReflect.Descriptor instead.
+func (*IndicatorPoint) Descriptor() ([]byte, []int) {
 	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateTradeResponse) GetParticipant() *v1.LadderParticipant {
+func (x *IndicatorPoint) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Participant
+		return x.Timestamp
 	}
 	return nil
 }
 
-var File_exchange_v1_exchange_proto protoreflect.FileDescriptor
+func (x *IndicatorPoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
 
-const file_exchange_v1_exchange_proto_rawDesc = "" +
-	"\n" +
-	"\x1aexchange/v1/exchange.proto\x12\vexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16ladder/v1/ladder.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xe3\x01\n" +
-	"\x05Quote\x12\x16\n" +
-	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x16\n" +
-	"\x06change\x18\x03 \x01(\x01R\x06change\x12%\n" +
-	"\x0echange_percent\x18\x04 \x01(\x01R\rchangePercent\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
-	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1b\n" +
-	"\tis_closed\x18\a \x01(\bR\bisClosed\".\n" +
-	"\x0fGetQuoteRequest\x12\x1b\n" +
-	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\"<\n" +
-	"\x10GetQuoteResponse\x12(\n" +
-	"\x05quote\x18\x01 \x01(\v2\x12.exchange.v1.QuoteR\x05quote\"F\n" +
-	"\x11GetHistoryRequest\x12\x1b\n" +
-	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"B\n" +
-	"\x12GetHistoryResponse\x12,\n" +
-	"\ahistory\x18\x01 \x03(\v2\x12.exchange.v1.QuoteR\ahistory\"-\n" +
-	"\x13StreamQuotesRequest\x12\x16\n" +
-	"\x06symbol\x18\x01 \x01(\tR\x06symbol\"@\n" +
-	"\x14StreamQuotesResponse\x12(\n" +
-	"\x05quote\x18\x01 \x01(\v2\x12.exchange.v1.QuoteR\x05quote\"\x89\x01\n" +
-	"\x12CreateTradeRequest\x12\x1b\n" +
-	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x1f\n" +
-	"\bquantity\x18\x02 \x01(\x01B\x03\xe0A\x02R\bquantity\x125\n" +
-	"\x06action\x18\x03 \x01(\x0e2\x18.exchange.v1.TradeActionB\x03\xe0A\x02R\x06action\"U\n" +
-	"\x13CreateTradeResponse\x12>\n" +
-	"\vparticipant\x18\x01 \x01(\v2\x1c.ladder.v1.LadderParticipantR\vparticipant*1\n" +
-	"\vTradeAction\x12\x0f\n" +
-	"\vUNSPECIFIED\x10\x00\x12\a\n" +
-	"\x03BUY\x10\x01\x12\b\n" +
-	"\x04SELL\x10\x022\x97\x04\n" +
-	"\x0fExchangeService\x12}\n" +
-	"\bGetQuote\x12\x1c.exchange.v1.GetQuoteRequest\x1a\x1d.exchange.v1.GetQuoteResponse\"4\x92A\x12b\x10\n" +
-	"\x0e\n" +
-	"\n" +
-	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/quotes/{symbol}\x12v\n" +
-	"\n" +
-	"GetHistory\x12\x1e.exchange.v1.GetHistoryRequest\x1a\x1f.exchange.v1.GetHistoryResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/quotes/{symbol}/history\x12\x89\x01\n" +
-	"\fStreamQuotes\x12 .exchange.v1.StreamQuotesRequest\x1a!.exchange.v1.StreamQuotesResponse\"2\x92A\x12b\x10\n" +
+func (x *IndicatorPoint) GetSignal() float64 {
+	if x != nil {
+		return x.Signal
+	}
+	return 0
+}
+
+func (x *IndicatorPoint) GetHistogram() float64 {
+	if x != nil {
+		return x.Histogram
+	}
+	return 0
+}
+
+func (x *IndicatorPoint) GetUpper() float64 {
+	if x != nil {
+		return x.Upper
+	}
+	return 0
+}
+
+func (x *IndicatorPoint) GetLower() float64 {
+	if x != nil {
+		return x.Lower
+	}
+	return 0
+}
+
+// A catalogued instrument a ticker symbol stands for.
+type Instrument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID, e.g. FH:AAPL.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Source provider for market data.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// Display name, e.g. "Apple Inc".
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Asset class, e.g. "stock", "crypto" or "forex".
+	AssetClass string `protobuf:"bytes,4,opt,name=asset_class,json=assetClass,proto3" json:"asset_class,omitempty"`
+	// Currency prices are quoted in.
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Exchange or venue the instrument trades on.
+	Exchange string `protobuf:"bytes,6,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// Smallest price increment.
+	TickSize float64 `protobuf:"fixed64,7,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	// Pip size of currency pairs, e.g. 0.0001; 0 for other asset classes.
+	PipSize       float64 `protobuf:"fixed64,8,opt,name=pip_size,json=pipSize,proto3" json:"pip_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Instrument) Reset() {
+	*x = Instrument{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instrument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instrument) ProtoMessage() {}
+
+func (x *Instrument) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instrument.ProtoReflect.Descriptor instead.
+func (*Instrument) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Instrument) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Instrument) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Instrument) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Instrument) GetAssetClass() string {
+	if x != nil {
+		return x.AssetClass
+	}
+	return ""
+}
+
+func (x *Instrument) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Instrument) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *Instrument) GetTickSize() float64 {
+	if x != nil {
+		return x.TickSize
+	}
+	return 0
+}
+
+func (x *Instrument) GetPipSize() float64 {
+	if x != nil {
+		return x.PipSize
+	}
+	return 0
+}
+
+// Request to search the instrument catalogue.
+type SearchInstrumentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Symbol or name to search for. Empty lists the catalogue.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Maximum number of instruments to return.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchInstrumentsRequest) Reset() {
+	*x = SearchInstrumentsRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchInstrumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchInstrumentsRequest) ProtoMessage() {}
+
+func (x *SearchInstrumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchInstrumentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchInstrumentsRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchInstrumentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchInstrumentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Response containing matching instruments, best matches first.
+type SearchInstrumentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matching instruments.
+	Instruments   []*Instrument `protobuf:"bytes,1,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchInstrumentsResponse) Reset() {
+	*x = SearchInstrumentsResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchInstrumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchInstrumentsResponse) ProtoMessage() {}
+
+func (x *SearchInstrumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchInstrumentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchInstrumentsResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchInstrumentsResponse) GetInstruments() []*Instrument {
+	if x != nil {
+		return x.Instruments
+	}
+	return nil
+}
+
+// Request to establish a real-time quote stream.
+type StreamQuotesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional instrument ID to filter updates. Deprecated in favour of symbols.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Optional instrument IDs to multiplex over one stream, e.g. ?symbols=FH:AAPL,CG:bitcoin.
+	// If both symbol and symbols are empty, streams all tickers of the active ladder.
+	Symbols       []string `protobuf:"bytes,2,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamQuotesRequest) Reset() {
+	*x = StreamQuotesRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQuotesRequest) ProtoMessage() {}
+
+func (x *StreamQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQuotesRequest.ProtoReflect.Descriptor instead.
+func (*StreamQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamQuotesRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *StreamQuotesRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+// Response payload for real-time quote stream.
+type StreamQuotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Live stock quote update.
+	Quote *Quote `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	// Event ID of the quote. Clients resume a stream by sending the last one they received
+	// as "last-event-id" metadata.
+	EventId       string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamQuotesResponse) Reset() {
+	*x = StreamQuotesResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamQuotesResponse) ProtoMessage() {}
+
+func (x *StreamQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamQuotesResponse.ProtoReflect.Descriptor instead.
+func (*StreamQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StreamQuotesResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *StreamQuotesResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// Request payload to place a trade.
+type CreateTradeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID to trade, e.g. FH:AAPL.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Quantity of shares to trade.
+	Quantity float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Action to perform (Buy or Sell).
+	Action        TradeAction `protobuf:"varint,3,opt,name=action,proto3,enum=exchange.v1.TradeAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTradeRequest) Reset() {
+	*x = CreateTradeRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTradeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTradeRequest) ProtoMessage() {}
+
+func (x *CreateTradeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTradeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTradeRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateTradeRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateTradeRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateTradeRequest) GetAction() TradeAction {
+	if x != nil {
+		return x.Action
+	}
+	return TradeAction_UNSPECIFIED
+}
+
+// Response payload for a trade transaction.
+type CreateTradeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Updated standing and portfolio of the participant.
+	Participant   *v1.LadderParticipant `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTradeResponse) Reset() {
+	*x = CreateTradeResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTradeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTradeResponse) ProtoMessage() {}
+
+func (x *CreateTradeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTradeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTradeResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateTradeResponse) GetParticipant() *v1.LadderParticipant {
+	if x != nil {
+		return x.Participant
+	}
+	return nil
+}
+
+// A quote that failed the fetcher's sanity checks and awaits review.
+type QuarantinedQuote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Quarantine entry identifier.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The quote as received from the provider.
+	Quote *Quote `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// Check that failed, e.g. "deviation" or "stale".
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Human-readable explanation of the failed check.
+	Detail string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	// When the quote was quarantined.
+	QuarantinedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=quarantined_at,json=quarantinedAt,proto3" json:"quarantined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuarantinedQuote) Reset() {
+	*x = QuarantinedQuote{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuarantinedQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuarantinedQuote) ProtoMessage() {}
+
+func (x *QuarantinedQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuarantinedQuote.ProtoReflect.Descriptor instead.
+func (*QuarantinedQuote) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *QuarantinedQuote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *QuarantinedQuote) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *QuarantinedQuote) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *QuarantinedQuote) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *QuarantinedQuote) GetQuarantinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuarantinedAt
+	}
+	return nil
+}
+
+// Request to list quarantined quotes.
+type ListQuarantinedQuotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedQuotesRequest) Reset() {
+	*x = ListQuarantinedQuotesRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedQuotesRequest) ProtoMessage() {}
+
+func (x *ListQuarantinedQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedQuotesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{17}
+}
+
+// Response containing quarantined quotes, oldest first.
+type ListQuarantinedQuotesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Quotes awaiting review.
+	Quotes        []*QuarantinedQuote `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuarantinedQuotesResponse) Reset() {
+	*x = ListQuarantinedQuotesResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuarantinedQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuarantinedQuotesResponse) ProtoMessage() {}
+
+func (x *ListQuarantinedQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuarantinedQuotesResponse.ProtoReflect.Descriptor instead.
+func (*ListQuarantinedQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListQuarantinedQuotesResponse) GetQuotes() []*QuarantinedQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+// Request to release a quarantined quote.
+type ReleaseQuarantinedQuoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Quarantine entry identifier.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuarantinedQuoteRequest) Reset() {
+	*x = ReleaseQuarantinedQuoteRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuarantinedQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuarantinedQuoteRequest) ProtoMessage() {}
+
+func (x *ReleaseQuarantinedQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuarantinedQuoteRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseQuarantinedQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReleaseQuarantinedQuoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response containing the released quote.
+type ReleaseQuarantinedQuoteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The quote that was published.
+	Quote         *Quote `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuarantinedQuoteResponse) Reset() {
+	*x = ReleaseQuarantinedQuoteResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuarantinedQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuarantinedQuoteResponse) ProtoMessage() {}
+
+func (x *ReleaseQuarantinedQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuarantinedQuoteResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseQuarantinedQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseQuarantinedQuoteResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// Request to discard a quarantined quote.
+type DiscardQuarantinedQuoteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Quarantine entry identifier.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardQuarantinedQuoteRequest) Reset() {
+	*x = DiscardQuarantinedQuoteRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardQuarantinedQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardQuarantinedQuoteRequest) ProtoMessage() {}
+
+func (x *DiscardQuarantinedQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardQuarantinedQuoteRequest.ProtoReflect.Descriptor instead.
+func (*DiscardQuarantinedQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DiscardQuarantinedQuoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response for a discarded quote.
+type DiscardQuarantinedQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscardQuarantinedQuoteResponse) Reset() {
+	*x = DiscardQuarantinedQuoteResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscardQuarantinedQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardQuarantinedQuoteResponse) ProtoMessage() {}
+
+func (x *DiscardQuarantinedQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardQuarantinedQuoteResponse.ProtoReflect.Descriptor instead.
+func (*DiscardQuarantinedQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{22}
+}
+
+// A weighted component of a basket.
+type BasketComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of the component, e.g. "FH:AAPL".
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Share of the basket's value at creation. Normalized to sum to 1; omit all weights to
+	// weight the components equally.
+	Weight float64 `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Units of the component held per basket, fixed at creation.
+	Units         float64 `protobuf:"fixed64,3,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BasketComponent) Reset() {
+	*x = BasketComponent{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BasketComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasketComponent) ProtoMessage() {}
+
+func (x *BasketComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasketComponent.ProtoReflect.Descriptor instead.
+func (*BasketComponent) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BasketComponent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *BasketComponent) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *BasketComponent) GetUnits() float64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+// A composite instrument priced from the quotes of its components.
+type Basket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of the basket, e.g. "BK:TECH5".
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Price of the basket at creation.
+	BaseValue float64 `protobuf:"fixed64,3,opt,name=base_value,json=baseValue,proto3" json:"base_value,omitempty"`
+	// Weighted components.
+	Components []*BasketComponent `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	// When the basket was created.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Username of the player who published the basket as a custom index. Empty for baskets
+	// defined by admins.
+	Owner string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	// How often the original weights are restored: "none", "weekly", "monthly" or "quarterly".
+	Rebalance string `protobuf:"bytes,7,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	// When the weights were last restored.
+	RebalancedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=rebalanced_at,json=rebalancedAt,proto3" json:"rebalanced_at,omitempty"`
+	// Number of players following the basket.
+	FollowerCount int64 `protobuf:"varint,9,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Basket) Reset() {
+	*x = Basket{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Basket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Basket) ProtoMessage() {}
+
+func (x *Basket) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Basket.ProtoReflect.Descriptor instead.
+func (*Basket) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Basket) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Basket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Basket) GetBaseValue() float64 {
+	if x != nil {
+		return x.BaseValue
+	}
+	return 0
+}
+
+func (x *Basket) GetComponents() []*BasketComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Basket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Basket) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Basket) GetRebalance() string {
+	if x != nil {
+		return x.Rebalance
+	}
+	return ""
+}
+
+func (x *Basket) GetRebalancedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RebalancedAt
+	}
+	return nil
+}
+
+func (x *Basket) GetFollowerCount() int64 {
+	if x != nil {
+		return x.FollowerCount
+	}
+	return 0
+}
+
+// Request to create a basket.
+type CreateBasketRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Symbol of the basket, e.g. "TECH5". It is qualified as "BK:TECH5".
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Display name. Defaults to the symbol.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Between 2 and 20 distinct components.
+	Components    []*BasketComponent `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBasketRequest) Reset() {
+	*x = CreateBasketRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBasketRequest) ProtoMessage() {}
+
+func (x *CreateBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBasketRequest.ProtoReflect.Descriptor instead.
+func (*CreateBasketRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateBasketRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateBasketRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBasketRequest) GetComponents() []*BasketComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+// Response containing the created basket.
+type CreateBasketResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The created basket.
+	Basket        *Basket `protobuf:"bytes,1,opt,name=basket,proto3" json:"basket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBasketResponse) Reset() {
+	*x = CreateBasketResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBasketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBasketResponse) ProtoMessage() {}
+
+func (x *CreateBasketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBasketResponse.ProtoReflect.Descriptor instead.
+func (*CreateBasketResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateBasketResponse) GetBasket() *Basket {
+	if x != nil {
+		return x.Basket
+	}
+	return nil
+}
+
+// Request to list baskets.
+type ListBasketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBasketsRequest) Reset() {
+	*x = ListBasketsRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBasketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBasketsRequest) ProtoMessage() {}
+
+func (x *ListBasketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBasketsRequest.ProtoReflect.Descriptor instead.
+func (*ListBasketsRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{27}
+}
+
+// Response containing all baskets, in symbol order.
+type ListBasketsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defined baskets.
+	Baskets       []*Basket `protobuf:"bytes,1,rep,name=baskets,proto3" json:"baskets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBasketsResponse) Reset() {
+	*x = ListBasketsResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBasketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBasketsResponse) ProtoMessage() {}
+
+func (x *ListBasketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBasketsResponse.ProtoReflect.Descriptor instead.
+func (*ListBasketsResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListBasketsResponse) GetBaskets() []*Basket {
+	if x != nil {
+		return x.Baskets
+	}
+	return nil
+}
+
+// Request to delete a basket.
+type DeleteBasketRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of the basket.
+	Symbol        string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBasketRequest) Reset() {
+	*x = DeleteBasketRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBasketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBasketRequest) ProtoMessage() {}
+
+func (x *DeleteBasketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBasketRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBasketRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteBasketRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// Response for a deleted basket.
+type DeleteBasketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBasketResponse) Reset() {
+	*x = DeleteBasketResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBasketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBasketResponse) ProtoMessage() {}
+
+func (x *DeleteBasketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBasketResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBasketResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{30}
+}
+
+// A custom index with its current performance.
+type Index struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The index and its components.
+	Basket *Basket `protobuf:"bytes,1,opt,name=basket,proto3" json:"basket,omitempty"`
+	// Current quote. Unset while a component has no price.
+	Quote *Quote `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	// Change of the price since creation, in percent.
+	ReturnPercent float64 `protobuf:"fixed64,3,opt,name=return_percent,json=returnPercent,proto3" json:"return_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Index) Reset() {
+	*x = Index{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Index) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Index) ProtoMessage() {}
+
+func (x *Index) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Index.ProtoReflect.Descriptor instead.
+func (*Index) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Index) GetBasket() *Basket {
+	if x != nil {
+		return x.Basket
+	}
+	return nil
+}
+
+func (x *Index) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+func (x *Index) GetReturnPercent() float64 {
+	if x != nil {
+		return x.ReturnPercent
+	}
+	return 0
+}
+
+// Request to publish a custom index.
+type CreateIndexRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Symbol of the index, e.g. "MYTECH". It is qualified as "BK:MYTECH".
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Display name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Between 2 and 20 distinct instruments of the active ladder.
+	Components []*BasketComponent `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	// How often the weights are restored: "none" (default), "weekly", "monthly" or "quarterly".
+	Rebalance     string `protobuf:"bytes,4,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIndexRequest) Reset() {
+	*x = CreateIndexRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIndexRequest) ProtoMessage() {}
+
+func (x *CreateIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIndexRequest.ProtoReflect.Descriptor instead.
+func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateIndexRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreateIndexRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateIndexRequest) GetComponents() []*BasketComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CreateIndexRequest) GetRebalance() string {
+	if x != nil {
+		return x.Rebalance
+	}
+	return ""
+}
+
+// Response containing the published index.
+type CreateIndexResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The published index.
+	Basket        *Basket `protobuf:"bytes,1,opt,name=basket,proto3" json:"basket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIndexResponse) Reset() {
+	*x = CreateIndexResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIndexResponse) ProtoMessage() {}
+
+func (x *CreateIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIndexResponse.ProtoReflect.Descriptor instead.
+func (*CreateIndexResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateIndexResponse) GetBasket() *Basket {
+	if x != nil {
+		return x.Basket
+	}
+	return nil
+}
+
+// Request to list custom indices.
+type ListIndicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of indices to return, at most 100.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Pagination offset.
+	Offset        int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIndicesRequest) Reset() {
+	*x = ListIndicesRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIndicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIndicesRequest) ProtoMessage() {}
+
+func (x *ListIndicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIndicesRequest.ProtoReflect.Descriptor instead.
+func (*ListIndicesRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListIndicesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListIndicesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// Response containing a page of custom indices, in symbol order.
+type ListIndicesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Published indices.
+	Indices       []*Index `protobuf:"bytes,1,rep,name=indices,proto3" json:"indices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIndicesResponse) Reset() {
+	*x = ListIndicesResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIndicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIndicesResponse) ProtoMessage() {}
+
+func (x *ListIndicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIndicesResponse.ProtoReflect.Descriptor instead.
+func (*ListIndicesResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListIndicesResponse) GetIndices() []*Index {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+// Request to fetch the public page of a custom index.
+type GetIndexRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of the index.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Maximum number of historical prices to return.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIndexRequest) Reset() {
+	*x = GetIndexRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexRequest) ProtoMessage() {}
+
+func (x *GetIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexRequest.ProtoReflect.Descriptor instead.
+func (*GetIndexRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetIndexRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *GetIndexRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Response containing the public page of a custom index.
+type GetIndexResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The index and its performance.
+	Index *Index `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Usernames of the followers, earliest first.
+	Followers []string `protobuf:"bytes,2,rep,name=followers,proto3" json:"followers,omitempty"`
+	// Most recent recorded prices, oldest first.
+	History       []*Quote `protobuf:"bytes,3,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIndexResponse) Reset() {
+	*x = GetIndexResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIndexResponse) ProtoMessage() {}
+
+func (x *GetIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIndexResponse.ProtoReflect.Descriptor instead.
+func (*GetIndexResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetIndexResponse) GetIndex() *Index {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *GetIndexResponse) GetFollowers() []string {
+	if x != nil {
+		return x.Followers
+	}
+	return nil
+}
+
+func (x *GetIndexResponse) GetHistory() []*Quote {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Request to follow a custom index.
+type FollowIndexRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of the index.
+	Symbol        string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowIndexRequest) Reset() {
+	*x = FollowIndexRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowIndexRequest) ProtoMessage() {}
+
+func (x *FollowIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowIndexRequest.ProtoReflect.Descriptor instead.
+func (*FollowIndexRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FollowIndexRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// Response for a followed index.
+type FollowIndexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowIndexResponse) Reset() {
+	*x = FollowIndexResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowIndexResponse) ProtoMessage() {}
+
+func (x *FollowIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowIndexResponse.ProtoReflect.Descriptor instead.
+func (*FollowIndexResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{39}
+}
+
+// Request to stop following a custom index.
+type UnfollowIndexRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of the index.
+	Symbol        string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowIndexRequest) Reset() {
+	*x = UnfollowIndexRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowIndexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowIndexRequest) ProtoMessage() {}
+
+func (x *UnfollowIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowIndexRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowIndexRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnfollowIndexRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// Response for an unfollowed index.
+type UnfollowIndexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowIndexResponse) Reset() {
+	*x = UnfollowIndexResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowIndexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowIndexResponse) ProtoMessage() {}
+
+func (x *UnfollowIndexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowIndexResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowIndexResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{41}
+}
+
+// A cash-settled European option listed in a ladder.
+type OptionContract struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of the contract, e.g. "OPT:AAPL-261120-C200".
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Instrument ID of the underlying.
+	Underlying string `protobuf:"bytes,2,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	// "call" or "put".
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Strike price, in USD.
+	Strike float64 `protobuf:"fixed64,4,opt,name=strike,proto3" json:"strike,omitempty"`
+	// When the contract expires and is settled.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Annualized volatility the premium is priced with. Zero uses the configured or historical
+	// volatility of the underlying.
+	Volatility float64 `protobuf:"fixed64,6,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	// Current premium per unit of the underlying. Unset until the contract is priced.
+	Premium *Quote `protobuf:"bytes,7,opt,name=premium,proto3" json:"premium,omitempty"`
+	// Price of the underlying the contract was settled at.
+	SettlementPrice float64 `protobuf:"fixed64,8,opt,name=settlement_price,json=settlementPrice,proto3" json:"settlement_price,omitempty"`
+	// When the contract was settled. Unset until it expires.
+	SettledAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptionContract) Reset() {
+	*x = OptionContract{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptionContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionContract) ProtoMessage() {}
+
+func (x *OptionContract) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionContract.ProtoReflect.Descriptor instead.
+func (*OptionContract) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OptionContract) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OptionContract) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *OptionContract) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OptionContract) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *OptionContract) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *OptionContract) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+func (x *OptionContract) GetPremium() *Quote {
+	if x != nil {
+		return x.Premium
+	}
+	return nil
+}
+
+func (x *OptionContract) GetSettlementPrice() float64 {
+	if x != nil {
+		return x.SettlementPrice
+	}
+	return 0
+}
+
+func (x *OptionContract) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+// Request to list an option contract.
+type CreateOptionContractRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of a USD-priced underlying of the active ladder.
+	Underlying string `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	// "call" or "put".
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Strike price, in USD.
+	Strike float64 `protobuf:"fixed64,3,opt,name=strike,proto3" json:"strike,omitempty"`
+	// Expiry, before the end of the active ladder.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Annualized volatility to price the premium with, e.g. 0.3. Omit to use the configured
+	// or historical volatility of the underlying.
+	Volatility    float64 `protobuf:"fixed64,5,opt,name=volatility,proto3" json:"volatility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOptionContractRequest) Reset() {
+	*x = CreateOptionContractRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOptionContractRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOptionContractRequest) ProtoMessage() {}
+
+func (x *CreateOptionContractRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOptionContractRequest.ProtoReflect.Descriptor instead.
+func (*CreateOptionContractRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateOptionContractRequest) GetUnderlying() string {
+	if x != nil {
+		return x.Underlying
+	}
+	return ""
+}
+
+func (x *CreateOptionContractRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateOptionContractRequest) GetStrike() float64 {
+	if x != nil {
+		return x.Strike
+	}
+	return 0
+}
+
+func (x *CreateOptionContractRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateOptionContractRequest) GetVolatility() float64 {
+	if x != nil {
+		return x.Volatility
+	}
+	return 0
+}
+
+// Response containing the listed option contract.
+type CreateOptionContractResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The listed contract.
+	Contract      *OptionContract `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOptionContractResponse) Reset() {
+	*x = CreateOptionContractResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOptionContractResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOptionContractResponse) ProtoMessage() {}
+
+func (x *CreateOptionContractResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOptionContractResponse.ProtoReflect.Descriptor instead.
+func (*CreateOptionContractResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateOptionContractResponse) GetContract() *OptionContract {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+// Request to list option contracts.
+type ListOptionContractsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOptionContractsRequest) Reset() {
+	*x = ListOptionContractsRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOptionContractsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptionContractsRequest) ProtoMessage() {}
+
+func (x *ListOptionContractsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptionContractsRequest.ProtoReflect.Descriptor instead.
+func (*ListOptionContractsRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{45}
+}
+
+// Response containing the option contracts of the active ladder.
+type ListOptionContractsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Contracts by underlying and expiry.
+	Contracts     []*OptionContract `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOptionContractsResponse) Reset() {
+	*x = ListOptionContractsResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOptionContractsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOptionContractsResponse) ProtoMessage() {}
+
+func (x *ListOptionContractsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOptionContractsResponse.ProtoReflect.Descriptor instead.
+func (*ListOptionContractsResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListOptionContractsResponse) GetContracts() []*OptionContract {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+// A binary market on whether a ticker's price is above a threshold at the resolution time.
+// Shares are priced by a logarithmic market scoring rule market maker, and every winning
+// share pays out one unit of the ladder's base currency.
+type PredictionMarket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique identifier of the market.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Instrument ID of the ticker the question is about.
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// The question, e.g. "Will Bitcoin close above 100000 USD on Fri 23 Oct 2026 20:00 UTC?".
+	Question string `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	// Price the ticker must be above for the market to resolve YES, in the ticker's currency.
+	Threshold float64 `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// When the market closes and is resolved.
+	ResolvesAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolves_at,json=resolvesAt,proto3" json:"resolves_at,omitempty"`
+	// Liquidity parameter of the market maker; higher values make stakes move prices less.
+	Liquidity float64 `protobuf:"fixed64,6,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	// Price of a YES share, which is also the implied probability of YES.
+	YesPrice float64 `protobuf:"fixed64,7,opt,name=yes_price,json=yesPrice,proto3" json:"yes_price,omitempty"`
+	// Price of a NO share.
+	NoPrice float64 `protobuf:"fixed64,8,opt,name=no_price,json=noPrice,proto3" json:"no_price,omitempty"`
+	// Outstanding YES shares.
+	YesShares float64 `protobuf:"fixed64,9,opt,name=yes_shares,json=yesShares,proto3" json:"yes_shares,omitempty"`
+	// Outstanding NO shares.
+	NoShares float64 `protobuf:"fixed64,10,opt,name=no_shares,json=noShares,proto3" json:"no_shares,omitempty"`
+	// "yes" or "no" once resolved, empty before.
+	Outcome string `protobuf:"bytes,11,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// Recorded price of the ticker at the resolution time.
+	ResolutionPrice float64 `protobuf:"fixed64,12,opt,name=resolution_price,json=resolutionPrice,proto3" json:"resolution_price,omitempty"`
+	// When the market was resolved. Unset until it is.
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredictionMarket) Reset() {
+	*x = PredictionMarket{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredictionMarket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredictionMarket) ProtoMessage() {}
+
+func (x *PredictionMarket) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredictionMarket.ProtoReflect.Descriptor instead.
+func (*PredictionMarket) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PredictionMarket) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PredictionMarket) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PredictionMarket) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *PredictionMarket) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *PredictionMarket) GetResolvesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvesAt
+	}
+	return nil
+}
+
+func (x *PredictionMarket) GetLiquidity() float64 {
+	if x != nil {
+		return x.Liquidity
+	}
+	return 0
+}
+
+func (x *PredictionMarket) GetYesPrice() float64 {
+	if x != nil {
+		return x.YesPrice
+	}
+	return 0
+}
+
+func (x *PredictionMarket) GetNoPrice() float64 {
+	if x != nil {
+		return x.NoPrice
+	}
+	return 0
+}
+
+func (x *PredictionMarket) GetYesShares() float64 {
+	if x != nil {
+		return x.YesShares
+	}
+	return 0
+}
+
+func (x *PredictionMarket) GetNoShares() float64 {
+	if x != nil {
+		return x.NoShares
+	}
+	return 0
+}
+
+func (x *PredictionMarket) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *PredictionMarket) GetResolutionPrice() float64 {
+	if x != nil {
+		return x.ResolutionPrice
+	}
+	return 0
+}
+
+func (x *PredictionMarket) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+// A user's shares in a prediction market.
+type PredictionPosition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the market.
+	MarketId int64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// YES shares held.
+	YesShares float64 `protobuf:"fixed64,2,opt,name=yes_shares,json=yesShares,proto3" json:"yes_shares,omitempty"`
+	// NO shares held.
+	NoShares float64 `protobuf:"fixed64,3,opt,name=no_shares,json=noShares,proto3" json:"no_shares,omitempty"`
+	// Total cash staked on the market, in the ladder's base currency.
+	Staked        float64 `protobuf:"fixed64,4,opt,name=staked,proto3" json:"staked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PredictionPosition) Reset() {
+	*x = PredictionPosition{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PredictionPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PredictionPosition) ProtoMessage() {}
+
+func (x *PredictionPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PredictionPosition.ProtoReflect.Descriptor instead.
+func (*PredictionPosition) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *PredictionPosition) GetMarketId() int64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *PredictionPosition) GetYesShares() float64 {
+	if x != nil {
+		return x.YesShares
+	}
+	return 0
+}
+
+func (x *PredictionPosition) GetNoShares() float64 {
+	if x != nil {
+		return x.NoShares
+	}
+	return 0
+}
+
+func (x *PredictionPosition) GetStaked() float64 {
+	if x != nil {
+		return x.Staked
+	}
+	return 0
+}
+
+// Request to open a prediction market.
+type CreatePredictionMarketRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Instrument ID of a ticker of the active ladder.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Price the ticker must be above for the market to resolve YES.
+	Threshold float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Resolution time, before the end of the active ladder.
+	ResolvesAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=resolves_at,json=resolvesAt,proto3" json:"resolves_at,omitempty"`
+	// Question to display. Omit to phrase it from the terms.
+	Question string `protobuf:"bytes,4,opt,name=question,proto3" json:"question,omitempty"`
+	// Liquidity parameter of the market maker. Omit to use the configured default.
+	Liquidity     float64 `protobuf:"fixed64,5,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePredictionMarketRequest) Reset() {
+	*x = CreatePredictionMarketRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePredictionMarketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePredictionMarketRequest) ProtoMessage() {}
+
+func (x *CreatePredictionMarketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePredictionMarketRequest.ProtoReflect.Descriptor instead.
+func (*CreatePredictionMarketRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreatePredictionMarketRequest) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *CreatePredictionMarketRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CreatePredictionMarketRequest) GetResolvesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvesAt
+	}
+	return nil
+}
+
+func (x *CreatePredictionMarketRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *CreatePredictionMarketRequest) GetLiquidity() float64 {
+	if x != nil {
+		return x.Liquidity
+	}
+	return 0
+}
+
+// Response containing the opened prediction market.
+type CreatePredictionMarketResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The opened market.
+	Market        *PredictionMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePredictionMarketResponse) Reset() {
+	*x = CreatePredictionMarketResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePredictionMarketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePredictionMarketResponse) ProtoMessage() {}
+
+func (x *CreatePredictionMarketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePredictionMarketResponse.ProtoReflect.Descriptor instead.
+func (*CreatePredictionMarketResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreatePredictionMarketResponse) GetMarket() *PredictionMarket {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+// Request to list prediction markets.
+type ListPredictionMarketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPredictionMarketsRequest) Reset() {
+	*x = ListPredictionMarketsRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPredictionMarketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPredictionMarketsRequest) ProtoMessage() {}
+
+func (x *ListPredictionMarketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPredictionMarketsRequest.ProtoReflect.Descriptor instead.
+func (*ListPredictionMarketsRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{51}
+}
+
+// Response containing the prediction markets of the active ladder.
+type ListPredictionMarketsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Markets by resolution time.
+	Markets       []*PredictionMarket `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPredictionMarketsResponse) Reset() {
+	*x = ListPredictionMarketsResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPredictionMarketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPredictionMarketsResponse) ProtoMessage() {}
+
+func (x *ListPredictionMarketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPredictionMarketsResponse.ProtoReflect.Descriptor instead.
+func (*ListPredictionMarketsResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListPredictionMarketsResponse) GetMarkets() []*PredictionMarket {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+// Request to stake on a prediction market.
+type StakePredictionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifier of the market.
+	MarketId int64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// "yes" or "no".
+	Outcome string `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	// Cash to stake, in the ladder's base currency.
+	Amount        float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakePredictionRequest) Reset() {
+	*x = StakePredictionRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakePredictionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakePredictionRequest) ProtoMessage() {}
+
+func (x *StakePredictionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakePredictionRequest.ProtoReflect.Descriptor instead.
+func (*StakePredictionRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StakePredictionRequest) GetMarketId() int64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *StakePredictionRequest) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *StakePredictionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Response containing the market and the user's position after a stake.
+type StakePredictionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The market with its updated prices.
+	Market *PredictionMarket `protobuf:"bytes,1,opt,name=market,proto3" json:"market,omitempty"`
+	// The user's position in the market.
+	Position      *PredictionPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakePredictionResponse) Reset() {
+	*x = StakePredictionResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakePredictionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakePredictionResponse) ProtoMessage() {}
+
+func (x *StakePredictionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakePredictionResponse.ProtoReflect.Descriptor instead.
+func (*StakePredictionResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StakePredictionResponse) GetMarket() *PredictionMarket {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
+func (x *StakePredictionResponse) GetPosition() *PredictionPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+// Request to list the user's prediction positions.
+type ListPredictionPositionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPredictionPositionsRequest) Reset() {
+	*x = ListPredictionPositionsRequest{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPredictionPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPredictionPositionsRequest) ProtoMessage() {}
+
+func (x *ListPredictionPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPredictionPositionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPredictionPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{55}
+}
+
+// Response containing the user's prediction positions.
+type ListPredictionPositionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Positions by market resolution time.
+	Positions     []*PredictionPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPredictionPositionsResponse) Reset() {
+	*x = ListPredictionPositionsResponse{}
+	mi := &file_exchange_v1_exchange_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPredictionPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPredictionPositionsResponse) ProtoMessage() {}
+
+func (x *ListPredictionPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_exchange_v1_exchange_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPredictionPositionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPredictionPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_exchange_v1_exchange_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListPredictionPositionsResponse) GetPositions() []*PredictionPosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+var File_exchange_v1_exchange_proto protoreflect.FileDescriptor
+
+const file_exchange_v1_exchange_proto_rawDesc = "" +
+	"\n" +
+	"\x1aexchange/v1/exchange.proto\x12\vexchange.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16ladder/v1/ladder.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf0\x02\n" +
+	"\x05Quote\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06change\x18\x03 \x01(\x01R\x06change\x12%\n" +
+	"\x0echange_percent\x18\x04 \x01(\x01R\rchangePercent\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1b\n" +
+	"\tis_closed\x18\a \x01(\bR\bisClosed\x12#\n" +
+	"\rprice_decimal\x18\b \x01(\tR\fpriceDecimal\x12'\n" +
+	"\x0fprice_precision\x18\t \x01(\x05R\x0epricePrecision\x12\x10\n" +
+	"\x03bid\x18\n" +
+	" \x01(\tR\x03bid\x12\x10\n" +
+	"\x03ask\x18\v \x01(\tR\x03ask\x12\x19\n" +
+	"\bpip_size\x18\f \x01(\tR\apipSize\".\n" +
+	"\x0fGetQuoteRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\"<\n" +
+	"\x10GetQuoteResponse\x12(\n" +
+	"\x05quote\x18\x01 \x01(\v2\x12.exchange.v1.QuoteR\x05quote\"F\n" +
+	"\x11GetHistoryRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"B\n" +
+	"\x12GetHistoryResponse\x12,\n" +
+	"\ahistory\x18\x01 \x03(\v2\x12.exchange.v1.QuoteR\ahistory\"z\n" +
+	"\x14GetIndicatorsRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tB\x03\xe0A\x02R\x04type\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\x05R\x06period\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"O\n" +
+	"\x15GetIndicatorsResponse\x126\n" +
+	"\n" +
+	"indicators\x18\x01 \x03(\v2\x16.exchange.v1.IndicatorR\n" +
+	"indicators\"l\n" +
+	"\tIndicator\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\x05R\x06period\x123\n" +
+	"\x06points\x18\x03 \x03(\v2\x1b.exchange.v1.IndicatorPointR\x06points\"\xc2\x01\n" +
+	"\x0eIndicatorPoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x16\n" +
+	"\x06signal\x18\x03 \x01(\x01R\x06signal\x12\x1c\n" +
+	"\thistogram\x18\x04 \x01(\x01R\thistogram\x12\x14\n" +
+	"\x05upper\x18\x05 \x01(\x01R\x05upper\x12\x14\n" +
+	"\x05lower\x18\x06 \x01(\x01R\x05lower\"\xe1\x01\n" +
+	"\n" +
+	"Instrument\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vasset_class\x18\x04 \x01(\tR\n" +
+	"assetClass\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bexchange\x18\x06 \x01(\tR\bexchange\x12\x1b\n" +
+	"\ttick_size\x18\a \x01(\x01R\btickSize\x12\x19\n" +
+	"\bpip_size\x18\b \x01(\x01R\apipSize\"F\n" +
+	"\x18SearchInstrumentsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"V\n" +
+	"\x19SearchInstrumentsResponse\x129\n" +
+	"\vinstruments\x18\x01 \x03(\v2\x17.exchange.v1.InstrumentR\vinstruments\"G\n" +
+	"\x13StreamQuotesRequest\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x18\n" +
+	"\asymbols\x18\x02 \x03(\tR\asymbols\"[\n" +
+	"\x14StreamQuotesResponse\x12(\n" +
+	"\x05quote\x18\x01 \x01(\v2\x12.exchange.v1.QuoteR\x05quote\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"\x89\x01\n" +
+	"\x12CreateTradeRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x1f\n" +
+	"\bquantity\x18\x02 \x01(\x01B\x03\xe0A\x02R\bquantity\x125\n" +
+	"\x06action\x18\x03 \x01(\x0e2\x18.exchange.v1.TradeActionB\x03\xe0A\x02R\x06action\"U\n" +
+	"\x13CreateTradeResponse\x12>\n" +
+	"\vparticipant\x18\x01 \x01(\v2\x1c.ladder.v1.LadderParticipantR\vparticipant\"\xbf\x01\n" +
+	"\x10QuarantinedQuote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x05quote\x18\x02 \x01(\v2\x12.exchange.v1.QuoteR\x05quote\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12A\n" +
+	"\x0equarantined_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rquarantinedAt\"\x1e\n" +
+	"\x1cListQuarantinedQuotesRequest\"V\n" +
+	"\x1dListQuarantinedQuotesResponse\x125\n" +
+	"\x06quotes\x18\x01 \x03(\v2\x1d.exchange.v1.QuarantinedQuoteR\x06quotes\"5\n" +
+	"\x1eReleaseQuarantinedQuoteRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"K\n" +
+	"\x1fReleaseQuarantinedQuoteResponse\x12(\n" +
+	"\x05quote\x18\x01 \x01(\v2\x12.exchange.v1.QuoteR\x05quote\"5\n" +
+	"\x1eDiscardQuarantinedQuoteRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"!\n" +
+	"\x1fDiscardQuarantinedQuoteResponse\"a\n" +
+	"\x0fBasketComponent\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\x12\x19\n" +
+	"\x05units\x18\x03 \x01(\x01B\x03\xe0A\x03R\x05units\"\xe8\x02\n" +
+	"\x06Basket\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"base_value\x18\x03 \x01(\x01R\tbaseValue\x12<\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2\x1c.exchange.v1.BasketComponentR\n" +
+	"components\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05owner\x18\x06 \x01(\tR\x05owner\x12\x1c\n" +
+	"\trebalance\x18\a \x01(\tR\trebalance\x12?\n" +
+	"\rrebalanced_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\frebalancedAt\x12%\n" +
+	"\x0efollower_count\x18\t \x01(\x03R\rfollowerCount\"\x89\x01\n" +
+	"\x13CreateBasketRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12A\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v2\x1c.exchange.v1.BasketComponentB\x03\xe0A\x02R\n" +
+	"components\"C\n" +
+	"\x14CreateBasketResponse\x12+\n" +
+	"\x06basket\x18\x01 \x01(\v2\x13.exchange.v1.BasketR\x06basket\"\x14\n" +
+	"\x12ListBasketsRequest\"D\n" +
+	"\x13ListBasketsResponse\x12-\n" +
+	"\abaskets\x18\x01 \x03(\v2\x13.exchange.v1.BasketR\abaskets\"2\n" +
+	"\x13DeleteBasketRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\"\x16\n" +
+	"\x14DeleteBasketResponse\"\x85\x01\n" +
+	"\x05Index\x12+\n" +
+	"\x06basket\x18\x01 \x01(\v2\x13.exchange.v1.BasketR\x06basket\x12(\n" +
+	"\x05quote\x18\x02 \x01(\v2\x12.exchange.v1.QuoteR\x05quote\x12%\n" +
+	"\x0ereturn_percent\x18\x03 \x01(\x01R\rreturnPercent\"\xab\x01\n" +
+	"\x12CreateIndexRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12A\n" +
+	"\n" +
+	"components\x18\x03 \x03(\v2\x1c.exchange.v1.BasketComponentB\x03\xe0A\x02R\n" +
+	"components\x12\x1c\n" +
+	"\trebalance\x18\x04 \x01(\tR\trebalance\"B\n" +
+	"\x13CreateIndexResponse\x12+\n" +
+	"\x06basket\x18\x01 \x01(\v2\x13.exchange.v1.BasketR\x06basket\"B\n" +
+	"\x12ListIndicesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"C\n" +
+	"\x13ListIndicesResponse\x12,\n" +
+	"\aindices\x18\x01 \x03(\v2\x12.exchange.v1.IndexR\aindices\"D\n" +
+	"\x0fGetIndexRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x88\x01\n" +
+	"\x10GetIndexResponse\x12(\n" +
+	"\x05index\x18\x01 \x01(\v2\x12.exchange.v1.IndexR\x05index\x12\x1c\n" +
+	"\tfollowers\x18\x02 \x03(\tR\tfollowers\x12,\n" +
+	"\ahistory\x18\x03 \x03(\v2\x12.exchange.v1.QuoteR\ahistory\"1\n" +
+	"\x12FollowIndexRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\"\x15\n" +
+	"\x13FollowIndexResponse\"3\n" +
+	"\x14UnfollowIndexRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\"\x17\n" +
+	"\x15UnfollowIndexResponse\"\xe3\x02\n" +
+	"\x0eOptionContract\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x02 \x01(\tR\n" +
+	"underlying\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06strike\x18\x04 \x01(\x01R\x06strike\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x06 \x01(\x01R\n" +
+	"volatility\x12,\n" +
+	"\apremium\x18\a \x01(\v2\x12.exchange.v1.QuoteR\apremium\x12)\n" +
+	"\x10settlement_price\x18\b \x01(\x01R\x0fsettlementPrice\x129\n" +
+	"\n" +
+	"settled_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tsettledAt\"\xd8\x01\n" +
+	"\x1bCreateOptionContractRequest\x12#\n" +
+	"\n" +
+	"underlying\x18\x01 \x01(\tB\x03\xe0A\x02R\n" +
+	"underlying\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tB\x03\xe0A\x02R\x04type\x12\x1b\n" +
+	"\x06strike\x18\x03 \x01(\x01B\x03\xe0A\x02R\x06strike\x12>\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\texpiresAt\x12\x1e\n" +
+	"\n" +
+	"volatility\x18\x05 \x01(\x01R\n" +
+	"volatility\"W\n" +
+	"\x1cCreateOptionContractResponse\x127\n" +
+	"\bcontract\x18\x01 \x01(\v2\x1b.exchange.v1.OptionContractR\bcontract\"\x1c\n" +
+	"\x1aListOptionContractsRequest\"X\n" +
+	"\x1bListOptionContractsResponse\x129\n" +
+	"\tcontracts\x18\x01 \x03(\v2\x1b.exchange.v1.OptionContractR\tcontracts\"\xc5\x03\n" +
+	"\x10PredictionMarket\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bquestion\x18\x03 \x01(\tR\bquestion\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12;\n" +
+	"\vresolves_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvesAt\x12\x1c\n" +
+	"\tliquidity\x18\x06 \x01(\x01R\tliquidity\x12\x1b\n" +
+	"\tyes_price\x18\a \x01(\x01R\byesPrice\x12\x19\n" +
+	"\bno_price\x18\b \x01(\x01R\anoPrice\x12\x1d\n" +
+	"\n" +
+	"yes_shares\x18\t \x01(\x01R\tyesShares\x12\x1b\n" +
+	"\tno_shares\x18\n" +
+	" \x01(\x01R\bnoShares\x12\x18\n" +
+	"\aoutcome\x18\v \x01(\tR\aoutcome\x12)\n" +
+	"\x10resolution_price\x18\f \x01(\x01R\x0fresolutionPrice\x12;\n" +
+	"\vresolved_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"\x85\x01\n" +
+	"\x12PredictionPosition\x12\x1b\n" +
+	"\tmarket_id\x18\x01 \x01(\x03R\bmarketId\x12\x1d\n" +
+	"\n" +
+	"yes_shares\x18\x02 \x01(\x01R\tyesShares\x12\x1b\n" +
+	"\tno_shares\x18\x03 \x01(\x01R\bnoShares\x12\x16\n" +
+	"\x06staked\x18\x04 \x01(\x01R\x06staked\"\xdb\x01\n" +
+	"\x1dCreatePredictionMarketRequest\x12\x1b\n" +
+	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12!\n" +
+	"\tthreshold\x18\x02 \x01(\x01B\x03\xe0A\x02R\tthreshold\x12@\n" +
+	"\vresolves_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\n" +
+	"resolvesAt\x12\x1a\n" +
+	"\bquestion\x18\x04 \x01(\tR\bquestion\x12\x1c\n" +
+	"\tliquidity\x18\x05 \x01(\x01R\tliquidity\"W\n" +
+	"\x1eCreatePredictionMarketResponse\x125\n" +
+	"\x06market\x18\x01 \x01(\v2\x1d.exchange.v1.PredictionMarketR\x06market\"\x1e\n" +
+	"\x1cListPredictionMarketsRequest\"X\n" +
+	"\x1dListPredictionMarketsResponse\x127\n" +
+	"\amarkets\x18\x01 \x03(\v2\x1d.exchange.v1.PredictionMarketR\amarkets\"v\n" +
+	"\x16StakePredictionRequest\x12 \n" +
+	"\tmarket_id\x18\x01 \x01(\x03B\x03\xe0A\x02R\bmarketId\x12\x1d\n" +
+	"\aoutcome\x18\x02 \x01(\tB\x03\xe0A\x02R\aoutcome\x12\x1b\n" +
+	"\x06amount\x18\x03 \x01(\x01B\x03\xe0A\x02R\x06amount\"\x8d\x01\n" +
+	"\x17StakePredictionResponse\x125\n" +
+	"\x06market\x18\x01 \x01(\v2\x1d.exchange.v1.PredictionMarketR\x06market\x12;\n" +
+	"\bposition\x18\x02 \x01(\v2\x1f.exchange.v1.PredictionPositionR\bposition\" \n" +
+	"\x1eListPredictionPositionsRequest\"`\n" +
+	"\x1fListPredictionPositionsResponse\x12=\n" +
+	"\tpositions\x18\x01 \x03(\v2\x1f.exchange.v1.PredictionPositionR\tpositions*1\n" +
+	"\vTradeAction\x12\x0f\n" +
+	"\vUNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03BUY\x10\x01\x12\b\n" +
+	"\x04SELL\x10\x022\xa2\x1a\n" +
+	"\x0fExchangeService\x12}\n" +
+	"\bGetQuote\x12\x1c.exchange.v1.GetQuoteRequest\x1a\x1d.exchange.v1.GetQuoteResponse\"4\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/quotes/{symbol}\x12v\n" +
+	"\n" +
+	"GetHistory\x12\x1e.exchange.v1.GetHistoryRequest\x1a\x1f.exchange.v1.GetHistoryResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/v1/quotes/{symbol}/history\x12\x82\x01\n" +
+	"\rGetIndicators\x12!.exchange.v1.GetIndicatorsRequest\x1a\".exchange.v1.GetIndicatorsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/v1/quotes/{symbol}/indicators\x12\x7f\n" +
+	"\x11SearchInstruments\x12%.exchange.v1.SearchInstrumentsRequest\x1a&.exchange.v1.SearchInstrumentsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/instruments\x12\x89\x01\n" +
+	"\fStreamQuotes\x12 .exchange.v1.StreamQuotesRequest\x1a!.exchange.v1.StreamQuotesResponse\"2\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/quotes/events0\x01\x12\xa5\x01\n" +
+	"\x15ListQuarantinedQuotes\x12).exchange.v1.ListQuarantinedQuotesRequest\x1a*.exchange.v1.ListQuarantinedQuotesResponse\"5\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/admin/quarantine\x12\xb8\x01\n" +
+	"\x17ReleaseQuarantinedQuote\x12+.exchange.v1.ReleaseQuarantinedQuoteRequest\x1a,.exchange.v1.ReleaseQuarantinedQuoteResponse\"B\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02'\"%/api/v1/admin/quarantine/{id}/release\x12\xb0\x01\n" +
+	"\x17DiscardQuarantinedQuote\x12+.exchange.v1.DiscardQuarantinedQuoteRequest\x1a,.exchange.v1.DiscardQuarantinedQuoteResponse\":\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x1f*\x1d/api/v1/admin/quarantine/{id}\x12\x8a\x01\n" +
+	"\fCreateBasket\x12 .exchange.v1.CreateBasketRequest\x1a!.exchange.v1.CreateBasketResponse\"5\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/admin/baskets\x12\x84\x01\n" +
+	"\vListBaskets\x12\x1f.exchange.v1.ListBasketsRequest\x1a .exchange.v1.ListBasketsResponse\"2\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/admin/baskets\x12\x90\x01\n" +
+	"\fDeleteBasket\x12 .exchange.v1.DeleteBasketRequest\x1a!.exchange.v1.DeleteBasketResponse\";\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02 *\x1e/api/v1/admin/baskets/{symbol}\x12\x81\x01\n" +
+	"\vCreateIndex\x12\x1f.exchange.v1.CreateIndexRequest\x1a .exchange.v1.CreateIndexResponse\"/\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/indices\x12i\n" +
+	"\vListIndices\x12\x1f.exchange.v1.ListIndicesRequest\x1a .exchange.v1.ListIndicesResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/indices\x12i\n" +
+	"\bGetIndex\x12\x1c.exchange.v1.GetIndexRequest\x1a\x1d.exchange.v1.GetIndexResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/indices/{symbol}\x12\x91\x01\n" +
+	"\vFollowIndex\x12\x1f.exchange.v1.FollowIndexRequest\x1a .exchange.v1.FollowIndexResponse\"?\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02$\x1a\"/api/v1/indices/{symbol}/followers\x12\x97\x01\n" +
+	"\rUnfollowIndex\x12!.exchange.v1.UnfollowIndexRequest\x1a\".exchange.v1.UnfollowIndexResponse\"?\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02$*\"/api/v1/indices/{symbol}/followers\x12\xa2\x01\n" +
+	"\x14CreateOptionContract\x12(.exchange.v1.CreateOptionContractRequest\x1a).exchange.v1.CreateOptionContractResponse\"5\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/admin/options\x12\x81\x01\n" +
+	"\x13ListOptionContracts\x12'.exchange.v1.ListOptionContractsRequest\x1a(.exchange.v1.ListOptionContractsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/api/v1/options\x12\xac\x01\n" +
+	"\x16CreatePredictionMarket\x12*.exchange.v1.CreatePredictionMarketRequest\x1a+.exchange.v1.CreatePredictionMarketResponse\"9\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/admin/predictions\x12\x8b\x01\n" +
+	"\x15ListPredictionMarkets\x12).exchange.v1.ListPredictionMarketsRequest\x1a*.exchange.v1.ListPredictionMarketsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/predictions\x12\xa4\x01\n" +
+	"\x0fStakePrediction\x12#.exchange.v1.StakePredictionRequest\x1a$.exchange.v1.StakePredictionResponse\"F\x92A\x12b\x10\n" +
+	"\x0e\n" +
+	"\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02+:\x01*\"&/api/v1/predictions/{market_id}/stakes\x12\xb0\x01\n" +
+	"\x17ListPredictionPositions\x12+.exchange.v1.ListPredictionPositionsRequest\x1a,.exchange.v1.ListPredictionPositionsResponse\":\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
-	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/quotes/events0\x01\x12\x80\x01\n" +
+	"CookieAuth\x12\x00\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/predictions/positions\x12\x80\x01\n" +
 	"\vCreateTrade\x12\x1f.exchange.v1.CreateTradeRequest\x1a .exchange.v1.CreateTradeResponse\".\x92A\x12b\x10\n" +
 	"\x0e\n" +
 	"\n" +
@@ -640,41 +3791,162 @@ func file_exchange_v1_exchange_proto_rawDescGZIP() []byte {
 }
 
 var file_exchange_v1_exchange_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_exchange_v1_exchange_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_exchange_v1_exchange_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_exchange_v1_exchange_proto_goTypes = []any{
-	(TradeAction)(0),              // 0: exchange.v1.TradeAction
-	(*Quote)(nil),                 // 1: exchange.v1.Quote
-	(*GetQuoteRequest)(nil),       // 2: exchange.v1.GetQuoteRequest
-	(*GetQuoteResponse)(nil),      // 3: exchange.v1.GetQuoteResponse
-	(*GetHistoryRequest)(nil),     // 4: exchange.v1.GetHistoryRequest
-	(*GetHistoryResponse)(nil),    // 5: exchange.v1.GetHistoryResponse
-	(*StreamQuotesRequest)(nil),   // 6: exchange.v1.StreamQuotesRequest
-	(*StreamQuotesResponse)(nil),  // 7: exchange.v1.StreamQuotesResponse
-	(*CreateTradeRequest)(nil),    // 8: exchange.v1.CreateTradeRequest
-	(*CreateTradeResponse)(nil),   // 9: exchange.v1.CreateTradeResponse
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
-	(*v1.LadderParticipant)(nil),  // 11: ladder.v1.LadderParticipant
+	(TradeAction)(0),                        // 0: exchange.v1.TradeAction
+	(*Quote)(nil),                           // 1: exchange.v1.Quote
+	(*GetQuoteRequest)(nil),                 // 2: exchange.v1.GetQuoteRequest
+	(*GetQuoteResponse)(nil),                // 3: exchange.v1.GetQuoteResponse
+	(*GetHistoryRequest)(nil),               // 4: exchange.v1.GetHistoryRequest
+	(*GetHistoryResponse)(nil),              // 5: exchange.v1.GetHistoryResponse
+	(*GetIndicatorsRequest)(nil),            // 6: exchange.v1.GetIndicatorsRequest
+	(*GetIndicatorsResponse)(nil),           // 7: exchange.v1.GetIndicatorsResponse
+	(*Indicator)(nil),                       // 8: exchange.v1.Indicator
+	(*IndicatorPoint)(nil),                  // 9: exchange.v1.IndicatorPoint
+	(*Instrument)(nil),                      // 10: exchange.v1.Instrument
+	(*SearchInstrumentsRequest)(nil),        // 11: exchange.v1.SearchInstrumentsRequest
+	(*SearchInstrumentsResponse)(nil),       // 12: exchange.v1.SearchInstrumentsResponse
+	(*StreamQuotesRequest)(nil),             // 13: exchange.v1.StreamQuotesRequest
+	(*StreamQuotesResponse)(nil),            // 14: exchange.v1.StreamQuotesResponse
+	(*CreateTradeRequest)(nil),              // 15: exchange.v1.CreateTradeRequest
+	(*CreateTradeResponse)(nil),             // 16: exchange.v1.CreateTradeResponse
+	(*QuarantinedQuote)(nil),                // 17: exchange.v1.QuarantinedQuote
+	(*ListQuarantinedQuotesRequest)(nil),    // 18: exchange.v1.ListQuarantinedQuotesRequest
+	(*ListQuarantinedQuotesResponse)(nil),   // 19: exchange.v1.ListQuarantinedQuotesResponse
+	(*ReleaseQuarantinedQuoteRequest)(nil),  // 20: exchange.v1.ReleaseQuarantinedQuoteRequest
+	(*ReleaseQuarantinedQuoteResponse)(nil), // 21: exchange.v1.ReleaseQuarantinedQuoteResponse
+	(*DiscardQuarantinedQuoteRequest)(nil),  // 22: exchange.v1.DiscardQuarantinedQuoteRequest
+	(*DiscardQuarantinedQuoteResponse)(nil), // 23: exchange.v1.DiscardQuarantinedQuoteResponse
+	(*BasketComponent)(nil),                 // 24: exchange.v1.BasketComponent
+	(*Basket)(nil),                          // 25: exchange.v1.Basket
+	(*CreateBasketRequest)(nil),             // 26: exchange.v1.CreateBasketRequest
+	(*CreateBasketResponse)(nil),            // 27: exchange.v1.CreateBasketResponse
+	(*ListBasketsRequest)(nil),              // 28: exchange.v1.ListBasketsRequest
+	(*ListBasketsResponse)(nil),             // 29: exchange.v1.ListBasketsResponse
+	(*DeleteBasketRequest)(nil),             // 30: exchange.v1.DeleteBasketRequest
+	(*DeleteBasketResponse)(nil),            // 31: exchange.v1.DeleteBasketResponse
+	(*Index)(nil),                           // 32: exchange.v1.Index
+	(*CreateIndexRequest)(nil),              // 33: exchange.v1.CreateIndexRequest
+	(*CreateIndexResponse)(nil),             // 34: exchange.v1.CreateIndexResponse
+	(*ListIndicesRequest)(nil),              // 35: exchange.v1.ListIndicesRequest
+	(*ListIndicesResponse)(nil),             // 36: exchange.v1.ListIndicesResponse
+	(*GetIndexRequest)(nil),                 // 37: exchange.v1.GetIndexRequest
+	(*GetIndexResponse)(nil),                // 38: exchange.v1.GetIndexResponse
+	(*FollowIndexRequest)(nil),              // 39: exchange.v1.FollowIndexRequest
+	(*FollowIndexResponse)(nil),             // 40: exchange.v1.FollowIndexResponse
+	(*UnfollowIndexRequest)(nil),            // 41: exchange.v1.UnfollowIndexRequest
+	(*UnfollowIndexResponse)(nil),           // 42: exchange.v1.UnfollowIndexResponse
+	(*OptionContract)(nil),                  // 43: exchange.v1.OptionContract
+	(*CreateOptionContractRequest)(nil),     // 44: exchange.v1.CreateOptionContractRequest
+	(*CreateOptionContractResponse)(nil),    // 45: exchange.v1.CreateOptionContractResponse
+	(*ListOptionContractsRequest)(nil),      // 46: exchange.v1.ListOptionContractsRequest
+	(*ListOptionContractsResponse)(nil),     // 47: exchange.v1.ListOptionContractsResponse
+	(*PredictionMarket)(nil),                // 48: exchange.v1.PredictionMarket
+	(*PredictionPosition)(nil),              // 49: exchange.v1.PredictionPosition
+	(*CreatePredictionMarketRequest)(nil),   // 50: exchange.v1.CreatePredictionMarketRequest
+	(*CreatePredictionMarketResponse)(nil),  // 51: exchange.v1.CreatePredictionMarketResponse
+	(*ListPredictionMarketsRequest)(nil),    // 52: exchange.v1.ListPredictionMarketsRequest
+	(*ListPredictionMarketsResponse)(nil),   // 53: exchange.v1.ListPredictionMarketsResponse
+	(*StakePredictionRequest)(nil),          // 54: exchange.v1.StakePredictionRequest
+	(*StakePredictionResponse)(nil),         // 55: exchange.v1.StakePredictionResponse
+	(*ListPredictionPositionsRequest)(nil),  // 56: exchange.v1.ListPredictionPositionsRequest
+	(*ListPredictionPositionsResponse)(nil), // 57: exchange.v1.ListPredictionPositionsResponse
+	(*timestamppb.Timestamp)(nil),           // 58: google.protobuf.Timestamp
+	(*v1.LadderParticipant)(nil),            // 59: ladder.v1.LadderParticipant
 }
 var file_exchange_v1_exchange_proto_depIdxs = []int32{
-	10, // 0: exchange.v1.Quote.timestamp:type_name -> google.protobuf.Timestamp
+	58, // 0: exchange.v1.Quote.timestamp:type_name -> google.protobuf.Timestamp
 	1,  // 1: exchange.v1.GetQuoteResponse.quote:type_name -> exchange.v1.Quote
 	1,  // 2: exchange.v1.GetHistoryResponse.history:type_name -> exchange.v1.Quote
-	1,  // 3: exchange.v1.StreamQuotesResponse.quote:type_name -> exchange.v1.Quote
-	0,  // 4: exchange.v1.CreateTradeRequest.action:type_name -> exchange.v1.TradeAction
-	11, // 5: exchange.v1.CreateTradeResponse.participant:type_name -> ladder.v1.LadderParticipant
-	2,  // 6: exchange.v1.ExchangeService.GetQuote:input_type -> exchange.v1.GetQuoteRequest
-	4,  // 7: exchange.v1.ExchangeService.GetHistory:input_type -> exchange.v1.GetHistoryRequest
-	6,  // 8: exchange.v1.ExchangeService.StreamQuotes:input_type -> exchange.v1.StreamQuotesRequest
-	8,  // 9: exchange.v1.ExchangeService.CreateTrade:input_type -> exchange.v1.CreateTradeRequest
-	3,  // 10: exchange.v1.ExchangeService.GetQuote:output_type -> exchange.v1.GetQuoteResponse
-	5,  // 11: exchange.v1.ExchangeService.GetHistory:output_type -> exchange.v1.GetHistoryResponse
-	7,  // 12: exchange.v1.ExchangeService.StreamQuotes:output_type -> exchange.v1.StreamQuotesResponse
-	9,  // 13: exchange.v1.ExchangeService.CreateTrade:output_type -> exchange.v1.CreateTradeResponse
-	10, // [10:14] is the sub-list for method output_type
-	6,  // [6:10] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	8,  // 3: exchange.v1.GetIndicatorsResponse.indicators:type_name -> exchange.v1.Indicator
+	9,  // 4: exchange.v1.Indicator.points:type_name -> exchange.v1.IndicatorPoint
+	58, // 5: exchange.v1.IndicatorPoint.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 6: exchange.v1.SearchInstrumentsResponse.instruments:type_name -> exchange.v1.Instrument
+	1,  // 7: exchange.v1.StreamQuotesResponse.quote:type_name -> exchange.v1.Quote
+	0,  // 8: exchange.v1.CreateTradeRequest.action:type_name -> exchange.v1.TradeAction
+	59, // 9: exchange.v1.CreateTradeResponse.participant:type_name -> ladder.v1.LadderParticipant
+	1,  // 10: exchange.v1.QuarantinedQuote.quote:type_name -> exchange.v1.Quote
+	58, // 11: exchange.v1.QuarantinedQuote.quarantined_at:type_name -> google.protobuf.Timestamp
+	17, // 12: exchange.v1.ListQuarantinedQuotesResponse.quotes:type_name -> exchange.v1.QuarantinedQuote
+	1,  // 13: exchange.v1.ReleaseQuarantinedQuoteResponse.quote:type_name -> exchange.v1.Quote
+	24, // 14: exchange.v1.Basket.components:type_name -> exchange.v1.BasketComponent
+	58, // 15: exchange.v1.Basket.created_at:type_name -> google.protobuf.Timestamp
+	58, // 16: exchange.v1.Basket.rebalanced_at:type_name -> google.protobuf.Timestamp
+	24, // 17: exchange.v1.CreateBasketRequest.components:type_name -> exchange.v1.BasketComponent
+	25, // 18: exchange.v1.CreateBasketResponse.basket:type_name -> exchange.v1.Basket
+	25, // 19: exchange.v1.ListBasketsResponse.baskets:type_name -> exchange.v1.Basket
+	25, // 20: exchange.v1.Index.basket:type_name -> exchange.v1.Basket
+	1,  // 21: exchange.v1.Index.quote:type_name -> exchange.v1.Quote
+	24, // 22: exchange.v1.CreateIndexRequest.components:type_name -> exchange.v1.BasketComponent
+	25, // 23: exchange.v1.CreateIndexResponse.basket:type_name -> exchange.v1.Basket
+	32, // 24: exchange.v1.ListIndicesResponse.indices:type_name -> exchange.v1.Index
+	32, // 25: exchange.v1.GetIndexResponse.index:type_name -> exchange.v1.Index
+	1,  // 26: exchange.v1.GetIndexResponse.history:type_name -> exchange.v1.Quote
+	58, // 27: exchange.v1.OptionContract.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 28: exchange.v1.OptionContract.premium:type_name -> exchange.v1.Quote
+	58, // 29: exchange.v1.OptionContract.settled_at:type_name -> google.protobuf.Timestamp
+	58, // 30: exchange.v1.CreateOptionContractRequest.expires_at:type_name -> google.protobuf.Timestamp
+	43, // 31: exchange.v1.CreateOptionContractResponse.contract:type_name -> exchange.v1.OptionContract
+	43, // 32: exchange.v1.ListOptionContractsResponse.contracts:type_name -> exchange.v1.OptionContract
+	58, // 33: exchange.v1.PredictionMarket.resolves_at:type_name -> google.protobuf.Timestamp
+	58, // 34: exchange.v1.PredictionMarket.resolved_at:type_name -> google.protobuf.Timestamp
+	58, // 35: exchange.v1.CreatePredictionMarketRequest.resolves_at:type_name -> google.protobuf.Timestamp
+	48, // 36: exchange.v1.CreatePredictionMarketResponse.market:type_name -> exchange.v1.PredictionMarket
+	48, // 37: exchange.v1.ListPredictionMarketsResponse.markets:type_name -> exchange.v1.PredictionMarket
+	48, // 38: exchange.v1.StakePredictionResponse.market:type_name -> exchange.v1.PredictionMarket
+	49, // 39: exchange.v1.StakePredictionResponse.position:type_name -> exchange.v1.PredictionPosition
+	49, // 40: exchange.v1.ListPredictionPositionsResponse.positions:type_name -> exchange.v1.PredictionPosition
+	2,  // 41: exchange.v1.ExchangeService.GetQuote:input_type -> exchange.v1.GetQuoteRequest
+	4,  // 42: exchange.v1.ExchangeService.GetHistory:input_type -> exchange.v1.GetHistoryRequest
+	6,  // 43: exchange.v1.ExchangeService.GetIndicators:input_type -> exchange.v1.GetIndicatorsRequest
+	11, // 44: exchange.v1.ExchangeService.SearchInstruments:input_type -> exchange.v1.SearchInstrumentsRequest
+	13, // 45: exchange.v1.ExchangeService.StreamQuotes:input_type -> exchange.v1.StreamQuotesRequest
+	18, // 46: exchange.v1.ExchangeService.ListQuarantinedQuotes:input_type -> exchange.v1.ListQuarantinedQuotesRequest
+	20, // 47: exchange.v1.ExchangeService.ReleaseQuarantinedQuote:input_type -> exchange.v1.ReleaseQuarantinedQuoteRequest
+	22, // 48: exchange.v1.ExchangeService.DiscardQuarantinedQuote:input_type -> exchange.v1.DiscardQuarantinedQuoteRequest
+	26, // 49: exchange.v1.ExchangeService.CreateBasket:input_type -> exchange.v1.CreateBasketRequest
+	28, // 50: exchange.v1.ExchangeService.ListBaskets:input_type -> exchange.v1.ListBasketsRequest
+	30, // 51: exchange.v1.ExchangeService.DeleteBasket:input_type -> exchange.v1.DeleteBasketRequest
+	33, // 52: exchange.v1.ExchangeService.CreateIndex:input_type -> exchange.v1.CreateIndexRequest
+	35, // 53: exchange.v1.ExchangeService.ListIndices:input_type -> exchange.v1.ListIndicesRequest
+	37, // 54: exchange.v1.ExchangeService.GetIndex:input_type -> exchange.v1.GetIndexRequest
+	39, // 55: exchange.v1.ExchangeService.FollowIndex:input_type -> exchange.v1.FollowIndexRequest
+	41, // 56: exchange.v1.ExchangeService.UnfollowIndex:input_type -> exchange.v1.UnfollowIndexRequest
+	44, // 57: exchange.v1.ExchangeService.CreateOptionContract:input_type -> exchange.v1.CreateOptionContractRequest
+	46, // 58: exchange.v1.ExchangeService.ListOptionContracts:input_type -> exchange.v1.ListOptionContractsRequest
+	50, // 59: exchange.v1.ExchangeService.CreatePredictionMarket:input_type -> exchange.v1.CreatePredictionMarketRequest
+	52, // 60: exchange.v1.ExchangeService.ListPredictionMarkets:input_type -> exchange.v1.ListPredictionMarketsRequest
+	54, // 61: exchange.v1.ExchangeService.StakePrediction:input_type -> exchange.v1.StakePredictionRequest
+	56, // 62: exchange.v1.ExchangeService.ListPredictionPositions:input_type -> exchange.v1.ListPredictionPositionsRequest
+	15, // 63: exchange.v1.ExchangeService.CreateTrade:input_type -> exchange.v1.CreateTradeRequest
+	3,  // 64: exchange.v1.ExchangeService.GetQuote:output_type -> exchange.v1.GetQuoteResponse
+	5,  // 65: exchange.v1.ExchangeService.GetHistory:output_type -> exchange.v1.GetHistoryResponse
+	7,  // 66: exchange.v1.ExchangeService.GetIndicators:output_type -> exchange.v1.GetIndicatorsResponse
+	12, // 67: exchange.v1.ExchangeService.SearchInstruments:output_type -> exchange.v1.SearchInstrumentsResponse
+	14, // 68: exchange.v1.ExchangeService.StreamQuotes:output_type -> exchange.v1.StreamQuotesResponse
+	19, // 69: exchange.v1.ExchangeService.ListQuarantinedQuotes:output_type -> exchange.v1.ListQuarantinedQuotesResponse
+	21, // 70: exchange.v1.ExchangeService.ReleaseQuarantinedQuote:output_type -> exchange.v1.ReleaseQuarantinedQuoteResponse
+	23, // 71: exchange.v1.ExchangeService.DiscardQuarantinedQuote:output_type -> exchange.v1.DiscardQuarantinedQuoteResponse
+	27, // 72: exchange.v1.ExchangeService.CreateBasket:output_type -> exchange.v1.CreateBasketResponse
+	29, // 73: exchange.v1.ExchangeService.ListBaskets:output_type -> exchange.v1.ListBasketsResponse
+	31, // 74: exchange.v1.ExchangeService.DeleteBasket:output_type -> exchange.v1.DeleteBasketResponse
+	34, // 75: exchange.v1.ExchangeService.CreateIndex:output_type -> exchange.v1.CreateIndexResponse
+	36, // 76: exchange.v1.ExchangeService.ListIndices:output_type -> exchange.v1.ListIndicesResponse
+	38, // 77: exchange.v1.ExchangeService.GetIndex:output_type -> exchange.v1.GetIndexResponse
+	40, // 78: exchange.v1.ExchangeService.FollowIndex:output_type -> exchange.v1.FollowIndexResponse
+	42, // 79: exchange.v1.ExchangeService.UnfollowIndex:output_type -> exchange.v1.UnfollowIndexResponse
+	45, // 80: exchange.v1.ExchangeService.CreateOptionContract:output_type -> exchange.v1.CreateOptionContractResponse
+	47, // 81: exchange.v1.ExchangeService.ListOptionContracts:output_type -> exchange.v1.ListOptionContractsResponse
+	51, // 82: exchange.v1.ExchangeService.CreatePredictionMarket:output_type -> exchange.v1.CreatePredictionMarketResponse
+	53, // 83: exchange.v1.ExchangeService.ListPredictionMarkets:output_type -> exchange.v1.ListPredictionMarketsResponse
+	55, // 84: exchange.v1.ExchangeService.StakePrediction:output_type -> exchange.v1.StakePredictionResponse
+	57, // 85: exchange.v1.ExchangeService.ListPredictionPositions:output_type -> exchange.v1.ListPredictionPositionsResponse
+	16, // 86: exchange.v1.ExchangeService.CreateTrade:output_type -> exchange.v1.CreateTradeResponse
+	64, // [64:87] is the sub-list for method output_type
+	41, // [41:64] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_exchange_v1_exchange_proto_init() }
@@ -688,7 +3960,7 @@ func file_exchange_v1_exchange_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_exchange_v1_exchange_proto_rawDesc), len(file_exchange_v1_exchange_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExchangeService_GetQuote_FullMethodName     = "/exchange.v1.ExchangeService/GetQuote"
-	ExchangeService_GetHistory_FullMethodName   = "/exchange.v1.ExchangeService/GetHistory"
-	ExchangeService_StreamQuotes_FullMethodName = "/exchange.v1.ExchangeService/StreamQuotes"
-	ExchangeService_CreateTrade_FullMethodName  = "/exchange.v1.ExchangeService/CreateTrade"
+	ExchangeService_GetQuote_FullMethodName                = "/exchange.v1.ExchangeService/GetQuote"
+	ExchangeService_GetHistory_FullMethodName              = "/exchange.v1.ExchangeService/GetHistory"
+	ExchangeService_GetIndicators_FullMethodName           = "/exchange.v1.ExchangeService/GetIndicators"
+	ExchangeService_SearchInstruments_FullMethodName       = "/exchange.v1.ExchangeService/SearchInstruments"
+	ExchangeService_StreamQuotes_FullMethodName            = "/exchange.v1.ExchangeService/StreamQuotes"
+	ExchangeService_ListQuarantinedQuotes_FullMethodName   = "/exchange.v1.ExchangeService/ListQuarantinedQuotes"
+	ExchangeService_ReleaseQuarantinedQuote_FullMethodName = "/exchange.v1.ExchangeService/ReleaseQuarantinedQuote"
+	ExchangeService_DiscardQuarantinedQuote_FullMethodName = "/exchange.v1.ExchangeService/DiscardQuarantinedQuote"
+	ExchangeService_CreateBasket_FullMethodName            = "/exchange.v1.ExchangeService/CreateBasket"
+	ExchangeService_ListBaskets_FullMethodName             = "/exchange.v1.ExchangeService/ListBaskets"
+	ExchangeService_DeleteBasket_FullMethodName            = "/exchange.v1.ExchangeService/DeleteBasket"
+	ExchangeService_CreateIndex_FullMethodName             = "/exchange.v1.ExchangeService/CreateIndex"
+	ExchangeService_ListIndices_FullMethodName             = "/exchange.v1.ExchangeService/ListIndices"
+	ExchangeService_GetIndex_FullMethodName                = "/exchange.v1.ExchangeService/GetIndex"
+	ExchangeService_FollowIndex_FullMethodName             = "/exchange.v1.ExchangeService/FollowIndex"
+	ExchangeService_UnfollowIndex_FullMethodName           = "/exchange.v1.ExchangeService/UnfollowIndex"
+	ExchangeService_CreateOptionContract_FullMethodName    = "/exchange.v1.ExchangeService/CreateOptionContract"
+	ExchangeService_ListOptionContracts_FullMethodName     = "/exchange.v1.ExchangeService/ListOptionContracts"
+	ExchangeService_CreatePredictionMarket_FullMethodName  = "/exchange.v1.ExchangeService/CreatePredictionMarket"
+	ExchangeService_ListPredictionMarkets_FullMethodName   = "/exchange.v1.ExchangeService/ListPredictionMarkets"
+	ExchangeService_StakePrediction_FullMethodName         = "/exchange.v1.ExchangeService/StakePrediction"
+	ExchangeService_ListPredictionPositions_FullMethodName = "/exchange.v1.ExchangeService/ListPredictionPositions"
+	ExchangeService_CreateTrade_FullMethodName             = "/exchange.v1.ExchangeService/CreateTrade"
 )
 
 // ExchangeServiceClient is the client API for ExchangeService service.
@@ -35,8 +54,47 @@ type ExchangeServiceClient interface {
 	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
 	// Returns historical quote candles for a stock.
 	GetHistory(ctx context.Context, in *GetHistoryRequest, opts ...grpc.CallOption) (*GetHistoryResponse, error)
+	// Computes technical indicators over a stock's stored quotes.
+	GetIndicators(ctx context.Context, in *GetIndicatorsRequest, opts ...grpc.CallOption) (*GetIndicatorsResponse, error)
+	// Searches the instrument catalogue by symbol or name prefix, falling back to fuzzy matches.
+	SearchInstruments(ctx context.Context, in *SearchInstrumentsRequest, opts ...grpc.CallOption) (*SearchInstrumentsResponse, error)
 	// Opens a Server-Sent Events (SSE) stream for real-time stock quote updates.
 	StreamQuotes(ctx context.Context, in *StreamQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamQuotesResponse], error)
+	// Lists quotes the fetcher held back as suspicious. Admin only.
+	ListQuarantinedQuotes(ctx context.Context, in *ListQuarantinedQuotesRequest, opts ...grpc.CallOption) (*ListQuarantinedQuotesResponse, error)
+	// Publishes a quarantined quote as if it had passed validation. Admin only.
+	ReleaseQuarantinedQuote(ctx context.Context, in *ReleaseQuarantinedQuoteRequest, opts ...grpc.CallOption) (*ReleaseQuarantinedQuoteResponse, error)
+	// Drops a quarantined quote. Admin only.
+	DiscardQuarantinedQuote(ctx context.Context, in *DiscardQuarantinedQuoteRequest, opts ...grpc.CallOption) (*DiscardQuarantinedQuoteResponse, error)
+	// Defines a basket instrument priced from its components. Admin only.
+	CreateBasket(ctx context.Context, in *CreateBasketRequest, opts ...grpc.CallOption) (*CreateBasketResponse, error)
+	// Lists basket instruments with their components. Admin only.
+	ListBaskets(ctx context.Context, in *ListBasketsRequest, opts ...grpc.CallOption) (*ListBasketsResponse, error)
+	// Deletes a basket that no ladder lists. Admin only.
+	DeleteBasket(ctx context.Context, in *DeleteBasketRequest, opts ...grpc.CallOption) (*DeleteBasketResponse, error)
+	// Publishes a custom index of the active ladder's instruments.
+	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error)
+	// Lists a page of the custom indices players have published, with their performance.
+	ListIndices(ctx context.Context, in *ListIndicesRequest, opts ...grpc.CallOption) (*ListIndicesResponse, error)
+	// Retrieves the public page of a custom index.
+	GetIndex(ctx context.Context, in *GetIndexRequest, opts ...grpc.CallOption) (*GetIndexResponse, error)
+	// Follows a custom index.
+	FollowIndex(ctx context.Context, in *FollowIndexRequest, opts ...grpc.CallOption) (*FollowIndexResponse, error)
+	// Stops following a custom index.
+	UnfollowIndex(ctx context.Context, in *UnfollowIndexRequest, opts ...grpc.CallOption) (*UnfollowIndexResponse, error)
+	// Lists an option contract on an underlying of the active ladder. Admin only.
+	CreateOptionContract(ctx context.Context, in *CreateOptionContractRequest, opts ...grpc.CallOption) (*CreateOptionContractResponse, error)
+	// Lists the option contracts of the active ladder with their premiums. Options are traded
+	// with CreateTrade: buying opens a position and selling closes it.
+	ListOptionContracts(ctx context.Context, in *ListOptionContractsRequest, opts ...grpc.CallOption) (*ListOptionContractsResponse, error)
+	// Opens a prediction market on a ticker of the active ladder. Admin only.
+	CreatePredictionMarket(ctx context.Context, in *CreatePredictionMarketRequest, opts ...grpc.CallOption) (*CreatePredictionMarketResponse, error)
+	// Lists the prediction markets of the active ladder with their current prices.
+	ListPredictionMarkets(ctx context.Context, in *ListPredictionMarketsRequest, opts ...grpc.CallOption) (*ListPredictionMarketsResponse, error)
+	// Stakes cash from the user's ladder balance on an outcome of a prediction market.
+	StakePrediction(ctx context.Context, in *StakePredictionRequest, opts ...grpc.CallOption) (*StakePredictionResponse, error)
+	// Lists the user's positions in the prediction markets of the active ladder.
+	ListPredictionPositions(ctx context.Context, in *ListPredictionPositionsRequest, opts ...grpc.CallOption) (*ListPredictionPositionsResponse, error)
 	// Places a trade (Buy/Sell) for a stock.
 	CreateTrade(ctx context.Context, in *CreateTradeRequest, opts ...grpc.CallOption) (*CreateTradeResponse, error)
 }
@@ -69,6 +127,26 @@ func (c *exchangeServiceClient) GetHistory(ctx context.Context, in *GetHistoryRe
 	return out, nil
 }
 
+func (c *exchangeServiceClient) GetIndicators(ctx context.Context, in *GetIndicatorsRequest, opts ...grpc.CallOption) (*GetIndicatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIndicatorsResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_GetIndicators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) SearchInstruments(ctx context.Context, in *SearchInstrumentsRequest, opts ...grpc.CallOption) (*SearchInstrumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchInstrumentsResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_SearchInstruments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *exchangeServiceClient) StreamQuotes(ctx context.Context, in *StreamQuotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamQuotesResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &ExchangeService_ServiceDesc.Streams[0], ExchangeService_StreamQuotes_FullMethodName, cOpts...)
@@ -88,6 +166,176 @@ func (c *exchangeServiceClient) StreamQuotes(ctx context.Context, in *StreamQuot
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ExchangeService_StreamQuotesClient = grpc.ServerStreamingClient[StreamQuotesResponse]
 
+func (c *exchangeServiceClient) ListQuarantinedQuotes(ctx context.Context, in *ListQuarantinedQuotesRequest, opts ...grpc.CallOption) (*ListQuarantinedQuotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuarantinedQuotesResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_ListQuarantinedQuotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) ReleaseQuarantinedQuote(ctx context.Context, in *ReleaseQuarantinedQuoteRequest, opts ...grpc.CallOption) (*ReleaseQuarantinedQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseQuarantinedQuoteResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_ReleaseQuarantinedQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) DiscardQuarantinedQuote(ctx context.Context, in *DiscardQuarantinedQuoteRequest, opts ...grpc.CallOption) (*DiscardQuarantinedQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscardQuarantinedQuoteResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_DiscardQuarantinedQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) CreateBasket(ctx context.Context, in *CreateBasketRequest, opts ...grpc.CallOption) (*CreateBasketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBasketResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_CreateBasket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) ListBaskets(ctx context.Context, in *ListBasketsRequest, opts ...grpc.CallOption) (*ListBasketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBasketsResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_ListBaskets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) DeleteBasket(ctx context.Context, in *DeleteBasketRequest, opts ...grpc.CallOption) (*DeleteBasketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBasketResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_DeleteBasket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*CreateIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateIndexResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_CreateIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) ListIndices(ctx context.Context, in *ListIndicesRequest, opts ...grpc.CallOption) (*ListIndicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIndicesResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_ListIndices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) GetIndex(ctx context.Context, in *GetIndexRequest, opts ...grpc.CallOption) (*GetIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIndexResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_GetIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) FollowIndex(ctx context.Context, in *FollowIndexRequest, opts ...grpc.CallOption) (*FollowIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FollowIndexResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_FollowIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) UnfollowIndex(ctx context.Context, in *UnfollowIndexRequest, opts ...grpc.CallOption) (*UnfollowIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfollowIndexResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_UnfollowIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) CreateOptionContract(ctx context.Context, in *CreateOptionContractRequest, opts ...grpc.CallOption) (*CreateOptionContractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOptionContractResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_CreateOptionContract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) ListOptionContracts(ctx context.Context, in *ListOptionContractsRequest, opts ...grpc.CallOption) (*ListOptionContractsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOptionContractsResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_ListOptionContracts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) CreatePredictionMarket(ctx context.Context, in *CreatePredictionMarketRequest, opts ...grpc.CallOption) (*CreatePredictionMarketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePredictionMarketResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_CreatePredictionMarket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) ListPredictionMarkets(ctx context.Context, in *ListPredictionMarketsRequest, opts ...grpc.CallOption) (*ListPredictionMarketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPredictionMarketsResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_ListPredictionMarkets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) StakePrediction(ctx context.Context, in *StakePredictionRequest, opts ...grpc.CallOption) (*StakePredictionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StakePredictionResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_StakePrediction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeServiceClient) ListPredictionPositions(ctx context.Context, in *ListPredictionPositionsRequest, opts ...grpc.CallOption) (*ListPredictionPositionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPredictionPositionsResponse)
+	err := c.cc.Invoke(ctx, ExchangeService_ListPredictionPositions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *exchangeServiceClient) CreateTrade(ctx context.Context, in *CreateTradeRequest, opts ...grpc.CallOption) (*CreateTradeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTradeResponse)
@@ -108,8 +356,47 @@ type ExchangeServiceServer interface {
 	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
 	// Returns historical quote candles for a stock.
 	GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error)
+	// Computes technical indicators over a stock's stored quotes.
+	GetIndicators(context.Context, *GetIndicatorsRequest) (*GetIndicatorsResponse, error)
+	// Searches the instrument catalogue by symbol or name prefix, falling back to fuzzy matches.
+	SearchInstruments(context.Context, *SearchInstrumentsRequest) (*SearchInstrumentsResponse, error)
 	// Opens a Server-Sent Events (SSE) stream for real-time stock quote updates.
 	StreamQuotes(*StreamQuotesRequest, grpc.ServerStreamingServer[StreamQuotesResponse]) error
+	// Lists quotes the fetcher held back as suspicious. Admin only.
+	ListQuarantinedQuotes(context.Context, *ListQuarantinedQuotesRequest) (*ListQuarantinedQuotesResponse, error)
+	// Publishes a quarantined quote as if it had passed validation. Admin only.
+	ReleaseQuarantinedQuote(context.Context, *ReleaseQuarantinedQuoteRequest) (*ReleaseQuarantinedQuoteResponse, error)
+	// Drops a quarantined quote. Admin only.
+	DiscardQuarantinedQuote(context.Context, *DiscardQuarantinedQuoteRequest) (*DiscardQuarantinedQuoteResponse, error)
+	// Defines a basket instrument priced from its components. Admin only.
+	CreateBasket(context.Context, *CreateBasketRequest) (*CreateBasketResponse, error)
+	// Lists basket instruments with their components. Admin only.
+	ListBaskets(context.Context, *ListBasketsRequest) (*ListBasketsResponse, error)
+	// Deletes a basket that no ladder lists. Admin only.
+	DeleteBasket(context.Context, *DeleteBasketRequest) (*DeleteBasketResponse, error)
+	// Publishes a custom index of the active ladder's instruments.
+	CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error)
+	// Lists a page of the custom indices players have published, with their performance.
+	ListIndices(context.Context, *ListIndicesRequest) (*ListIndicesResponse, error)
+	// Retrieves the public page of a custom index.
+	GetIndex(context.Context, *GetIndexRequest) (*GetIndexResponse, error)
+	// Follows a custom index.
+	FollowIndex(context.Context, *FollowIndexRequest) (*FollowIndexResponse, error)
+	// Stops following a custom index.
+	UnfollowIndex(context.Context, *UnfollowIndexRequest) (*UnfollowIndexResponse, error)
+	// Lists an option contract on an underlying of the active ladder. Admin only.
+	CreateOptionContract(context.Context, *CreateOptionContractRequest) (*CreateOptionContractResponse, error)
+	// Lists the option contracts of the active ladder with their premiums. Options are traded
+	// with CreateTrade: buying opens a position and selling closes it.
+	ListOptionContracts(context.Context, *ListOptionContractsRequest) (*ListOptionContractsResponse, error)
+	// Opens a prediction market on a ticker of the active ladder. Admin only.
+	CreatePredictionMarket(context.Context, *CreatePredictionMarketRequest) (*CreatePredictionMarketResponse, error)
+	// Lists the prediction markets of the active ladder with their current prices.
+	ListPredictionMarkets(context.Context, *ListPredictionMarketsRequest) (*ListPredictionMarketsResponse, error)
+	// Stakes cash from the user's ladder balance on an outcome of a prediction market.
+	StakePrediction(context.Context, *StakePredictionRequest) (*StakePredictionResponse, error)
+	// Lists the user's positions in the prediction markets of the active ladder.
+	ListPredictionPositions(context.Context, *ListPredictionPositionsRequest) (*ListPredictionPositionsResponse, error)
 	// Places a trade (Buy/Sell) for a stock.
 	CreateTrade(context.Context, *CreateTradeRequest) (*CreateTradeResponse, error)
 	mustEmbedUnimplementedExchangeServiceServer()
@@ -128,9 +415,66 @@ func (UnimplementedExchangeServiceServer) GetQuote(context.Context, *GetQuoteReq
 func (UnimplementedExchangeServiceServer) GetHistory(context.Context, *GetHistoryRequest) (*GetHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetHistory not implemented")
 }
+func (UnimplementedExchangeServiceServer) GetIndicators(context.Context, *GetIndicatorsRequest) (*GetIndicatorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIndicators not implemented")
+}
+func (UnimplementedExchangeServiceServer) SearchInstruments(context.Context, *SearchInstrumentsRequest) (*SearchInstrumentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchInstruments not implemented")
+}
 func (UnimplementedExchangeServiceServer) StreamQuotes(*StreamQuotesRequest, grpc.ServerStreamingServer[StreamQuotesResponse]) error {
 	return status.Error(codes.Unimplemented, "method StreamQuotes not implemented")
 }
+func (UnimplementedExchangeServiceServer) ListQuarantinedQuotes(context.Context, *ListQuarantinedQuotesRequest) (*ListQuarantinedQuotesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQuarantinedQuotes not implemented")
+}
+func (UnimplementedExchangeServiceServer) ReleaseQuarantinedQuote(context.Context, *ReleaseQuarantinedQuoteRequest) (*ReleaseQuarantinedQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseQuarantinedQuote not implemented")
+}
+func (UnimplementedExchangeServiceServer) DiscardQuarantinedQuote(context.Context, *DiscardQuarantinedQuoteRequest) (*DiscardQuarantinedQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiscardQuarantinedQuote not implemented")
+}
+func (UnimplementedExchangeServiceServer) CreateBasket(context.Context, *CreateBasketRequest) (*CreateBasketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBasket not implemented")
+}
+func (UnimplementedExchangeServiceServer) ListBaskets(context.Context, *ListBasketsRequest) (*ListBasketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBaskets not implemented")
+}
+func (UnimplementedExchangeServiceServer) DeleteBasket(context.Context, *DeleteBasketRequest) (*DeleteBasketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteBasket not implemented")
+}
+func (UnimplementedExchangeServiceServer) CreateIndex(context.Context, *CreateIndexRequest) (*CreateIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateIndex not implemented")
+}
+func (UnimplementedExchangeServiceServer) ListIndices(context.Context, *ListIndicesRequest) (*ListIndicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIndices not implemented")
+}
+func (UnimplementedExchangeServiceServer) GetIndex(context.Context, *GetIndexRequest) (*GetIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIndex not implemented")
+}
+func (UnimplementedExchangeServiceServer) FollowIndex(context.Context, *FollowIndexRequest) (*FollowIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FollowIndex not implemented")
+}
+func (UnimplementedExchangeServiceServer) UnfollowIndex(context.Context, *UnfollowIndexRequest) (*UnfollowIndexResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnfollowIndex not implemented")
+}
+func (UnimplementedExchangeServiceServer) CreateOptionContract(context.Context, *CreateOptionContractRequest) (*CreateOptionContractResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOptionContract not implemented")
+}
+func (UnimplementedExchangeServiceServer) ListOptionContracts(context.Context, *ListOptionContractsRequest) (*ListOptionContractsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOptionContracts not implemented")
+}
+func (UnimplementedExchangeServiceServer) CreatePredictionMarket(context.Context, *CreatePredictionMarketRequest) (*CreatePredictionMarketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePredictionMarket not implemented")
+}
+func (UnimplementedExchangeServiceServer) ListPredictionMarkets(context.Context, *ListPredictionMarketsRequest) (*ListPredictionMarketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPredictionMarkets not implemented")
+}
+func (UnimplementedExchangeServiceServer) StakePrediction(context.Context, *StakePredictionRequest) (*StakePredictionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StakePrediction not implemented")
+}
+func (UnimplementedExchangeServiceServer) ListPredictionPositions(context.Context, *ListPredictionPositionsRequest) (*ListPredictionPositionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPredictionPositions not implemented")
+}
 func (UnimplementedExchangeServiceServer) CreateTrade(context.Context, *CreateTradeRequest) (*CreateTradeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateTrade not implemented")
 }
@@ -191,6 +535,42 @@ func _ExchangeService_GetHistory_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExchangeService_GetIndicators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndicatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).GetIndicators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_GetIndicators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).GetIndicators(ctx, req.(*GetIndicatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_SearchInstruments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInstrumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).SearchInstruments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_SearchInstruments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).SearchInstruments(ctx, req.(*SearchInstrumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExchangeService_StreamQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamQuotesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -202,6 +582,312 @@ func _ExchangeService_StreamQuotes_Handler(srv interface{}, stream grpc.ServerSt
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ExchangeService_StreamQuotesServer = grpc.ServerStreamingServer[StreamQuotesResponse]
 
+func _ExchangeService_ListQuarantinedQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuarantinedQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).ListQuarantinedQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_ListQuarantinedQuotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).ListQuarantinedQuotes(ctx, req.(*ListQuarantinedQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_ReleaseQuarantinedQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseQuarantinedQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).ReleaseQuarantinedQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_ReleaseQuarantinedQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).ReleaseQuarantinedQuote(ctx, req.(*ReleaseQuarantinedQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_DiscardQuarantinedQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardQuarantinedQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).DiscardQuarantinedQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_DiscardQuarantinedQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).DiscardQuarantinedQuote(ctx, req.(*DiscardQuarantinedQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_CreateBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).CreateBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_CreateBasket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).CreateBasket(ctx, req.(*CreateBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_ListBaskets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBasketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).ListBaskets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_ListBaskets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).ListBaskets(ctx, req.(*ListBasketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_DeleteBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).DeleteBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_DeleteBasket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).DeleteBasket(ctx, req.(*DeleteBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).CreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_CreateIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).CreateIndex(ctx, req.(*CreateIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_ListIndices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).ListIndices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_ListIndices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).ListIndices(ctx, req.(*ListIndicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_GetIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).GetIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_GetIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).GetIndex(ctx, req.(*GetIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_FollowIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).FollowIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_FollowIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).FollowIndex(ctx, req.(*FollowIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_UnfollowIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).UnfollowIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_UnfollowIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).UnfollowIndex(ctx, req.(*UnfollowIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_CreateOptionContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOptionContractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).CreateOptionContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_CreateOptionContract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).CreateOptionContract(ctx, req.(*CreateOptionContractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_ListOptionContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOptionContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).ListOptionContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_ListOptionContracts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).ListOptionContracts(ctx, req.(*ListOptionContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_CreatePredictionMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePredictionMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).CreatePredictionMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_CreatePredictionMarket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).CreatePredictionMarket(ctx, req.(*CreatePredictionMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_ListPredictionMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPredictionMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).ListPredictionMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_ListPredictionMarkets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).ListPredictionMarkets(ctx, req.(*ListPredictionMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_StakePrediction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StakePredictionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).StakePrediction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_StakePrediction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).StakePrediction(ctx, req.(*StakePredictionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeService_ListPredictionPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPredictionPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeServiceServer).ListPredictionPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeService_ListPredictionPositions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeServiceServer).ListPredictionPositions(ctx, req.(*ListPredictionPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ExchangeService_CreateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTradeRequest)
 	if err := dec(in); err != nil {
@@ -235,6 +921,82 @@ var ExchangeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHistory",
 			Handler:    _ExchangeService_GetHistory_Handler,
 		},
+		{
+			MethodName: "GetIndicators",
+			Handler:    _ExchangeService_GetIndicators_Handler,
+		},
+		{
+			MethodName: "SearchInstruments",
+			Handler:    _ExchangeService_SearchInstruments_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedQuotes",
+			Handler:    _ExchangeService_ListQuarantinedQuotes_Handler,
+		},
+		{
+			MethodName: "ReleaseQuarantinedQuote",
+			Handler:    _ExchangeService_ReleaseQuarantinedQuote_Handler,
+		},
+		{
+			MethodName: "DiscardQuarantinedQuote",
+			Handler:    _ExchangeService_DiscardQuarantinedQuote_Handler,
+		},
+		{
+			MethodName: "CreateBasket",
+			Handler:    _ExchangeService_CreateBasket_Handler,
+		},
+		{
+			MethodName: "ListBaskets",
+			Handler:    _ExchangeService_ListBaskets_Handler,
+		},
+		{
+			MethodName: "DeleteBasket",
+			Handler:    _ExchangeService_DeleteBasket_Handler,
+		},
+		{
+			MethodName: "CreateIndex",
+			Handler:    _ExchangeService_CreateIndex_Handler,
+		},
+		{
+			MethodName: "ListIndices",
+			Handler:    _ExchangeService_ListIndices_Handler,
+		},
+		{
+			MethodName: "GetIndex",
+			Handler:    _ExchangeService_GetIndex_Handler,
+		},
+		{
+			MethodName: "FollowIndex",
+			Handler:    _ExchangeService_FollowIndex_Handler,
+		},
+		{
+			MethodName: "UnfollowIndex",
+			Handler:    _ExchangeService_UnfollowIndex_Handler,
+		},
+		{
+			MethodName: "CreateOptionContract",
+			Handler:    _ExchangeService_CreateOptionContract_Handler,
+		},
+		{
+			MethodName: "ListOptionContracts",
+			Handler:    _ExchangeService_ListOptionContracts_Handler,
+		},
+		{
+			MethodName: "CreatePredictionMarket",
+			Handler:    _ExchangeService_CreatePredictionMarket_Handler,
+		},
+		{
+			MethodName: "ListPredictionMarkets",
+			Handler:    _ExchangeService_ListPredictionMarkets_Handler,
+		},
+		{
+			MethodName: "StakePrediction",
+			Handler:    _ExchangeService_StakePrediction_Handler,
+		},
+		{
+			MethodName: "ListPredictionPositions",
+			Handler:    _ExchangeService_ListPredictionPositions_Handler,
+		},
 		{
 			MethodName: "CreateTrade",
 			Handler:    _ExchangeService_CreateTrade_Handler,
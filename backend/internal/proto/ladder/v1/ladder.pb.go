@@ -47,8 +47,10 @@ type Ladder struct {
 	InitialBalance float64 `protobuf:"fixed64,8,opt,name=initial_balance,json=initialBalance,proto3" json:"initial_balance,omitempty"`
 	// List of stock tickers allowed in this competition.
 	AllowedTickers []*TickerInfo `protobuf:"bytes,9,rep,name=allowed_tickers,json=allowedTickers,proto3" json:"allowed_tickers,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Currency balances and net worth are kept in, e.g. "USD".
+	BaseCurrency  string `protobuf:"bytes,10,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Ladder) Reset() {
@@ -144,13 +146,34 @@ func (x *Ladder) GetAllowedTickers() []*TickerInfo {
 	return nil
 }
 
+func (x *Ladder) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
 // Configuration of an allowed stock in the ladder.
 type TickerInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Stock ticker symbol.
+	// Instrument ID, e.g. FH:AAPL.
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	// Source provider for market data.
-	Source        string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// Display name from the instrument catalogue; empty if uncatalogued.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Asset class, e.g. "stock", "crypto" or "forex".
+	AssetClass string `protobuf:"bytes,4,opt,name=asset_class,json=assetClass,proto3" json:"asset_class,omitempty"`
+	// Currency prices are quoted in.
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Exchange or venue the instrument trades on.
+	Exchange string `protobuf:"bytes,6,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	// Smallest price increment; 0 if uncatalogued.
+	TickSize float64 `protobuf:"fixed64,7,opt,name=tick_size,json=tickSize,proto3" json:"tick_size,omitempty"`
+	// Pip size of currency pairs, e.g. 0.0001; 0 for other asset classes.
+	PipSize float64 `protobuf:"fixed64,8,opt,name=pip_size,json=pipSize,proto3" json:"pip_size,omitempty"`
+	// Base currency value of one unit of the ticker's currency; 0 if no rate is available.
+	FxRate        float64 `protobuf:"fixed64,9,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -199,6 +222,55 @@ func (x *TickerInfo) GetSource() string {
 	return ""
 }
 
+func (x *TickerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TickerInfo) GetAssetClass() string {
+	if x != nil {
+		return x.AssetClass
+	}
+	return ""
+}
+
+func (x *TickerInfo) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TickerInfo) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *TickerInfo) GetTickSize() float64 {
+	if x != nil {
+		return x.TickSize
+	}
+	return 0
+}
+
+func (x *TickerInfo) GetPipSize() float64 {
+	if x != nil {
+		return x.PipSize
+	}
+	return 0
+}
+
+func (x *TickerInfo) GetFxRate() float64 {
+	if x != nil {
+		return x.FxRate
+	}
+	return 0
+}
+
 // User standing and status in a ladder.
 type LadderParticipant struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -433,7 +505,7 @@ var File_ladder_v1_ladder_proto protoreflect.FileDescriptor
 
 const file_ladder_v1_ladder_proto_rawDesc = "" +
 	"\n" +
-	"\x16ladder/v1/ladder.proto\x12\tladder.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x12user/v1/user.proto\"\xa0\x03\n" +
+	"\x16ladder/v1/ladder.proto\x12\tladder.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x12user/v1/user.proto\"\xca\x03\n" +
 	"\x06Ladder\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x03B\x03\xe0A\x02R\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12\x17\n" +
@@ -445,11 +517,21 @@ const file_ladder_v1_ladder_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\tcreatedAt\x12,\n" +
 	"\x0finitial_balance\x18\b \x01(\x01B\x03\xe0A\x02R\x0einitialBalance\x12C\n" +
-	"\x0fallowed_tickers\x18\t \x03(\v2\x15.ladder.v1.TickerInfoB\x03\xe0A\x02R\x0eallowedTickers\"F\n" +
+	"\x0fallowed_tickers\x18\t \x03(\v2\x15.ladder.v1.TickerInfoB\x03\xe0A\x02R\x0eallowedTickers\x12(\n" +
+	"\rbase_currency\x18\n" +
+	" \x01(\tB\x03\xe0A\x02R\fbaseCurrency\"\x84\x02\n" +
 	"\n" +
 	"TickerInfo\x12\x1b\n" +
 	"\x06symbol\x18\x01 \x01(\tB\x03\xe0A\x02R\x06symbol\x12\x1b\n" +
-	"\x06source\x18\x02 \x01(\tB\x03\xe0A\x02R\x06source\"\xc3\x01\n" +
+	"\x06source\x18\x02 \x01(\tB\x03\xe0A\x02R\x06source\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
+	"\vasset_class\x18\x04 \x01(\tR\n" +
+	"assetClass\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bexchange\x18\x06 \x01(\tR\bexchange\x12\x1b\n" +
+	"\ttick_size\x18\a \x01(\x01R\btickSize\x12\x19\n" +
+	"\bpip_size\x18\b \x01(\x01R\apipSize\x12\x17\n" +
+	"\afx_rate\x18\t \x01(\x01R\x06fxRate\"\xc3\x01\n" +
 	"\x11LadderParticipant\x12 \n" +
 	"\tladder_id\x18\x01 \x01(\x03B\x03\xe0A\x02R\bladderId\x12/\n" +
 	"\x04user\x18\x02 \x01(\v2\x16.user.v1.PublicProfileB\x03\xe0A\x02R\x04user\x12\x1d\n" +
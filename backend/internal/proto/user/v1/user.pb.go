@@ -265,7 +265,7 @@ func (x *PublicProfile) GetIsPublic() bool {
 // PortfolioItem represents a single stock holding in a user's portfolio.
 type PortfolioItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The stock ticker symbol.
+	// The instrument ID, e.g. FH:AAPL.
 	StockSymbol string `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
 	// The quantity of shares owned.
 	Quantity float64 `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
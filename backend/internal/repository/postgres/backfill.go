@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/gen/sqlc"
+)
+
+// The staging table is created per transaction, so it is not part of the sqlc schema.
+const (
+	createBackfillStagingSQL = `CREATE TEMP TABLE backfill_quotes (
+	symbol TEXT NOT NULL,
+	price NUMERIC NOT NULL,
+	source TEXT NOT NULL,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL
+) ON COMMIT DROP`
+
+	insertBackfillQuotesSQL = `INSERT INTO market_quotes (symbol, price, source, created_at)
+SELECT DISTINCT ON (b.symbol, b.created_at) b.symbol, b.price, b.source, b.created_at
+FROM backfill_quotes b
+WHERE NOT EXISTS (
+	SELECT 1 FROM market_quotes q
+	WHERE q.symbol = b.symbol AND q.created_at = b.created_at
+)
+ORDER BY b.symbol, b.created_at`
+)
+
+// BackfillRepository implements service.BackfillRepository for PostgreSQL.
+type BackfillRepository struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+// NewBackfillRepository creates a new PostgreSQL BackfillRepository.
+func NewBackfillRepository(pool *pgxpool.Pool) *BackfillRepository {
+	return &BackfillRepository{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+// GetBackfillCheckpoint returns how far a backfill has been loaded, or the zero time if
+// it has not started.
+func (r *BackfillRepository) GetBackfillCheckpoint(
+	ctx context.Context,
+	symbol string,
+	resolution time.Duration,
+	rangeStart time.Time,
+) (time.Time, error) {
+	loadedUntil, err := r.queries.GetBackfillCheckpoint(ctx, sqlc.GetBackfillCheckpointParams{
+		Symbol:     symbol,
+		Resolution: domain.ResolutionName(resolution),
+		RangeStart: pgtype.Timestamptz{Time: rangeStart, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return loadedUntil.Time, nil
+}
+
+// LoadCandles bulk-loads the close of each candle as a quote at the candle's end with COPY,
+// skipping quotes already stored for the same symbol and time, and saves the checkpoint in
+// the same transaction. It returns the number of quotes stored.
+func (r *BackfillRepository) LoadCandles(
+	ctx context.Context,
+	candles []*domain.Candle,
+	checkpoint *domain.BackfillCheckpoint,
+) (int64, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var stored int64
+	if len(candles) > 0 {
+		if _, err = tx.Exec(ctx, createBackfillStagingSQL); err != nil {
+			return 0, err
+		}
+
+		_, err = tx.CopyFrom(ctx, pgx.Identifier{"backfill_quotes"},
+			[]string{"symbol", "price", "source", "created_at"},
+			pgx.CopyFromSlice(len(candles), func(i int) ([]any, error) {
+				c := candles[i]
+				price := pgtype.Numeric{Int: c.Close.Coefficient(), Exp: c.Close.Exponent(), Valid: true}
+
+				return []any{c.Symbol, price, c.Source, c.End()}, nil
+			}),
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		tag, execErr := tx.Exec(ctx, insertBackfillQuotesSQL)
+		if execErr != nil {
+			return 0, execErr
+		}
+		stored = tag.RowsAffected()
+	}
+
+	err = r.queries.WithTx(tx).UpsertBackfillCheckpoint(ctx, sqlc.UpsertBackfillCheckpointParams{
+		Symbol:      checkpoint.Symbol,
+		Resolution:  domain.ResolutionName(checkpoint.Resolution),
+		RangeStart:  pgtype.Timestamptz{Time: checkpoint.RangeStart, Valid: true},
+		Source:      checkpoint.Source,
+		LoadedUntil: pgtype.Timestamptz{Time: checkpoint.LoadedUntil, Valid: true},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return stored, tx.Commit(ctx)
+}
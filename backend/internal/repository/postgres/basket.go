@@ -0,0 +1,294 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/gen/sqlc"
+)
+
+// basketExchange is the venue catalogued baskets are listed on.
+const basketExchange = "Ticker Rush"
+
+// BasketRepository implements service.BasketRepository for PostgreSQL.
+type BasketRepository struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+// NewBasketRepository creates a new PostgreSQL BasketRepository.
+func NewBasketRepository(pool *pgxpool.Pool) *BasketRepository {
+	return &BasketRepository{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+// CreateBasket stores a basket with its components and lists it in the instrument
+// catalogue, all in one transaction. It fails with apperrors.ErrBasketExists if the symbol
+// is taken.
+func (r *BasketRepository) CreateBasket(ctx context.Context, basket *domain.Basket) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.queries.WithTx(tx)
+	inserted, err := q.InsertBasket(ctx, sqlc.InsertBasketParams{
+		Symbol:    basket.Symbol,
+		Name:      basket.Name,
+		BaseValue: basket.BaseValue,
+		OwnerID:   pgtype.Int8{Int64: basket.OwnerID, Valid: basket.OwnerID != 0},
+		Rebalance: basket.Rebalance,
+	})
+	if err != nil {
+		return err
+	}
+	if inserted == 0 {
+		return apperrors.ErrBasketExists
+	}
+
+	for _, c := range basket.Components {
+		err = q.InsertBasketComponent(ctx, sqlc.InsertBasketComponentParams{
+			BasketSymbol:    basket.Symbol,
+			ComponentSymbol: c.Symbol,
+			Weight:          c.Weight,
+			Units:           c.Units,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = q.UpsertInstrument(ctx, sqlc.UpsertInstrumentParams{
+		Symbol:     basket.Symbol,
+		Source:     domain.SourceBasket,
+		Name:       basket.Name,
+		AssetClass: domain.AssetClassBasket,
+		Currency:   domain.CurrencyUSD,
+		Exchange:   basketExchange,
+		TickSize:   decimal.New(1, -2),
+		PipSize:    decimal.Zero,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// GetBasket returns a basket with its components. It fails with apperrors.ErrBasketNotFound
+// if the basket does not exist.
+func (r *BasketRepository) GetBasket(ctx context.Context, symbol string) (*domain.Basket, error) {
+	row, err := r.queries.GetBasket(ctx, symbol)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.ErrBasketNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	componentRows, err := r.queries.GetBasketComponents(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]domain.BasketComponent, len(componentRows))
+	for i, c := range componentRows {
+		components[i] = domain.BasketComponent{
+			Symbol: c.ComponentSymbol,
+			Weight: c.Weight,
+			Units:  c.Units,
+		}
+	}
+
+	return toDomainBasket(sqlc.ListBasketsRow(row), components), nil
+}
+
+// ListBaskets returns all baskets with their components, in symbol order.
+func (r *BasketRepository) ListBaskets(ctx context.Context) ([]*domain.Basket, error) {
+	rows, err := r.queries.ListBaskets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	componentRows, err := r.queries.ListBasketComponents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	components := groupComponents(componentRows)
+	baskets := make([]*domain.Basket, len(rows))
+	for i, row := range rows {
+		baskets[i] = toDomainBasket(row, components[row.Symbol])
+	}
+
+	return baskets, nil
+}
+
+// ListIndices returns a page of the baskets published by players with their components,
+// in symbol order.
+func (r *BasketRepository) ListIndices(ctx context.Context, offset, limit int) ([]*domain.Basket, error) {
+	rows, err := r.queries.ListIndices(ctx, sqlc.ListIndicesParams{
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make([]string, len(rows))
+	for i, row := range rows {
+		symbols[i] = row.Symbol
+	}
+
+	componentRows, err := r.queries.ListComponentsOfBaskets(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	components := groupComponents(componentRows)
+	baskets := make([]*domain.Basket, len(rows))
+	for i, row := range rows {
+		baskets[i] = toDomainBasket(sqlc.ListBasketsRow(row), components[row.Symbol])
+	}
+
+	return baskets, nil
+}
+
+// groupComponents groups component rows by the symbol of their basket.
+func groupComponents(rows []sqlc.BasketComponent) map[string][]domain.BasketComponent {
+	components := make(map[string][]domain.BasketComponent)
+	for _, c := range rows {
+		components[c.BasketSymbol] = append(components[c.BasketSymbol], domain.BasketComponent{
+			Symbol: c.ComponentSymbol,
+			Weight: c.Weight,
+			Units:  c.Units,
+		})
+	}
+
+	return components
+}
+
+func toDomainBasket(row sqlc.ListBasketsRow, components []domain.BasketComponent) *domain.Basket {
+	return &domain.Basket{
+		Symbol:        row.Symbol,
+		Name:          row.Name,
+		BaseValue:     row.BaseValue,
+		Components:    components,
+		OwnerID:       row.OwnerID.Int64,
+		OwnerUsername: row.OwnerUsername,
+		Rebalance:     row.Rebalance,
+		RebalancedAt:  row.RebalancedAt.Time,
+		FollowerCount: row.FollowerCount,
+		CreatedAt:     row.CreatedAt.Time,
+	}
+}
+
+// CountBasketsByOwner returns the number of baskets a player has published.
+func (r *BasketRepository) CountBasketsByOwner(ctx context.Context, ownerID int64) (int64, error) {
+	return r.queries.CountBasketsByOwner(ctx, pgtype.Int8{Int64: ownerID, Valid: true})
+}
+
+// RebalanceBasket stores the new units of a basket's components and when they were set.
+func (r *BasketRepository) RebalanceBasket(ctx context.Context, basket *domain.Basket) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.queries.WithTx(tx)
+	for _, c := range basket.Components {
+		err = q.UpdateBasketComponentUnits(ctx, sqlc.UpdateBasketComponentUnitsParams{
+			BasketSymbol:    basket.Symbol,
+			ComponentSymbol: c.Symbol,
+			Units:           c.Units,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = q.SetBasketRebalancedAt(ctx, sqlc.SetBasketRebalancedAtParams{
+		Symbol:       basket.Symbol,
+		RebalancedAt: pgtype.Timestamptz{Time: basket.RebalancedAt, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// FollowBasket adds a follower to a basket. Following twice has no effect.
+func (r *BasketRepository) FollowBasket(ctx context.Context, symbol string, userID int64) error {
+	return r.queries.FollowBasket(ctx, sqlc.FollowBasketParams{BasketSymbol: symbol, UserID: userID})
+}
+
+// UnfollowBasket removes a follower from a basket.
+func (r *BasketRepository) UnfollowBasket(ctx context.Context, symbol string, userID int64) error {
+	return r.queries.UnfollowBasket(ctx, sqlc.UnfollowBasketParams{BasketSymbol: symbol, UserID: userID})
+}
+
+// ListBasketFollowers returns the usernames of a basket's followers, earliest first.
+func (r *BasketRepository) ListBasketFollowers(ctx context.Context, symbol string) ([]string, error) {
+	return r.queries.ListBasketFollowers(ctx, symbol)
+}
+
+// GetInstrumentCurrencies returns the quote currency of each of the given symbols that is
+// in the instrument catalogue.
+func (r *BasketRepository) GetInstrumentCurrencies(ctx context.Context, symbols []string) (map[string]string, error) {
+	rows, err := r.queries.ListInstrumentCurrencies(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies := make(map[string]string, len(rows))
+	for _, row := range rows {
+		currencies[row.Symbol] = row.Currency
+	}
+
+	return currencies, nil
+}
+
+// DeleteBasket removes a basket and its catalogue entry. Baskets a ladder lists are kept,
+// as their holders could otherwise no longer value or sell them.
+func (r *BasketRepository) DeleteBasket(ctx context.Context, symbol string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.queries.WithTx(tx)
+	listed, err := q.IsTickerInLadder(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if listed {
+		return apperrors.ErrBasketInUse
+	}
+
+	deleted, err := q.DeleteBasket(ctx, symbol)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return apperrors.ErrBasketNotFound
+	}
+
+	if err = q.DeleteInstrument(ctx, symbol); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/gen/sqlc"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// FillRepository handles the persistence of executed trades in PostgreSQL.
+type FillRepository struct {
+	queries *sqlc.Queries
+}
+
+// NewFillRepository creates a new instance of FillRepository.
+func NewFillRepository(pool *pgxpool.Pool) *FillRepository {
+	return &FillRepository{
+		queries: sqlc.New(pool),
+	}
+}
+
+// WithTx returns a new FillRepository that uses the given transaction.
+func (r *FillRepository) WithTx(tx service.Transaction) service.FillRepository {
+	return &FillRepository{
+		queries: r.queries.WithTx(tx.(pgx.Tx)),
+	}
+}
+
+// RecordFill stores an executed trade together with the FX rate it was converted at.
+func (r *FillRepository) RecordFill(ctx context.Context, fill *domain.Fill) error {
+	return r.queries.InsertLadderFill(ctx, sqlc.InsertLadderFillParams{
+		LadderID:    fill.LadderID,
+		UserID:      fill.UserID,
+		StockSymbol: fill.Symbol,
+		Side:        fill.Side,
+		Quantity:    fill.Quantity,
+		Price:       fill.Price,
+		Currency:    fill.Currency,
+		FxRate:      fill.FXRate,
+		Amount:      fill.Amount,
+		ExecutedAt:  pgtype.Timestamptz{Time: fill.ExecutedAt, Valid: true},
+	})
+}
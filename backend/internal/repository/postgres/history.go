@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -54,3 +55,42 @@ func (r *HistoryRepository) GetHistory(ctx context.Context, symbol string, limit
 
 	return quotes, nil
 }
+
+// GetLatestQuotes retrieves the most recent stored quote for each of the given symbols.
+// Symbols without any history are omitted.
+func (r *HistoryRepository) GetLatestQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error) {
+	rows, err := r.queries.GetLatestQuotes(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	quotes := make([]*domain.Quote, len(rows))
+	for i, row := range rows {
+		quotes[i] = &domain.Quote{
+			Symbol:    row.Symbol,
+			Price:     row.Price,
+			Source:    row.Source,
+			Timestamp: row.CreatedAt.Time,
+		}
+	}
+
+	return quotes, nil
+}
+
+// GetQuoteAt retrieves the last quote of a symbol recorded at or before the given time.
+func (r *HistoryRepository) GetQuoteAt(ctx context.Context, symbol string, at time.Time) (*domain.Quote, error) {
+	row, err := r.queries.GetQuoteAt(ctx, sqlc.GetQuoteAtParams{
+		Symbol:    symbol,
+		CreatedAt: pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Quote{
+		Symbol:    row.Symbol,
+		Price:     row.Price,
+		Source:    row.Source,
+		Timestamp: row.CreatedAt.Time,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/gen/sqlc"
+)
+
+// likeEscaper escapes the LIKE wildcards in user input.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// InstrumentRepository implements service.InstrumentRepository for PostgreSQL.
+type InstrumentRepository struct {
+	queries *sqlc.Queries
+}
+
+// NewInstrumentRepository creates a new PostgreSQL InstrumentRepository.
+func NewInstrumentRepository(pool *pgxpool.Pool) *InstrumentRepository {
+	return &InstrumentRepository{
+		queries: sqlc.New(pool),
+	}
+}
+
+// SaveInstrument inserts or replaces a catalogue entry.
+func (r *InstrumentRepository) SaveInstrument(ctx context.Context, instrument *domain.Instrument) error {
+	return r.queries.UpsertInstrument(ctx, sqlc.UpsertInstrumentParams{
+		Symbol:     instrument.Symbol,
+		Source:     instrument.Source,
+		Name:       instrument.Name,
+		AssetClass: instrument.AssetClass,
+		Currency:   instrument.Currency,
+		Exchange:   instrument.Exchange,
+		TickSize:   instrument.TickSize,
+		PipSize:    instrument.PipSize,
+	})
+}
+
+// SearchInstruments returns up to limit instruments whose symbol or name starts with query,
+// followed by fuzzy matches. An empty query lists the catalogue in symbol order.
+func (r *InstrumentRepository) SearchInstruments(ctx context.Context, query string, limit int) ([]*domain.Instrument, error) {
+	if query == "" {
+		rows, err := r.queries.ListInstruments(ctx, int32(limit))
+		if err != nil {
+			return nil, err
+		}
+
+		instruments := make([]*domain.Instrument, len(rows))
+		for i, row := range rows {
+			instruments[i] = toDomainInstrument(sqlc.SearchInstrumentsRow(row))
+		}
+
+		return instruments, nil
+	}
+
+	rows, err := r.queries.SearchInstruments(ctx, sqlc.SearchInstrumentsParams{
+		Pattern:    likeEscaper.Replace(query) + "%",
+		Query:      query,
+		MaxResults: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instruments := make([]*domain.Instrument, len(rows))
+	for i, row := range rows {
+		instruments[i] = toDomainInstrument(row)
+	}
+
+	return instruments, nil
+}
+
+// GetTrackedTickers returns every ticker used by any ladder.
+func (r *InstrumentRepository) GetTrackedTickers(ctx context.Context) ([]*domain.TickerInfo, error) {
+	rows, err := r.queries.ListTrackedTickers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tickers := make([]*domain.TickerInfo, len(rows))
+	for i, row := range rows {
+		tickers[i] = &domain.TickerInfo{Symbol: row.StockSymbol, Source: row.Source}
+	}
+
+	return tickers, nil
+}
+
+func toDomainInstrument(row sqlc.SearchInstrumentsRow) *domain.Instrument {
+	return &domain.Instrument{
+		Symbol:     row.Symbol,
+		Source:     row.Source,
+		Name:       row.Name,
+		AssetClass: row.AssetClass,
+		Currency:   row.Currency,
+		Exchange:   row.Exchange,
+		TickSize:   row.TickSize,
+		PipSize:    row.PipSize,
+	}
+}
+
+// numericToDecimal converts a computed NUMERIC column, which sqlc cannot map to decimal by
+// column name. NULL converts to zero.
+func numericToDecimal(n pgtype.Numeric) decimal.Decimal {
+	if !n.Valid || n.Int == nil {
+		return decimal.Zero
+	}
+
+	return decimal.NewFromBigInt(n.Int, n.Exp)
+}
+
+// decimalToNumeric converts a decimal for a NUMERIC parameter or nullable column, which sqlc
+// cannot map to decimal by column name.
+func decimalToNumeric(d decimal.Decimal) pgtype.Numeric {
+	return pgtype.Numeric{Int: d.Coefficient(), Exp: d.Exponent(), Valid: true}
+}
@@ -50,10 +50,7 @@ func (r *LadderRepository) GetLadder(ctx context.Context, id int64) (*domain.Lad
 
 	allowed := make([]domain.TickerInfo, len(tickers))
 	for i, t := range tickers {
-		allowed[i] = domain.TickerInfo{
-			Symbol: t.Symbol,
-			Source: t.Source,
-		}
+		allowed[i] = *t
 	}
 
 	return &domain.Ladder{
@@ -64,12 +61,14 @@ func (r *LadderRepository) GetLadder(ctx context.Context, id int64) (*domain.Lad
 		EndTime:        row.EndTime.Time,
 		IsActive:       row.IsActive,
 		InitialBalance: row.InitialBalance,
+		BaseCurrency:   row.BaseCurrency,
 		AllowedTickers: allowed,
 		CreatedAt:      row.CreatedAt.Time,
 	}, nil
 }
 
-// GetAllowedTickers retrieves the allowed stock symbols for a given ladder.
+// GetAllowedTickers retrieves the allowed stock symbols for a given ladder, with the metadata of
+// those in the instrument catalogue.
 func (r *LadderRepository) GetAllowedTickers(ctx context.Context, ladderID int64) ([]*domain.TickerInfo, error) {
 	tickers, err := r.queries.GetLadderTickers(ctx, ladderID)
 	if err != nil {
@@ -78,8 +77,14 @@ func (r *LadderRepository) GetAllowedTickers(ctx context.Context, ladderID int64
 	tickerInfos := make([]*domain.TickerInfo, len(tickers))
 	for i, t := range tickers {
 		tickerInfos[i] = &domain.TickerInfo{
-			Symbol: t.StockSymbol,
-			Source: t.Source,
+			Symbol:     t.StockSymbol,
+			Source:     t.Source,
+			Name:       t.Name,
+			AssetClass: t.AssetClass,
+			Currency:   t.Currency,
+			Exchange:   t.Exchange,
+			TickSize:   numericToDecimal(t.TickSize),
+			PipSize:    numericToDecimal(t.PipSize),
 		}
 	}
 
@@ -128,6 +133,7 @@ func (r *LadderRepository) GetExpiredActiveLadders(ctx context.Context, now time
 			EndTime:        row.EndTime.Time,
 			IsActive:       row.IsActive,
 			InitialBalance: row.InitialBalance,
+			BaseCurrency:   row.BaseCurrency,
 			CreatedAt:      row.CreatedAt.Time,
 		}
 	}
@@ -151,6 +157,7 @@ func (r *LadderRepository) GetPendingLaddersToActivate(ctx context.Context, now
 			EndTime:        row.EndTime.Time,
 			IsActive:       row.IsActive,
 			InitialBalance: row.InitialBalance,
+			BaseCurrency:   row.BaseCurrency,
 			CreatedAt:      row.CreatedAt.Time,
 		}
 	}
@@ -211,3 +218,29 @@ func (r *LadderRepository) PruneLadderParticipants(ctx context.Context, ladderID
 func (r *LadderRepository) DeleteLadderPortfolioItemsByLadder(ctx context.Context, ladderID int64) error {
 	return r.queries.DeleteLadderPortfolioItemsByLadder(ctx, ladderID)
 }
+
+// GetHeldSymbols retrieves the symbols that at least one participant of a ladder currently holds.
+func (r *LadderRepository) GetHeldSymbols(ctx context.Context, ladderID int64) ([]string, error) {
+	return r.queries.GetLadderHeldSymbols(ctx, ladderID)
+}
+
+// GetBasketComponents retrieves the components of the baskets a ladder lists, with the tick
+// and pip sizes of those in the instrument catalogue.
+func (r *LadderRepository) GetBasketComponents(ctx context.Context, ladderID int64) ([]*domain.TickerInfo, error) {
+	rows, err := r.queries.GetLadderBasketComponents(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]*domain.TickerInfo, len(rows))
+	for i, row := range rows {
+		components[i] = &domain.TickerInfo{
+			Symbol:   row.ComponentSymbol,
+			Source:   domain.InstrumentSource(row.ComponentSymbol),
+			TickSize: numericToDecimal(row.TickSize),
+			PipSize:  numericToDecimal(row.PipSize),
+		}
+	}
+
+	return components, nil
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/gen/sqlc"
+)
+
+// OptionRepository implements service.OptionRepository for PostgreSQL.
+type OptionRepository struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+// NewOptionRepository creates a new PostgreSQL OptionRepository.
+func NewOptionRepository(pool *pgxpool.Pool) *OptionRepository {
+	return &OptionRepository{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+// CreateOptionContract lists an option contract. It fails with apperrors.ErrOptionExists if a
+// contract with the same symbol is already listed.
+func (r *OptionRepository) CreateOptionContract(ctx context.Context, contract *domain.OptionContract) error {
+	volatility := pgtype.Numeric{}
+	if contract.Volatility.IsPositive() {
+		volatility = decimalToNumeric(contract.Volatility)
+	}
+
+	inserted, err := r.queries.InsertOptionContract(ctx, sqlc.InsertOptionContractParams{
+		Symbol:           contract.Symbol,
+		LadderID:         contract.LadderID,
+		UnderlyingSymbol: contract.Underlying,
+		OptionType:       contract.Type,
+		Strike:           contract.Strike,
+		ExpiresAt:        pgtype.Timestamptz{Time: contract.ExpiresAt, Valid: true},
+		Volatility:       volatility,
+	})
+	if err != nil {
+		return err
+	}
+	if inserted == 0 {
+		return apperrors.ErrOptionExists
+	}
+
+	return nil
+}
+
+// ListOptionContracts returns the option contracts of a ladder, by underlying and expiry.
+func (r *OptionRepository) ListOptionContracts(ctx context.Context, ladderID int64) ([]*domain.OptionContract, error) {
+	rows, err := r.queries.ListOptionContracts(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOptionContracts(rows), nil
+}
+
+// GetOptionContract retrieves an option contract by symbol. It fails with
+// apperrors.ErrOptionNotFound if no such contract is listed.
+func (r *OptionRepository) GetOptionContract(ctx context.Context, symbol string) (*domain.OptionContract, error) {
+	row, err := r.queries.GetOptionContract(ctx, symbol)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.ErrOptionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOptionContract(row), nil
+}
+
+// ListExpiredOptionContracts returns the unsettled option contracts that expired by now.
+func (r *OptionRepository) ListExpiredOptionContracts(ctx context.Context, now time.Time) ([]*domain.OptionContract, error) {
+	rows, err := r.queries.ListExpiredOptionContracts(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOptionContracts(rows), nil
+}
+
+// SettleOptionContract marks a contract as settled, credits every holder the payout per unit
+// held and closes their positions, all in one transaction. Contracts that are already
+// settled are left unchanged.
+func (r *OptionRepository) SettleOptionContract(
+	ctx context.Context,
+	contract *domain.OptionContract,
+	payout decimal.Decimal,
+) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	q := r.queries.WithTx(tx)
+	settled, err := q.MarkOptionContractSettled(ctx, sqlc.MarkOptionContractSettledParams{
+		Symbol:          contract.Symbol,
+		SettlementPrice: decimalToNumeric(contract.SettlementPrice),
+		SettledAt:       pgtype.Timestamptz{Time: *contract.SettledAt, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if settled == 0 {
+		return nil
+	}
+
+	err = q.CreditOptionSettlement(ctx, sqlc.CreditOptionSettlementParams{
+		Payout:   decimalToNumeric(payout),
+		LadderID: contract.LadderID,
+		Symbol:   contract.Symbol,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = q.DeleteOptionHoldings(ctx, sqlc.DeleteOptionHoldingsParams{
+		LadderID:    contract.LadderID,
+		StockSymbol: contract.Symbol,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func toDomainOptionContract(row sqlc.OptionContract) *domain.OptionContract {
+	contract := &domain.OptionContract{
+		Symbol:          row.Symbol,
+		LadderID:        row.LadderID,
+		Underlying:      row.UnderlyingSymbol,
+		Type:            row.OptionType,
+		Strike:          row.Strike,
+		ExpiresAt:       row.ExpiresAt.Time,
+		Volatility:      numericToDecimal(row.Volatility),
+		SettlementPrice: numericToDecimal(row.SettlementPrice),
+		CreatedAt:       row.CreatedAt.Time,
+	}
+	if row.SettledAt.Valid {
+		settledAt := row.SettledAt.Time
+		contract.SettledAt = &settledAt
+	}
+
+	return contract
+}
+
+func toDomainOptionContracts(rows []sqlc.OptionContract) []*domain.OptionContract {
+	contracts := make([]*domain.OptionContract, len(rows))
+	for i, row := range rows {
+		contracts[i] = toDomainOptionContract(row)
+	}
+
+	return contracts
+}
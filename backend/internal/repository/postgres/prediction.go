@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/gen/sqlc"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// PredictionRepository implements service.PredictionRepository for PostgreSQL.
+type PredictionRepository struct {
+	queries *sqlc.Queries
+}
+
+// NewPredictionRepository creates a new PostgreSQL PredictionRepository.
+func NewPredictionRepository(pool *pgxpool.Pool) *PredictionRepository {
+	return &PredictionRepository{
+		queries: sqlc.New(pool),
+	}
+}
+
+// WithTx returns a new PredictionRepository that uses the given transaction.
+func (r *PredictionRepository) WithTx(tx service.Transaction) service.PredictionRepository {
+	return &PredictionRepository{
+		queries: r.queries.WithTx(tx.(pgx.Tx)),
+	}
+}
+
+// CreatePredictionMarket stores a prediction market and sets its ID and creation time.
+func (r *PredictionRepository) CreatePredictionMarket(ctx context.Context, market *domain.PredictionMarket) error {
+	row, err := r.queries.InsertPredictionMarket(ctx, sqlc.InsertPredictionMarketParams{
+		LadderID:   market.LadderID,
+		Symbol:     market.Symbol,
+		Question:   market.Question,
+		Threshold:  market.Threshold,
+		ResolvesAt: pgtype.Timestamptz{Time: market.ResolvesAt, Valid: true},
+		Liquidity:  market.Liquidity,
+	})
+	if err != nil {
+		return err
+	}
+
+	market.ID = row.ID
+	market.CreatedAt = row.CreatedAt.Time
+
+	return nil
+}
+
+// ListPredictionMarkets returns the prediction markets of a ladder, by resolution time.
+func (r *PredictionRepository) ListPredictionMarkets(ctx context.Context, ladderID int64) ([]*domain.PredictionMarket, error) {
+	rows, err := r.queries.ListPredictionMarkets(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPredictionMarkets(rows), nil
+}
+
+// GetPredictionMarketForUpdate retrieves a prediction market with a lock for update. It fails
+// with apperrors.ErrPredictionMarketNotFound if the market does not exist.
+func (r *PredictionRepository) GetPredictionMarketForUpdate(ctx context.Context, id int64) (*domain.PredictionMarket, error) {
+	row, err := r.queries.GetPredictionMarketForUpdate(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.ErrPredictionMarketNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPredictionMarket(row), nil
+}
+
+// UpdatePredictionMarketShares stores the outstanding YES and NO shares of a market.
+func (r *PredictionRepository) UpdatePredictionMarketShares(ctx context.Context, market *domain.PredictionMarket) error {
+	return r.queries.UpdatePredictionMarketShares(ctx, sqlc.UpdatePredictionMarketSharesParams{
+		ID:        market.ID,
+		YesShares: market.YesShares,
+		NoShares:  market.NoShares,
+	})
+}
+
+// AddPredictionPosition adds shares and stake to a player's position in a market and returns
+// the resulting position.
+func (r *PredictionRepository) AddPredictionPosition(
+	ctx context.Context,
+	position *domain.PredictionPosition,
+) (*domain.PredictionPosition, error) {
+	row, err := r.queries.AddPredictionPosition(ctx, sqlc.AddPredictionPositionParams{
+		MarketID:  position.MarketID,
+		UserID:    position.UserID,
+		YesShares: position.YesShares,
+		NoShares:  position.NoShares,
+		Staked:    position.Staked,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPredictionPosition(row), nil
+}
+
+// ListPredictionPositions returns a player's positions in the prediction markets of a ladder.
+func (r *PredictionRepository) ListPredictionPositions(
+	ctx context.Context,
+	ladderID int64,
+	userID int64,
+) ([]*domain.PredictionPosition, error) {
+	rows, err := r.queries.ListPredictionPositions(ctx, sqlc.ListPredictionPositionsParams{
+		LadderID: ladderID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]*domain.PredictionPosition, len(rows))
+	for i, row := range rows {
+		positions[i] = toDomainPredictionPosition(row)
+	}
+
+	return positions, nil
+}
+
+// ListOpenPredictionPositions returns all players' positions in the unresolved prediction
+// markets of a ladder.
+func (r *PredictionRepository) ListOpenPredictionPositions(ctx context.Context, ladderID int64) ([]*domain.PredictionPosition, error) {
+	rows, err := r.queries.ListOpenPredictionPositions(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]*domain.PredictionPosition, len(rows))
+	for i, row := range rows {
+		positions[i] = toDomainPredictionPosition(row)
+	}
+
+	return positions, nil
+}
+
+// ListDuePredictionMarkets returns the unresolved prediction markets due by now.
+func (r *PredictionRepository) ListDuePredictionMarkets(ctx context.Context, now time.Time) ([]*domain.PredictionMarket, error) {
+	rows, err := r.queries.ListDuePredictionMarkets(ctx, pgtype.Timestamptz{Time: now, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPredictionMarkets(rows), nil
+}
+
+// MarkPredictionMarketResolved stores the outcome of a market. It reports false if the market
+// was already resolved.
+func (r *PredictionRepository) MarkPredictionMarketResolved(ctx context.Context, market *domain.PredictionMarket) (bool, error) {
+	resolved, err := r.queries.MarkPredictionMarketResolved(ctx, sqlc.MarkPredictionMarketResolvedParams{
+		ID:              market.ID,
+		Outcome:         pgtype.Text{String: market.Outcome, Valid: true},
+		ResolutionPrice: decimalToNumeric(market.ResolutionPrice),
+		ResolvedAt:      pgtype.Timestamptz{Time: *market.ResolvedAt, Valid: true},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return resolved > 0, nil
+}
+
+// CreditPredictionPayouts credits every holder of winning shares in a resolved market one
+// unit of the ladder's base currency per share.
+func (r *PredictionRepository) CreditPredictionPayouts(ctx context.Context, market *domain.PredictionMarket) error {
+	return r.queries.CreditPredictionPayouts(ctx, sqlc.CreditPredictionPayoutsParams{
+		Outcome:  market.Outcome,
+		MarketID: market.ID,
+		LadderID: market.LadderID,
+	})
+}
+
+func toDomainPredictionMarkets(rows []sqlc.PredictionMarket) []*domain.PredictionMarket {
+	markets := make([]*domain.PredictionMarket, len(rows))
+	for i, row := range rows {
+		markets[i] = toDomainPredictionMarket(row)
+	}
+
+	return markets
+}
+
+func toDomainPredictionMarket(row sqlc.PredictionMarket) *domain.PredictionMarket {
+	market := &domain.PredictionMarket{
+		ID:              row.ID,
+		LadderID:        row.LadderID,
+		Symbol:          row.Symbol,
+		Question:        row.Question,
+		Threshold:       row.Threshold,
+		ResolvesAt:      row.ResolvesAt.Time,
+		Liquidity:       row.Liquidity,
+		YesShares:       row.YesShares,
+		NoShares:        row.NoShares,
+		Outcome:         row.Outcome.String,
+		ResolutionPrice: numericToDecimal(row.ResolutionPrice),
+		CreatedAt:       row.CreatedAt.Time,
+	}
+	if row.ResolvedAt.Valid {
+		resolvedAt := row.ResolvedAt.Time
+		market.ResolvedAt = &resolvedAt
+	}
+
+	return market
+}
+
+func toDomainPredictionPosition(row sqlc.PredictionPosition) *domain.PredictionPosition {
+	return &domain.PredictionPosition{
+		MarketID:  row.MarketID,
+		UserID:    row.UserID,
+		YesShares: row.YesShares,
+		NoShares:  row.NoShares,
+		Staked:    row.Staked,
+	}
+}
@@ -0,0 +1,71 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const fxRatesKey = "fx:rates"
+
+type valkeyFXRate struct {
+	Rate      decimal.Decimal `json:"rate"`
+	UpdatedAt int64           `json:"updated_at"`
+}
+
+// FXRateRepository caches the latest FX rates in a Valkey hash keyed by currency.
+type FXRateRepository struct {
+	valkey *redis.Client
+}
+
+// NewFXRateRepository creates a new instance of FXRateRepository.
+func NewFXRateRepository(valkey *redis.Client) *FXRateRepository {
+	return &FXRateRepository{valkey: valkey}
+}
+
+// SaveRates stores the given rates, replacing earlier rates of the same currencies.
+func (r *FXRateRepository) SaveRates(ctx context.Context, rates []*domain.FXRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	values := make([]any, 0, 2*len(rates))
+	for _, rate := range rates {
+		data, err := json.Marshal(valkeyFXRate{Rate: rate.Rate, UpdatedAt: rate.UpdatedAt.UnixMilli()})
+		if err != nil {
+			return err
+		}
+		values = append(values, rate.Currency, data)
+	}
+
+	return r.valkey.HSet(ctx, fxRatesKey, values...).Err()
+}
+
+// GetRate returns the cached rate of a currency, or redis.Nil if there is none.
+func (r *FXRateRepository) GetRate(ctx context.Context, currency string) (*domain.FXRate, error) {
+	data, err := r.valkey.HGet(ctx, fxRatesKey, currency).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var cached valkeyFXRate
+	if unmarshalErr := json.Unmarshal(data, &cached); unmarshalErr != nil {
+		return nil, fmt.Errorf("invalid cached FX rate of %s: %w", currency, unmarshalErr)
+	}
+	if !cached.Rate.IsPositive() {
+		return nil, errors.New("cached FX rate is not positive")
+	}
+
+	return &domain.FXRate{
+		Currency:  currency,
+		Rate:      cached.Rate,
+		UpdatedAt: time.UnixMilli(cached.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,67 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+)
+
+func TestFXRateRepository(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	rClient := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer func() { _ = rClient.Close() }()
+
+	repo := redisRepo.NewFXRateRepository(rClient)
+	ctx := context.Background()
+	updatedAt := time.UnixMilli(time.Now().UnixMilli())
+
+	t.Run("Round Trip", func(t *testing.T) {
+		err := repo.SaveRates(ctx, []*domain.FXRate{
+			{Currency: "EUR", Rate: decimal.RequireFromString("1.0842"), UpdatedAt: updatedAt},
+			{Currency: "JPY", Rate: decimal.RequireFromString("0.00625"), UpdatedAt: updatedAt},
+		})
+		require.NoError(t, err)
+
+		rate, err := repo.GetRate(ctx, "EUR")
+		require.NoError(t, err)
+		assert.Equal(t, "EUR", rate.Currency)
+		assert.Equal(t, "1.0842", rate.Rate.String())
+		assert.True(t, updatedAt.Equal(rate.UpdatedAt))
+
+		rate, err = repo.GetRate(ctx, "JPY")
+		require.NoError(t, err)
+		assert.Equal(t, "0.00625", rate.Rate.String())
+	})
+
+	t.Run("Replaces Rate", func(t *testing.T) {
+		err := repo.SaveRates(ctx, []*domain.FXRate{
+			{Currency: "EUR", Rate: decimal.RequireFromString("1.09"), UpdatedAt: updatedAt},
+		})
+		require.NoError(t, err)
+
+		rate, err := repo.GetRate(ctx, "EUR")
+		require.NoError(t, err)
+		assert.Equal(t, "1.09", rate.Rate.String())
+	})
+
+	t.Run("Missing Rate", func(t *testing.T) {
+		_, err := repo.GetRate(ctx, "GBP")
+		assert.ErrorIs(t, err, redis.Nil)
+	})
+}
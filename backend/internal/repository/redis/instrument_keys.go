@@ -0,0 +1,128 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// legacyKeyScanCount is the SCAN batch size used when looking for keys of bare symbols.
+const legacyKeyScanCount = 500
+
+// KeyMigration counts the Valkey entries touched while moving bare provider symbols to
+// instrument IDs.
+type KeyMigration struct {
+	// Moved entries were rewritten under their instrument ID.
+	Moved int
+	// Dropped entries could not be mapped, or were superseded by fresher data, and were deleted.
+	Dropped int
+}
+
+// MigrateInstrumentIDs moves quotes cached under bare provider symbols, as written before
+// symbols became instrument IDs (e.g. "AAPL" instead of "FH:AAPL"), to their instrument ID.
+// The ID is derived from the quote's source. A quote already cached under the ID is newer
+// and is kept. Replay streams of bare symbols are deleted, since their entries carry the old
+// symbol; reconnecting clients simply resume from the live feed.
+func (r *MarketRepository) MigrateInstrumentIDs(ctx context.Context) (KeyMigration, error) {
+	var res KeyMigration
+
+	iter := r.valkey.Scan(ctx, 0, marketQuotePrefix+":*", legacyKeyScanCount).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		if key == marketStreamSeqKey {
+			continue
+		}
+
+		if symbol, ok := strings.CutPrefix(key, marketStreamPrefix+":"); ok {
+			if strings.Contains(symbol, ":") {
+				continue
+			}
+			if err := r.valkey.Del(ctx, key).Err(); err != nil {
+				return res, err
+			}
+			res.Dropped++
+
+			continue
+		}
+
+		symbol := strings.TrimPrefix(key, marketQuotePrefix+":")
+		if strings.Contains(symbol, ":") {
+			continue
+		}
+
+		moved, err := r.migrateQuoteKey(ctx, key, symbol)
+		if err != nil {
+			return res, err
+		}
+		if moved {
+			res.Moved++
+		} else {
+			res.Dropped++
+		}
+	}
+
+	return res, iter.Err()
+}
+
+// migrateQuoteKey rewrites the quote cached at key under its instrument ID and deletes key.
+// It reports whether the quote was written.
+func (r *MarketRepository) migrateQuoteKey(ctx context.Context, key, symbol string) (bool, error) {
+	val, err := r.valkey.Get(ctx, key).Bytes()
+	if err != nil {
+		return false, err
+	}
+
+	moved := false
+	quote, err := decodeQuote(val)
+	if err == nil && quote.Source != "" {
+		quote.Symbol = domain.InstrumentID(quote.Source, symbol)
+		if moved, err = r.RestoreQuote(ctx, quote); err != nil {
+			return false, err
+		}
+	}
+
+	return moved, r.valkey.Del(ctx, key).Err()
+}
+
+// MigrateInstrumentIDs re-keys quarantined quotes of bare provider symbols under their
+// instrument ID, derived from the quote's source, so that releasing them updates the
+// instrument's live quote. Entries without a source are dropped.
+func (r *QuarantineRepository) MigrateInstrumentIDs(ctx context.Context) (KeyMigration, error) {
+	var res KeyMigration
+
+	entries, err := r.valkey.HGetAll(ctx, quarantineKey).Result()
+	if err != nil {
+		return res, err
+	}
+
+	for id, val := range entries {
+		var vq valkeyQuarantinedQuote
+		if errJSON := json.Unmarshal([]byte(val), &vq); errJSON != nil {
+			return res, errJSON
+		}
+		if strings.Contains(vq.Quote.Symbol, ":") {
+			continue
+		}
+
+		if errDel := r.valkey.HDel(ctx, quarantineKey, id).Err(); errDel != nil {
+			return res, errDel
+		}
+		if vq.Quote.Source == "" {
+			res.Dropped++
+
+			continue
+		}
+
+		entry := vq.toDomain()
+		entry.ID = ""
+		entry.Quote.Symbol = domain.InstrumentID(entry.Quote.Source, entry.Quote.Symbol)
+		if errQuarantine := r.Quarantine(ctx, entry); errQuarantine != nil {
+			return res, errQuarantine
+		}
+		res.Moved++
+	}
+
+	return res, nil
+}
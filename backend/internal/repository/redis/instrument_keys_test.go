@@ -0,0 +1,83 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+)
+
+func TestMigrateInstrumentIDs(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	rClient := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer func() { _ = rClient.Close() }()
+
+	ctx := context.Background()
+	marketRepo := redisRepo.NewMarketRepository(rClient)
+	quarantineRepo := redisRepo.NewQuarantineRepository(rClient)
+	now := time.Now().Truncate(time.Second)
+
+	// Write quotes the way they were cached before symbols became instrument IDs.
+	require.NoError(t, marketRepo.SaveQuote(ctx, &domain.Quote{Symbol: "AAPL", Price: decimal.NewFromInt(190), Source: "Finnhub", Timestamp: now}))
+	require.NoError(t, marketRepo.SaveQuote(ctx, &domain.Quote{Symbol: "bitcoin", Price: decimal.NewFromInt(60000), Source: "CoinGecko", Timestamp: now}))
+	require.NoError(t, marketRepo.SaveQuote(ctx, &domain.Quote{Symbol: "CG:bitcoin", Price: decimal.NewFromInt(65000), Source: "CoinGecko", Timestamp: now}))
+	require.NoError(t, quarantineRepo.Quarantine(ctx, &domain.QuarantinedQuote{
+		Quote:         domain.Quote{Symbol: "AAPL", Price: decimal.NewFromInt(1900), Source: "Finnhub", Timestamp: now},
+		Reason:        domain.QuarantineReasonDeviation,
+		QuarantinedAt: now,
+	}))
+
+	t.Run("Quotes", func(t *testing.T) {
+		res, err := marketRepo.MigrateInstrumentIDs(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, res.Moved)
+		assert.Equal(t, 3, res.Dropped, "the superseded bitcoin quote and both bare replay streams")
+
+		quote, err := marketRepo.GetQuote(ctx, "FH:AAPL")
+		require.NoError(t, err)
+		assert.Equal(t, "FH:AAPL", quote.Symbol)
+		assert.True(t, decimal.NewFromInt(190).Equal(quote.Price))
+
+		quote, err = marketRepo.GetQuote(ctx, "CG:bitcoin")
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(65000).Equal(quote.Price), "the newer cached quote wins")
+
+		for _, key := range []string{"market:AAPL", "market:bitcoin", "market:stream:AAPL", "market:stream:bitcoin"} {
+			assert.False(t, mr.Exists(key), key)
+		}
+		assert.True(t, mr.Exists("market:stream:CG:bitcoin"))
+
+		res, err = marketRepo.MigrateInstrumentIDs(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, redisRepo.KeyMigration{}, res)
+	})
+
+	t.Run("Quarantine", func(t *testing.T) {
+		res, err := quarantineRepo.MigrateInstrumentIDs(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, res.Moved)
+
+		entries, err := quarantineRepo.ListQuarantined(ctx)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "FH:AAPL", entries[0].Quote.Symbol)
+
+		_, err = quarantineRepo.TakeQuarantined(ctx, entries[0].ID)
+		assert.NoError(t, err)
+	})
+}
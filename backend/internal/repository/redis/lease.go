@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const leasePrefix = "lease"
+
+func leaseKey(name string) string {
+	return fmt.Sprintf("%s:%s", leasePrefix, name)
+}
+
+// leaseFenceKey holds the last fencing token issued for a lease. It never expires,
+// so tokens keep increasing across leaders.
+func leaseFenceKey(name string) string {
+	return fmt.Sprintf("%s:%s:fence", leasePrefix, name)
+}
+
+// acquireLeaseLuaScript renews the lease if the caller holds it, takes it if it is free,
+// and returns the holder's fencing token, or 0 if someone else holds it.
+var acquireLeaseLuaScript = redis.NewScript(`
+	local holder = redis.call('HGET', KEYS[1], 'holder')
+	if holder == ARGV[1] then
+		redis.call('PEXPIRE', KEYS[1], ARGV[2])
+		return tonumber(redis.call('HGET', KEYS[1], 'token'))
+	end
+	if holder then
+		return 0
+	end
+	local token = redis.call('INCR', KEYS[2])
+	redis.call('HSET', KEYS[1], 'holder', ARGV[1], 'token', token)
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return token
+`)
+
+var releaseLeaseLuaScript = redis.NewScript(`
+	if redis.call('HGET', KEYS[1], 'holder') == ARGV[1] then
+		return redis.call('DEL', KEYS[1])
+	end
+	return 0
+`)
+
+// LeaseRepository implements expiring, fenced leases in Valkey.
+type LeaseRepository struct {
+	valkey *redis.Client
+}
+
+// NewLeaseRepository creates a new instance of LeaseRepository.
+func NewLeaseRepository(valkey *redis.Client) *LeaseRepository {
+	return &LeaseRepository{valkey: valkey}
+}
+
+// AcquireLease takes or renews the named lease for holder and returns its fencing token.
+// A zero token means the lease is held by someone else.
+func (r *LeaseRepository) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (int64, error) {
+	return acquireLeaseLuaScript.Run(
+		ctx,
+		r.valkey,
+		[]string{leaseKey(name), leaseFenceKey(name)},
+		holder,
+		ttl.Milliseconds(),
+	).Int64()
+}
+
+// ReleaseLease gives up the named lease if holder still holds it.
+func (r *LeaseRepository) ReleaseLease(ctx context.Context, name, holder string) error {
+	return releaseLeaseLuaScript.Run(ctx, r.valkey, []string{leaseKey(name)}, holder).Err()
+}
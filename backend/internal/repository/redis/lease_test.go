@@ -0,0 +1,84 @@
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+)
+
+func TestLeaseRepository(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	rClient := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer func() { _ = rClient.Close() }()
+
+	leases := redisRepo.NewLeaseRepository(rClient)
+	market := redisRepo.NewMarketRepository(rClient)
+	ctx := context.Background()
+
+	const lease = "fetcher:Finnhub"
+	ttl := 10 * time.Second
+	quote := &domain.Quote{Symbol: "AAPL", Price: decimal.NewFromInt(190), Timestamp: time.Now()}
+
+	t.Run("Single Holder", func(t *testing.T) {
+		token, err := leases.AcquireLease(ctx, lease, "a", ttl)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), token)
+
+		renewed, err := leases.AcquireLease(ctx, lease, "a", ttl)
+		require.NoError(t, err)
+		assert.Equal(t, token, renewed)
+
+		other, err := leases.AcquireLease(ctx, lease, "b", ttl)
+		require.NoError(t, err)
+		assert.Zero(t, other)
+	})
+
+	t.Run("Takeover After Expiry Fences Old Leader", func(t *testing.T) {
+		mr.FastForward(ttl + time.Second)
+
+		token, err := leases.AcquireLease(ctx, lease, "b", ttl)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), token)
+
+		err = market.SaveQuoteFenced(ctx, quote, domain.Fence{Lease: lease, Token: 1})
+		assert.ErrorIs(t, err, apperrors.ErrStaleFencingToken)
+
+		err = market.SaveQuoteFenced(ctx, quote, domain.Fence{Lease: lease, Token: token})
+		assert.NoError(t, err)
+
+		stored, err := market.GetQuote(ctx, "AAPL")
+		require.NoError(t, err)
+		assert.True(t, quote.Price.Equal(stored.Price))
+	})
+
+	t.Run("Release Only By Holder", func(t *testing.T) {
+		require.NoError(t, leases.ReleaseLease(ctx, lease, "a"))
+
+		token, err := leases.AcquireLease(ctx, lease, "a", ttl)
+		require.NoError(t, err)
+		assert.Zero(t, token)
+
+		require.NoError(t, leases.ReleaseLease(ctx, lease, "b"))
+
+		token, err = leases.AcquireLease(ctx, lease, "a", ttl)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), token)
+	})
+}
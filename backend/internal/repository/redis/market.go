@@ -3,19 +3,28 @@ package redis
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 )
 
 const (
 	marketQuotePrefix   = "market"
 	marketChannelPrefix = "market:quote"
+	marketStreamPrefix  = "market:stream"
+	marketStreamSeqKey  = "market:stream-seq"
+
+	// quoteStreamMaxLen caps each symbol's replay stream. Trimming is approximate.
+	quoteStreamMaxLen = 1000
+	// quoteReplayLimit bounds how many missed quotes per symbol are replayed to a reconnecting client.
+	quoteReplayLimit = 500
 )
 
 func marketQuoteKey(symbol string) string {
@@ -26,15 +35,59 @@ func marketQuoteChannel(symbol string) string {
 	return fmt.Sprintf("%s:%s", marketChannelPrefix, symbol)
 }
 
-// ValkeyQuote is used for backward-compatible JSON serialization in Redis.
+func marketQuoteStream(symbol string) string {
+	return fmt.Sprintf("%s:%s", marketStreamPrefix, symbol)
+}
+
+// ValkeyQuote is the JSON encoding of quarantined quotes and of quotes cached before the
+// versioned protobuf encoding. Prices are written as decimal strings; quotes stored as JSON
+// numbers by older versions still decode.
 type ValkeyQuote struct {
-	Symbol        string  `json:"symbol,omitempty"`
-	Price         float64 `json:"price,omitempty"`
-	Change        float64 `json:"change,omitempty"`
-	ChangePercent float64 `json:"change_percent,omitempty"`
-	Timestamp     int64   `json:"timestamp,omitempty"`
-	Source        string  `json:"source,omitempty"`
-	IsClosed      bool    `json:"is_closed,omitempty"`
+	Symbol        string          `json:"symbol,omitempty"`
+	Price         decimal.Decimal `json:"price"`
+	Change        decimal.Decimal `json:"change"`
+	ChangePercent decimal.Decimal `json:"change_percent"`
+	Precision     int32           `json:"precision,omitempty"`
+	Bid           decimal.Decimal `json:"bid"`
+	Ask           decimal.Decimal `json:"ask"`
+	PipSize       decimal.Decimal `json:"pip_size"`
+	Timestamp     int64           `json:"timestamp,omitempty"`
+	Source        string          `json:"source,omitempty"`
+	IsClosed      bool            `json:"is_closed,omitempty"`
+	ID            string          `json:"id,omitempty"`
+}
+
+func newValkeyQuote(quote *domain.Quote) ValkeyQuote {
+	return ValkeyQuote{
+		Symbol:        quote.Symbol,
+		Price:         quote.Price,
+		Change:        quote.Change,
+		ChangePercent: quote.ChangePercent,
+		Precision:     quote.Precision,
+		Bid:           quote.Bid,
+		Ask:           quote.Ask,
+		PipSize:       quote.PipSize,
+		Timestamp:     quote.Timestamp.Unix(),
+		Source:        quote.Source,
+		IsClosed:      domain.CalculateIsClosed(quote.IsClosed, quote.Timestamp),
+	}
+}
+
+func (vq *ValkeyQuote) toDomain() *domain.Quote {
+	return &domain.Quote{
+		Symbol:        vq.Symbol,
+		Price:         vq.Price,
+		Change:        vq.Change,
+		ChangePercent: vq.ChangePercent,
+		Precision:     vq.Precision,
+		Bid:           vq.Bid,
+		Ask:           vq.Ask,
+		PipSize:       vq.PipSize,
+		Timestamp:     time.Unix(vq.Timestamp, 0),
+		Source:        vq.Source,
+		IsClosed:      domain.CalculateIsClosed(vq.IsClosed, time.Unix(vq.Timestamp, 0)),
+		EventID:       vq.ID,
+	}
 }
 
 // MarketRepository handles market data storage in Redis.
@@ -55,56 +108,196 @@ func (r *MarketRepository) GetQuote(ctx context.Context, symbol string) (*domain
 		return nil, err
 	}
 
-	var vq ValkeyQuote
-	if err := json.Unmarshal([]byte(val), &vq); err != nil {
+	return decodeQuote([]byte(val))
+}
+
+// GetQuotes retrieves the latest quotes of several symbols with a single MGET, keyed by
+// symbol. Symbols without a cached quote are left out.
+func (r *MarketRepository) GetQuotes(ctx context.Context, symbols []string) (map[string]*domain.Quote, error) {
+	quotes := make(map[string]*domain.Quote, len(symbols))
+	if len(symbols) == 0 {
+		return quotes, nil
+	}
+
+	keys := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		keys[i] = marketQuoteKey(symbol)
+	}
+
+	vals, err := r.valkey.MGet(ctx, keys...).Result()
+	if err != nil {
 		return nil, err
 	}
 
-	isClosed := domain.CalculateIsClosed(vq.IsClosed, time.Unix(vq.Timestamp, 0))
+	for i, val := range vals {
+		payload, ok := val.(string)
+		if !ok {
+			continue
+		}
+		quote, errDecode := decodeQuote([]byte(payload))
+		if errDecode != nil {
+			return nil, errDecode
+		}
+		quotes[symbols[i]] = quote
+	}
 
-	return &domain.Quote{
-		Symbol:        vq.Symbol,
-		Price:         decimal.NewFromFloat(vq.Price),
-		Change:        decimal.NewFromFloat(vq.Change),
-		ChangePercent: decimal.NewFromFloat(vq.ChangePercent),
-		Timestamp:     time.Unix(vq.Timestamp, 0),
-		Source:        vq.Source,
-		IsClosed:      isClosed,
-	}, nil
+	return quotes, nil
+}
+
+// DecodeQuote decodes a quote published on a market channel.
+func (r *MarketRepository) DecodeQuote(payload string) (*domain.Quote, error) {
+	return decodeQuote([]byte(payload))
 }
 
-// SaveQuote saves a quote to Redis and publishes it to the channel.
+// saveQuoteLuaScript appends a quote to its symbol's replay stream, then stores and publishes
+// it tagged with the stream entry ID, so that live and replayed events share one ID sequence.
+// The quote (ARGV[1]) is a version byte followed by an encoded StreamQuotesResponse, so the
+// ID is tagged on by appending the event_id field (tag 0x12); IDs are shorter than 128 bytes,
+// so their length fits in a single varint byte.
+// Entry IDs are drawn from a sequence shared by all symbols (KEYS[4]) rather than from each
+// stream, so an ID seen on any stream marks the same point in every other one.
+// If a fence key is passed as KEYS[5], nothing is written unless ARGV[3] is still the latest
+// fencing token issued for the writer's lease.
+var saveQuoteLuaScript = redis.NewScript(`
+	if KEYS[5] and redis.call('GET', KEYS[5]) ~= ARGV[3] then
+		return false
+	end
+	local now = redis.call('TIME')
+	local ms = tonumber(now[1]) * 1000 + math.floor(tonumber(now[2]) / 1000)
+	local seq = 0
+	local last = redis.call('GET', KEYS[4])
+	if last then
+		local lastMs, lastSeq = string.match(last, '^(%d+)-(%d+)$')
+		lastMs = tonumber(lastMs)
+		if ms <= lastMs then
+			ms = lastMs
+			seq = tonumber(lastSeq) + 1
+		end
+	end
+	local id = string.format('%d-%d', ms, seq)
+	redis.call('SET', KEYS[4], id)
+	redis.call('XADD', KEYS[3], 'MAXLEN', '~', ARGV[2], id, 'quote', ARGV[1])
+	local tagged = ARGV[1] .. '\18' .. string.char(#id) .. id
+	redis.call('SET', KEYS[1], tagged)
+	redis.call('PUBLISH', KEYS[2], tagged)
+	return id
+`)
+
+// SaveQuote saves a quote to Redis, appends it to the replay stream and publishes it to the channel.
 func (r *MarketRepository) SaveQuote(ctx context.Context, quote *domain.Quote) error {
-	isClosed := domain.CalculateIsClosed(quote.IsClosed, quote.Timestamp)
-	vq := ValkeyQuote{
-		Symbol:        quote.Symbol,
-		Price:         quote.Price.InexactFloat64(),
-		Change:        quote.Change.InexactFloat64(),
-		ChangePercent: quote.ChangePercent.InexactFloat64(),
-		Timestamp:     quote.Timestamp.Unix(),
-		Source:        quote.Source,
-		IsClosed:      isClosed,
+	return r.saveQuote(ctx, quote, nil)
+}
+
+// SaveQuoteFenced is like SaveQuote, but rejects the write with apperrors.ErrStaleFencingToken
+// if a newer holder of fence.Lease has been elected since the token was issued.
+func (r *MarketRepository) SaveQuoteFenced(ctx context.Context, quote *domain.Quote, fence domain.Fence) error {
+	err := r.saveQuote(ctx, quote, &fence)
+	if errors.Is(err, redis.Nil) {
+		return apperrors.ErrStaleFencingToken
 	}
 
-	data, err := json.Marshal(vq)
+	return err
+}
+
+func (r *MarketRepository) saveQuote(ctx context.Context, quote *domain.Quote, fence *domain.Fence) error {
+	data, err := encodeQuote(quote)
 	if err != nil {
 		return err
 	}
 
-	key := marketQuoteKey(quote.Symbol)
-	channel := marketQuoteChannel(quote.Symbol)
+	keys := []string{marketQuoteKey(quote.Symbol), marketQuoteChannel(quote.Symbol), marketQuoteStream(quote.Symbol), marketStreamSeqKey}
+	args := []any{data, quoteStreamMaxLen}
+	if fence != nil {
+		keys = append(keys, leaseFenceKey(fence.Lease))
+		args = append(args, fence.Token)
+	}
+
+	return saveQuoteLuaScript.Run(ctx, r.valkey, keys, args...).Err()
+}
+
+// RestoreQuote stores a quote recovered from history unless a quote is already cached.
+// Nothing is published, since subscribers only expect fresh ticks. It reports whether
+// the quote was written.
+func (r *MarketRepository) RestoreQuote(ctx context.Context, quote *domain.Quote) (bool, error) {
+	data, err := encodeQuote(quote)
+	if err != nil {
+		return false, err
+	}
 
+	return r.valkey.SetNX(ctx, marketQuoteKey(quote.Symbol), data, 0).Result()
+}
+
+// QuotesSince returns the quotes of the given symbols that were appended to their replay
+// streams after the entry lastID, ordered by ID. lastID may come from any symbol's stream.
+func (r *MarketRepository) QuotesSince(ctx context.Context, symbols []string, lastID string) ([]*domain.Quote, error) {
 	pipe := r.valkey.Pipeline()
-	pipe.Set(ctx, key, data, 0)
-	pipe.Publish(ctx, channel, data)
-	_, err = pipe.Exec(ctx)
+	cmds := make([]*redis.XMessageSliceCmd, len(symbols))
+	for i, symbol := range symbols {
+		cmds[i] = pipe.XRangeN(ctx, marketQuoteStream(symbol), "("+lastID, "+", quoteReplayLimit)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
 
-	return err
+	var quotes []*domain.Quote
+	for _, cmd := range cmds {
+		for _, msg := range cmd.Val() {
+			payload, ok := msg.Values["quote"].(string)
+			if !ok {
+				continue
+			}
+			quote, err := decodeQuote([]byte(payload))
+			if err != nil {
+				return nil, err
+			}
+			// Replayed quotes may have gone stale since they were published, so their
+			// payload is not forwarded as is.
+			quote.EventID = msg.ID
+			quote.Payload = nil
+			quotes = append(quotes, quote)
+		}
+	}
+
+	slices.SortFunc(quotes, func(a, b *domain.Quote) int {
+		switch {
+		case domain.QuoteEventIDAfter(a.EventID, b.EventID):
+			return 1
+		case domain.QuoteEventIDAfter(b.EventID, a.EventID):
+			return -1
+		default:
+			return 0
+		}
+	})
+
+	return quotes, nil
+}
+
+// CountSubscribers returns the number of live quote subscriptions per symbol.
+func (r *MarketRepository) CountSubscribers(ctx context.Context, symbols []string) (map[string]int64, error) {
+	channels := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		channels[i] = marketQuoteChannel(symbol)
+	}
+
+	counts, err := r.valkey.PubSubNumSub(ctx, channels...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]int64, len(symbols))
+	for i, symbol := range symbols {
+		res[symbol] = counts[channels[i]]
+	}
+
+	return res, nil
 }
 
-// SubscribeToQuotes subscribes to real-time quote updates for a symbol.
-func (r *MarketRepository) SubscribeToQuotes(ctx context.Context, symbol string) *redis.PubSub {
-	channel := marketQuoteChannel(symbol)
+// SubscribeToQuotes subscribes to real-time quote updates for the given symbols over a single connection.
+func (r *MarketRepository) SubscribeToQuotes(ctx context.Context, symbols ...string) *redis.PubSub {
+	channels := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		channels[i] = marketQuoteChannel(symbol)
+	}
 
-	return r.valkey.Subscribe(ctx, channel)
+	return r.valkey.Subscribe(ctx, channels...)
 }
@@ -2,7 +2,7 @@ package redis_test
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,8 +10,10 @@ import (
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
 	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
 )
 
@@ -52,6 +54,74 @@ func TestMarketRepository(t *testing.T) {
 		assert.False(t, fetched.IsClosed)
 	})
 
+	t.Run("Get Several Quotes At Once", func(t *testing.T) {
+		err := repo.SaveQuote(ctx, &domain.Quote{Symbol: "MSFT", Price: decimal.NewFromInt(410), Timestamp: time.Now()})
+		assert.NoError(t, err)
+
+		quotes, err := repo.GetQuotes(ctx, []string{symbol, "MISSING", "MSFT"})
+		assert.NoError(t, err)
+		assert.Len(t, quotes, 2)
+		assert.True(t, decimal.NewFromFloat(150.25).Equal(quotes[symbol].Price))
+		assert.True(t, decimal.NewFromInt(410).Equal(quotes["MSFT"].Price))
+		assert.NotContains(t, quotes, "MISSING")
+	})
+
+	t.Run("Sub-Cent Price Round Trips Exactly", func(t *testing.T) {
+		quote := &domain.Quote{
+			Symbol:    "shiba-inu",
+			Price:     decimal.RequireFromString("0.00001235"),
+			Precision: 8,
+			Timestamp: time.Now(),
+		}
+
+		err := repo.SaveQuote(ctx, quote)
+		assert.NoError(t, err)
+
+		fetched, err := repo.GetQuote(ctx, "shiba-inu")
+		assert.NoError(t, err)
+		assert.Equal(t, "0.00001235", fetched.Price.String())
+		assert.Equal(t, int32(8), fetched.Precision)
+	})
+
+	t.Run("Two-Sided Quote Round Trips Bid And Ask", func(t *testing.T) {
+		quote := &domain.Quote{
+			Symbol:    "OA:EUR_USD",
+			Price:     decimal.RequireFromString("1.0842"),
+			Bid:       decimal.RequireFromString("1.08410"),
+			Ask:       decimal.RequireFromString("1.08430"),
+			PipSize:   decimal.RequireFromString("0.0001"),
+			Precision: 5,
+			Timestamp: time.Now(),
+		}
+
+		err := repo.SaveQuote(ctx, quote)
+		assert.NoError(t, err)
+
+		fetched, err := repo.GetQuote(ctx, "OA:EUR_USD")
+		assert.NoError(t, err)
+		assert.True(t, fetched.IsTwoSided())
+		assert.Equal(t, "1.0841", fetched.Bid.String())
+		assert.Equal(t, "1.0843", fetched.Ask.String())
+		assert.Equal(t, "0.0001", fetched.PipSize.String())
+	})
+
+	t.Run("Get Quote Stored As JSON Numbers", func(t *testing.T) {
+		legacy := fmt.Sprintf(`{"symbol":"IBM","price":180.45,"change":-1.5,"timestamp":%d}`, time.Now().Unix())
+		mr.Set("market:IBM", legacy)
+
+		fetched, err := repo.GetQuote(ctx, "IBM")
+		assert.NoError(t, err)
+		assert.Equal(t, "180.45", fetched.Price.String())
+		assert.Equal(t, "-1.5", fetched.Change.String())
+	})
+
+	t.Run("Get Quote Of Unknown Payload Version", func(t *testing.T) {
+		mr.Set("market:IBM", "\x07garbage")
+
+		_, err := repo.GetQuote(ctx, "IBM")
+		assert.ErrorIs(t, err, redisRepo.ErrUnknownQuotePayload)
+	})
+
 	t.Run("Get Quote Older Than 30 Minutes Sets IsClosed", func(t *testing.T) {
 		oldTime := time.Now().Add(-35 * time.Minute)
 		quote := &domain.Quote{
@@ -89,11 +159,97 @@ func TestMarketRepository(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotEmpty(t, msg.Payload)
 
-		var received struct {
-			Price float64 `json:"price"`
+		received, err := repo.DecodeQuote(msg.Payload)
+		assert.NoError(t, err)
+		assert.Equal(t, "152", received.Price.String())
+		assert.True(t, domain.IsQuoteEventID(received.EventID))
+
+		// The payload is the published message itself, tagged with the event ID.
+		var published exchange.StreamQuotesResponse
+		assert.NoError(t, proto.Unmarshal(received.Payload, &published))
+		assert.Equal(t, received.EventID, published.GetEventId())
+		assert.Equal(t, symbol, published.GetQuote().GetSymbol())
+	})
+	t.Run("Subscribe to Multiple Quotes", func(t *testing.T) {
+		multi := repo.SubscribeToQuotes(ctx, symbol, "bitcoin")
+		defer func() { _ = multi.Close() }()
+
+		_, err := multi.Receive(ctx)
+		assert.NoError(t, err)
+
+		for _, s := range []string{"MSFT", "bitcoin"} {
+			err := repo.SaveQuote(ctx, &domain.Quote{Symbol: s, Price: decimal.NewFromInt(1), Timestamp: time.Now()})
+			assert.NoError(t, err)
 		}
-		err = json.Unmarshal([]byte(msg.Payload), &received)
+
+		msg, err := multi.ReceiveMessage(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "market:quote:bitcoin", msg.Channel)
+	})
+
+	t.Run("Replay Stream", func(t *testing.T) {
+		save := func(s string, price int64) string {
+			err := repo.SaveQuote(ctx, &domain.Quote{Symbol: s, Price: decimal.NewFromInt(price), Timestamp: time.Now()})
+			assert.NoError(t, err)
+
+			fetched, err := repo.GetQuote(ctx, s)
+			assert.NoError(t, err)
+			assert.True(t, domain.IsQuoteEventID(fetched.EventID))
+
+			return fetched.EventID
+		}
+
+		first := save("NVDA", 100)
+		save("AMD", 200)
+		save("NVDA", 101)
+		last := save("AMD", 201)
+
+		missed, err := repo.QuotesSince(ctx, []string{"NVDA", "AMD"}, first)
+		assert.NoError(t, err)
+		if assert.Len(t, missed, 3) {
+			assert.Equal(t, "AMD", missed[0].Symbol)
+			assert.Equal(t, "NVDA", missed[1].Symbol)
+			assert.True(t, decimal.NewFromInt(101).Equal(missed[1].Price))
+			assert.Equal(t, last, missed[2].EventID)
+		}
+
+		missed, err = repo.QuotesSince(ctx, []string{"NVDA", "AMD", "UNKNOWN"}, last)
+		assert.NoError(t, err)
+		assert.Empty(t, missed)
+	})
+
+	t.Run("Count Subscribers", func(t *testing.T) {
+		pubSub := repo.SubscribeToQuotes(ctx, symbol)
+		defer func() { _ = pubSub.Close() }()
+
+		_, err := pubSub.Receive(ctx)
+		assert.NoError(t, err)
+
+		counts, err := repo.CountSubscribers(ctx, []string{symbol, "MSFT"})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), counts[symbol])
+		assert.Equal(t, int64(0), counts["MSFT"])
+	})
+
+	t.Run("Restore Quote Does Not Overwrite", func(t *testing.T) {
+		restoredAt := time.Now().Add(-2 * time.Hour)
+		restored := &domain.Quote{Symbol: "TSLA", Price: decimal.NewFromInt(250), Timestamp: restoredAt}
+
+		ok, err := repo.RestoreQuote(ctx, restored)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		fetched, err := repo.GetQuote(ctx, "TSLA")
+		assert.NoError(t, err)
+		assert.Equal(t, restoredAt.Unix(), fetched.Timestamp.Unix())
+		assert.True(t, fetched.IsClosed, "restored quotes keep their age")
+
+		ok, err = repo.RestoreQuote(ctx, &domain.Quote{Symbol: symbol, Price: decimal.NewFromInt(1), Timestamp: restoredAt})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		fetched, err = repo.GetQuote(ctx, symbol)
 		assert.NoError(t, err)
-		assert.Equal(t, 152.00, received.Price)
+		assert.True(t, decimal.NewFromFloat(152.00).Equal(fetched.Price))
 	})
 }
@@ -0,0 +1,138 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const (
+	quarantineKey = "quarantine:market"
+	// maxQuarantined bounds the hash so a feed stuck on bad data cannot grow it forever.
+	maxQuarantined = 1000
+)
+
+// quarantineLuaScript stores an entry unless the same quote is already held or the hash is
+// full, in which case it returns -1.
+var quarantineLuaScript = redis.NewScript(`
+	if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 1 then
+		return 0
+	end
+	if redis.call('HLEN', KEYS[1]) >= tonumber(ARGV[3]) then
+		return -1
+	end
+	return redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+`)
+
+type valkeyQuarantinedQuote struct {
+	ID            string      `json:"id"`
+	Quote         ValkeyQuote `json:"quote"`
+	Reason        string      `json:"reason"`
+	Detail        string      `json:"detail,omitempty"`
+	QuarantinedAt int64       `json:"quarantined_at"`
+}
+
+func (vq *valkeyQuarantinedQuote) toDomain() *domain.QuarantinedQuote {
+	return &domain.QuarantinedQuote{
+		ID:            vq.ID,
+		Quote:         *vq.Quote.toDomain(),
+		Reason:        vq.Reason,
+		Detail:        vq.Detail,
+		QuarantinedAt: time.UnixMilli(vq.QuarantinedAt),
+	}
+}
+
+// QuarantineRepository keeps quotes that failed validation in a Valkey hash until they are reviewed.
+type QuarantineRepository struct {
+	valkey *redis.Client
+}
+
+// NewQuarantineRepository creates a new instance of QuarantineRepository.
+func NewQuarantineRepository(valkey *redis.Client) *QuarantineRepository {
+	return &QuarantineRepository{valkey: valkey}
+}
+
+// Quarantine stores a suspicious quote. An empty ID is derived from the quote's symbol and
+// timestamp, so a feed repeating the same bad quote only produces one entry. Once
+// maxQuarantined entries await review, new ones are rejected with ErrQuarantineFull.
+func (r *QuarantineRepository) Quarantine(ctx context.Context, entry *domain.QuarantinedQuote) error {
+	if entry.ID == "" {
+		entry.ID = fmt.Sprintf("%s@%d", entry.Quote.Symbol, entry.Quote.Timestamp.UnixMilli())
+	}
+
+	data, err := json.Marshal(valkeyQuarantinedQuote{
+		ID:            entry.ID,
+		Quote:         newValkeyQuote(&entry.Quote),
+		Reason:        entry.Reason,
+		Detail:        entry.Detail,
+		QuarantinedAt: entry.QuarantinedAt.UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := quarantineLuaScript.Run(ctx, r.valkey, []string{quarantineKey}, entry.ID, data, maxQuarantined).Int()
+	if err != nil {
+		return err
+	}
+	if res < 0 {
+		return apperrors.ErrQuarantineFull
+	}
+
+	return nil
+}
+
+// ListQuarantined returns all quarantined quotes, oldest first.
+func (r *QuarantineRepository) ListQuarantined(ctx context.Context) ([]*domain.QuarantinedQuote, error) {
+	vals, err := r.valkey.HVals(ctx, quarantineKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domain.QuarantinedQuote, 0, len(vals))
+	for _, val := range vals {
+		var vq valkeyQuarantinedQuote
+		if err := json.Unmarshal([]byte(val), &vq); err != nil {
+			return nil, err
+		}
+		entries = append(entries, vq.toDomain())
+	}
+
+	slices.SortFunc(entries, func(a, b *domain.QuarantinedQuote) int {
+		return a.QuarantinedAt.Compare(b.QuarantinedAt)
+	})
+
+	return entries, nil
+}
+
+// TakeQuarantined atomically removes a quarantined quote and returns it.
+func (r *QuarantineRepository) TakeQuarantined(ctx context.Context, id string) (*domain.QuarantinedQuote, error) {
+	var get *redis.StringCmd
+	_, err := r.valkey.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.HGet(ctx, quarantineKey, id)
+		pipe.HDel(ctx, quarantineKey, id)
+
+		return nil
+	})
+	if errors.Is(err, redis.Nil) {
+		return nil, apperrors.ErrQuoteNotQuarantined
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var vq valkeyQuarantinedQuote
+	if err := json.Unmarshal([]byte(get.Val()), &vq); err != nil {
+		return nil, err
+	}
+
+	return vq.toDomain(), nil
+}
@@ -0,0 +1,118 @@
+package redis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+)
+
+func TestQuarantineRepository(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	rClient := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer func() { _ = rClient.Close() }()
+
+	repo := redisRepo.NewQuarantineRepository(rClient)
+	ctx := context.Background()
+	now := time.Now()
+
+	first := &domain.QuarantinedQuote{
+		Quote:         domain.Quote{Symbol: "AAPL", Price: decimal.NewFromFloat(1900.5), Timestamp: now},
+		Reason:        domain.QuarantineReasonDeviation,
+		Detail:        "deviates 900% from median 190.00",
+		QuarantinedAt: now.Add(-time.Minute),
+	}
+	second := &domain.QuarantinedQuote{
+		Quote:         domain.Quote{Symbol: "bitcoin", Price: decimal.NewFromInt(65000), Timestamp: now.Add(-time.Hour)},
+		Reason:        domain.QuarantineReasonStale,
+		QuarantinedAt: now,
+	}
+
+	t.Run("Quarantine and List", func(t *testing.T) {
+		require.NoError(t, repo.Quarantine(ctx, second))
+		require.NoError(t, repo.Quarantine(ctx, first))
+		assert.NotEmpty(t, first.ID)
+		assert.NotEqual(t, first.ID, second.ID)
+
+		entries, err := repo.ListQuarantined(ctx)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, first.ID, entries[0].ID)
+		assert.Equal(t, "AAPL", entries[0].Quote.Symbol)
+		assert.True(t, decimal.NewFromFloat(1900.5).Equal(entries[0].Quote.Price))
+		assert.Equal(t, first.Detail, entries[0].Detail)
+		assert.Equal(t, second.ID, entries[1].ID)
+	})
+
+	t.Run("Repeated Quote Is Stored Once", func(t *testing.T) {
+		repeat := &domain.QuarantinedQuote{
+			Quote:         first.Quote,
+			Reason:        domain.QuarantineReasonDeviation,
+			QuarantinedAt: now.Add(time.Minute),
+		}
+		require.NoError(t, repo.Quarantine(ctx, repeat))
+		assert.Equal(t, first.ID, repeat.ID)
+
+		entries, err := repo.ListQuarantined(ctx)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		assert.Equal(t, first.Detail, entries[0].Detail)
+	})
+
+	t.Run("Take Removes Entry", func(t *testing.T) {
+		taken, err := repo.TakeQuarantined(ctx, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.QuarantineReasonDeviation, taken.Reason)
+
+		_, err = repo.TakeQuarantined(ctx, first.ID)
+		assert.ErrorIs(t, err, apperrors.ErrQuoteNotQuarantined)
+
+		entries, err := repo.ListQuarantined(ctx)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+}
+
+func TestQuarantineRepository_Full(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	rClient := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer func() { _ = rClient.Close() }()
+
+	repo := redisRepo.NewQuarantineRepository(rClient)
+	ctx := context.Background()
+
+	// The quarantine holds at most 1000 entries.
+	for i := range 1000 {
+		require.NoError(t, repo.Quarantine(ctx, &domain.QuarantinedQuote{ID: fmt.Sprint(i)}))
+	}
+
+	err = repo.Quarantine(ctx, &domain.QuarantinedQuote{ID: "overflow"})
+	assert.ErrorIs(t, err, apperrors.ErrQuarantineFull)
+
+	err = repo.Quarantine(ctx, &domain.QuarantinedQuote{ID: "0"})
+	assert.NoError(t, err, "a quote already held is not dropped")
+}
@@ -0,0 +1,123 @@
+package redis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
+)
+
+// quotePayloadV1 prefixes quotes encoded as a protobuf exchange.StreamQuotesResponse.
+// Payloads written before versioning are JSON ValkeyQuotes and start with '{'.
+const quotePayloadV1 byte = 1
+
+// ErrUnknownQuotePayload is returned for cached quotes of an unknown encoding version.
+var ErrUnknownQuotePayload = errors.New("unknown quote payload version")
+
+// encodeQuote encodes a quote for the quote cache, channel and replay stream.
+func encodeQuote(quote *domain.Quote) ([]byte, error) {
+	price := quote.Price.String()
+	if quote.Precision > 0 {
+		price = quote.Price.StringFixed(quote.Precision)
+	}
+
+	data, err := proto.Marshal(&exchange.StreamQuotesResponse{
+		Quote: &exchange.Quote{
+			Symbol:         quote.Symbol,
+			Price:          quote.Price.InexactFloat64(),
+			Change:         quote.Change.InexactFloat64(),
+			ChangePercent:  quote.ChangePercent.InexactFloat64(),
+			Timestamp:      timestamppb.New(quote.Timestamp),
+			Source:         quote.Source,
+			IsClosed:       domain.CalculateIsClosed(quote.IsClosed, quote.Timestamp),
+			PriceDecimal:   price,
+			PricePrecision: quote.Precision,
+			Bid:            decimalString(quote.Bid),
+			Ask:            decimalString(quote.Ask),
+			PipSize:        decimalString(quote.PipSize),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{quotePayloadV1}, data...), nil
+}
+
+// decodeQuote decodes a cached quote of any encoding version. Protobuf quotes keep their
+// encoded message as the quote's payload, so that streams can forward it as is.
+func decodeQuote(payload []byte) (*domain.Quote, error) {
+	if len(payload) == 0 {
+		return nil, ErrUnknownQuotePayload
+	}
+
+	switch payload[0] {
+	case '{':
+		var vq ValkeyQuote
+		if err := json.Unmarshal(payload, &vq); err != nil {
+			return nil, err
+		}
+
+		return vq.toDomain(), nil
+	case quotePayloadV1:
+		var msg exchange.StreamQuotesResponse
+		if err := proto.Unmarshal(payload[1:], &msg); err != nil {
+			return nil, err
+		}
+
+		quote := quoteFromProto(msg.GetQuote())
+		quote.EventID = msg.GetEventId()
+		quote.Payload = payload[1:]
+
+		return quote, nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownQuotePayload, payload[0])
+	}
+}
+
+// decimalString formats optional decimal quote fields, which are empty when zero.
+func decimalString(d decimal.Decimal) string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return d.String()
+}
+
+// parseDecimalString parses optional decimal quote fields, see decimalString.
+func parseDecimalString(s string) decimal.Decimal {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero
+	}
+
+	return d
+}
+
+func quoteFromProto(q *exchange.Quote) *domain.Quote {
+	price, err := decimal.NewFromString(q.GetPriceDecimal())
+	if err != nil {
+		price = decimal.NewFromFloat(q.GetPrice())
+	}
+	ts := q.GetTimestamp().AsTime()
+
+	return &domain.Quote{
+		Symbol:        q.GetSymbol(),
+		Price:         price,
+		Change:        decimal.NewFromFloat(q.GetChange()),
+		ChangePercent: decimal.NewFromFloat(q.GetChangePercent()),
+		Precision:     q.GetPricePrecision(),
+		Bid:           parseDecimalString(q.GetBid()),
+		Ask:           parseDecimalString(q.GetAsk()),
+		PipSize:       parseDecimalString(q.GetPipSize()),
+		Timestamp:     ts,
+		Source:        q.GetSource(),
+		IsClosed:      domain.CalculateIsClosed(q.GetIsClosed(), ts),
+	}
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const (
+	// maxBackfillRetries bounds how often a rate limited window is retried before the
+	// backfill of its symbol is abandoned.
+	maxBackfillRetries = 3
+	// defaultBackfillRetryDelay is the wait after a rate limit without a Retry-After.
+	defaultBackfillRetryDelay = time.Minute
+)
+
+// CandleProvider fetches historical candles from a market data provider.
+type CandleProvider interface {
+	GetCandles(ctx context.Context, symbol string, resolution time.Duration, from, to time.Time) ([]*domain.Candle, error)
+	// CandleWindow is the longest range a single GetCandles request may cover at the resolution.
+	CandleWindow(resolution time.Duration) time.Duration
+}
+
+// BackfillRepository stores backfilled candles along with the progress of each backfill.
+type BackfillRepository interface {
+	// GetBackfillCheckpoint returns how far a backfill has been loaded, or the zero time
+	// if it has not started.
+	GetBackfillCheckpoint(ctx context.Context, symbol string, resolution time.Duration, rangeStart time.Time) (time.Time, error)
+	// LoadCandles stores the close of each candle as a quote at the candle's end, skipping
+	// quotes already stored, and saves the checkpoint in the same transaction. It returns
+	// the number of quotes stored.
+	LoadCandles(ctx context.Context, candles []*domain.Candle, checkpoint *domain.BackfillCheckpoint) (int64, error)
+}
+
+// Backfill loads historical candles from provider history endpoints into the quote history.
+type Backfill struct {
+	repo      BackfillRepository
+	providers map[string]CandleProvider
+	pause     time.Duration
+}
+
+// NewBackfill creates a new instance of Backfill. Providers are keyed by ticker source,
+// and pause is the delay between two provider requests.
+func NewBackfill(repo BackfillRepository, providers map[string]CandleProvider, pause time.Duration) *Backfill {
+	return &Backfill{
+		repo:      repo,
+		providers: providers,
+		pause:     pause,
+	}
+}
+
+// Run backfills the tickers over [from, to) one provider window at a time. Each window is
+// stored together with a checkpoint, so running the same backfill again resumes where it
+// stopped. Candles that have not closed by the time they are fetched are left to the live
+// fetcher. Tickers that fail are logged and skipped. It returns the number of quotes stored.
+func (s *Backfill) Run(
+	ctx context.Context,
+	tickers []*domain.TickerInfo,
+	resolution time.Duration,
+	from, to time.Time,
+) (int64, error) {
+	var stored int64
+	for _, ticker := range tickers {
+		provider, ok := s.providers[ticker.Source]
+		if !ok {
+			log.Printf("[Backfill] No candle provider for %s (source %s)", ticker.Symbol, ticker.Source)
+
+			continue
+		}
+
+		n, err := s.backfillTicker(ctx, provider, ticker, resolution, from, to)
+		stored += n
+		if err != nil {
+			return stored, err
+		}
+		log.Printf("[Backfill] %s: %d quotes stored", ticker.Symbol, n)
+	}
+
+	return stored, nil
+}
+
+func (s *Backfill) backfillTicker(
+	ctx context.Context,
+	provider CandleProvider,
+	ticker *domain.TickerInfo,
+	resolution time.Duration,
+	from, to time.Time,
+) (int64, error) {
+	loadedUntil, err := s.repo.GetBackfillCheckpoint(ctx, ticker.Symbol, resolution, from)
+	if err != nil {
+		return 0, err
+	}
+
+	// Windows are aligned to the resolution so that no candle is split between two requests.
+	start := from.Truncate(resolution)
+	if loadedUntil.After(start) {
+		log.Printf("[Backfill] Resuming %s from %s", ticker.Symbol, loadedUntil.Format(time.RFC3339))
+		start = loadedUntil
+	}
+
+	window := provider.CandleWindow(resolution).Truncate(resolution)
+	if window < resolution {
+		window = resolution
+	}
+
+	var stored int64
+	for start.Before(to) {
+		end := start.Add(window)
+		if end.After(to) {
+			end = to
+		}
+
+		candles, err := s.fetchWindow(ctx, provider, ticker.Symbol, resolution, start, end)
+		if err != nil {
+			if ctx.Err() != nil {
+				return stored, ctx.Err()
+			}
+			log.Printf("[Backfill] Stopped backfilling %s at %s: %v", ticker.Symbol, start.Format(time.RFC3339), err)
+
+			return stored, nil
+		}
+
+		// The last candle may still be forming; it is fetched again once it has closed.
+		now := time.Now()
+		loaded := end
+		closed := candles[:0]
+		for _, c := range candles {
+			if c.End().After(now) {
+				if c.Start.Before(loaded) {
+					loaded = c.Start
+				}
+
+				continue
+			}
+			closed = append(closed, c)
+		}
+
+		n, err := s.repo.LoadCandles(ctx, closed, &domain.BackfillCheckpoint{
+			Symbol:      ticker.Symbol,
+			Source:      ticker.Source,
+			Resolution:  resolution,
+			RangeStart:  from,
+			LoadedUntil: loaded,
+		})
+		if err != nil {
+			return stored, err
+		}
+		stored += n
+
+		if loaded.Before(end) {
+			break
+		}
+		start = end
+	}
+
+	return stored, nil
+}
+
+// fetchWindow requests one window of candles, waiting out rate limits.
+func (s *Backfill) fetchWindow(
+	ctx context.Context,
+	provider CandleProvider,
+	symbol string,
+	resolution time.Duration,
+	from, to time.Time,
+) ([]*domain.Candle, error) {
+	for attempt := 0; ; attempt++ {
+		if err := sleepContext(ctx, s.pause); err != nil {
+			return nil, err
+		}
+
+		candles, err := provider.GetCandles(ctx, symbol, resolution, from, to)
+		if err == nil || !errors.Is(err, clients.ErrRateLimited) || attempt == maxBackfillRetries {
+			return candles, err
+		}
+
+		delay := defaultBackfillRetryDelay
+		var rlErr *clients.RateLimitError
+		if errors.As(err, &rlErr) && rlErr.RetryAfter > 0 {
+			delay = rlErr.RetryAfter
+		}
+		log.Printf("[Backfill] Rate limited while fetching %s, retrying in %s", symbol, delay)
+
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+func dailyCandles(symbol string, from time.Time, days int) []*domain.Candle {
+	candles := make([]*domain.Candle, days)
+	for i := range candles {
+		candles[i] = &domain.Candle{
+			Symbol:     symbol,
+			Start:      from.AddDate(0, 0, i),
+			Resolution: 24 * time.Hour,
+			Close:      decimal.NewFromInt(int64(100 + i)),
+		}
+	}
+
+	return candles
+}
+
+func loadedUntil(until time.Time) any {
+	return mock.MatchedBy(func(c *domain.BackfillCheckpoint) bool {
+		return c.LoadedUntil.Equal(until)
+	})
+}
+
+func TestBackfillService_Run(t *testing.T) {
+	ctx := context.Background()
+	day := 24 * time.Hour
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 3)
+	aapl := []*domain.TickerInfo{{Symbol: "AAPL", Source: "Finnhub"}}
+
+	t.Run("LoadsWindowsWithCheckpoints", func(t *testing.T) {
+		repo := new(mocks.MockBackfillRepository)
+		provider := new(mocks.MockCandleProvider)
+		provider.On("CandleWindow", day).Return(2 * day)
+		repo.On("GetBackfillCheckpoint", ctx, "AAPL", day, from).Return(time.Time{}, nil)
+
+		first, second := dailyCandles("AAPL", from, 2), dailyCandles("AAPL", from.AddDate(0, 0, 2), 1)
+		provider.On("GetCandles", ctx, "AAPL", day, from, from.AddDate(0, 0, 2)).Return(first, nil)
+		provider.On("GetCandles", ctx, "AAPL", day, from.AddDate(0, 0, 2), to).Return(second, nil)
+		repo.On("LoadCandles", ctx, first, loadedUntil(from.AddDate(0, 0, 2))).Return(int64(2), nil)
+		repo.On("LoadCandles", ctx, second, loadedUntil(to)).Return(int64(1), nil)
+
+		backfill := service.NewBackfill(repo, map[string]service.CandleProvider{"Finnhub": provider}, 0)
+		stored, err := backfill.Run(ctx, aapl, day, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), stored)
+		provider.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ResumesFromCheckpoint", func(t *testing.T) {
+		repo := new(mocks.MockBackfillRepository)
+		provider := new(mocks.MockCandleProvider)
+		resume := from.AddDate(0, 0, 2)
+		provider.On("CandleWindow", day).Return(2 * day)
+		repo.On("GetBackfillCheckpoint", ctx, "AAPL", day, from).Return(resume, nil)
+
+		candles := dailyCandles("AAPL", resume, 1)
+		provider.On("GetCandles", ctx, "AAPL", day, resume, to).Return(candles, nil).Once()
+		repo.On("LoadCandles", ctx, candles, loadedUntil(to)).Return(int64(1), nil)
+
+		backfill := service.NewBackfill(repo, map[string]service.CandleProvider{"Finnhub": provider}, 0)
+		stored, err := backfill.Run(ctx, aapl, day, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), stored)
+		provider.AssertExpectations(t)
+	})
+
+	t.Run("LeavesFormingCandle", func(t *testing.T) {
+		repo := new(mocks.MockBackfillRepository)
+		provider := new(mocks.MockCandleProvider)
+		current := time.Now().Truncate(time.Hour)
+		start := current.Add(-2 * time.Hour)
+		provider.On("CandleWindow", time.Hour).Return(day)
+		repo.On("GetBackfillCheckpoint", ctx, "AAPL", time.Hour, start).Return(time.Time{}, nil)
+
+		candles := make([]*domain.Candle, 3)
+		for i := range candles {
+			candles[i] = &domain.Candle{Symbol: "AAPL", Start: start.Add(time.Duration(i) * time.Hour), Resolution: time.Hour}
+		}
+		provider.On("GetCandles", ctx, "AAPL", time.Hour, start, mock.Anything).Return(candles, nil).Once()
+		repo.On("LoadCandles", ctx, mock.MatchedBy(func(c []*domain.Candle) bool {
+			return len(c) == 2
+		}), loadedUntil(current)).Return(int64(2), nil)
+
+		backfill := service.NewBackfill(repo, map[string]service.CandleProvider{"Finnhub": provider}, 0)
+		stored, err := backfill.Run(ctx, aapl, time.Hour, start, time.Now())
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), stored)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("RetriesRateLimitsAndSkipsFailingTickers", func(t *testing.T) {
+		repo := new(mocks.MockBackfillRepository)
+		provider := new(mocks.MockCandleProvider)
+		provider.On("CandleWindow", day).Return(7 * day)
+		repo.On("GetBackfillCheckpoint", ctx, mock.Anything, day, from).Return(time.Time{}, nil)
+
+		provider.On("GetCandles", ctx, "MSFT", day, from, to).Return(nil, errors.New("API status: 403")).Once()
+		provider.On("GetCandles", ctx, "AAPL", day, from, to).
+			Return(nil, &clients.RateLimitError{RetryAfter: time.Millisecond}).Once()
+		candles := dailyCandles("AAPL", from, 3)
+		provider.On("GetCandles", ctx, "AAPL", day, from, to).Return(candles, nil).Once()
+		repo.On("LoadCandles", ctx, candles, loadedUntil(to)).Return(int64(3), nil)
+
+		backfill := service.NewBackfill(repo, map[string]service.CandleProvider{"Finnhub": provider}, 0)
+		stored, err := backfill.Run(ctx, []*domain.TickerInfo{
+			{Symbol: "MSFT", Source: "Finnhub"},
+			{Symbol: "bitcoin", Source: "CoinGecko"},
+			{Symbol: "AAPL", Source: "Finnhub"},
+		}, day, from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), stored)
+		provider.AssertExpectations(t)
+		repo.AssertNumberOfCalls(t, "LoadCandles", 1)
+	})
+
+	t.Run("StopsOnRepositoryError", func(t *testing.T) {
+		repo := new(mocks.MockBackfillRepository)
+		provider := new(mocks.MockCandleProvider)
+		provider.On("CandleWindow", day).Return(7 * day)
+		repo.On("GetBackfillCheckpoint", ctx, "AAPL", day, from).Return(time.Time{}, nil)
+		provider.On("GetCandles", ctx, "AAPL", day, from, to).Return(dailyCandles("AAPL", from, 3), nil)
+		repo.On("LoadCandles", ctx, mock.Anything, mock.Anything).Return(int64(0), errors.New("db down"))
+
+		backfill := service.NewBackfill(repo, map[string]service.CandleProvider{"Finnhub": provider}, 0)
+		_, err := backfill.Run(ctx, aapl, day, from, to)
+
+		assert.EqualError(t, err, "db down")
+	})
+}
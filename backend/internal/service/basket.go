@@ -0,0 +1,446 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const (
+	minBasketComponents = 2
+	maxBasketComponents = 20
+	// maxIndicesPerUser is the number of custom indices a player can publish.
+	maxIndicesPerUser = 5
+	// basketPrecision is the number of decimal places basket prices are quoted to.
+	basketPrecision = 2
+	// basketUnitsPlaces is the number of decimal places component units are kept to.
+	basketUnitsPlaces = 12
+)
+
+// basketBaseValue is the price of a basket at creation.
+var basketBaseValue = decimal.NewFromInt(100)
+
+// basketPrefix is the prefix of basket instrument IDs.
+var basketPrefix = domain.InstrumentID(domain.SourceBasket, "")
+
+// basketSymbolPattern matches the provider symbol of a basket, e.g. "TR-TECH5".
+var basketSymbolPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9-]{1,19}$`)
+
+// BasketRepository defines the interface for basket persistence. Creating a basket also
+// lists it in the instrument catalogue.
+type BasketRepository interface {
+	CreateBasket(ctx context.Context, basket *domain.Basket) error
+	GetBasket(ctx context.Context, symbol string) (*domain.Basket, error)
+	ListBaskets(ctx context.Context) ([]*domain.Basket, error)
+	ListIndices(ctx context.Context, offset, limit int) ([]*domain.Basket, error)
+	DeleteBasket(ctx context.Context, symbol string) error
+	CountBasketsByOwner(ctx context.Context, ownerID int64) (int64, error)
+	RebalanceBasket(ctx context.Context, basket *domain.Basket) error
+	FollowBasket(ctx context.Context, symbol string, userID int64) error
+	UnfollowBasket(ctx context.Context, symbol string, userID int64) error
+	ListBasketFollowers(ctx context.Context, symbol string) ([]string, error)
+	GetInstrumentCurrencies(ctx context.Context, symbols []string) (map[string]string, error)
+}
+
+// Basket manages composite instruments and prices them from their components' quotes.
+// Components must be quoted in USD, the currency baskets are quoted in. Baskets
+// published by players are custom indices, which anyone can view and follow.
+type Basket struct {
+	basketRepo  BasketRepository
+	marketRepo  MarketRepository
+	historyRepo HistoryRepository
+	ladderRepo  LadderRepository
+}
+
+// NewBasket creates a new instance of Basket.
+func NewBasket(
+	basketRepo BasketRepository,
+	marketRepo MarketRepository,
+	historyRepo HistoryRepository,
+	ladderRepo LadderRepository,
+) *Basket {
+	return &Basket{
+		basketRepo:  basketRepo,
+		marketRepo:  marketRepo,
+		historyRepo: historyRepo,
+		ladderRepo:  ladderRepo,
+	}
+}
+
+// Create defines a basket of the given components. Components without weights are weighted
+// equally; otherwise the weights are normalized to sum to 1. The units of each component
+// are fixed at its current price so that the basket starts at a price of 100.
+func (s *Basket) Create(
+	ctx context.Context,
+	symbol, name string,
+	components []domain.BasketComponent,
+) (*domain.Basket, error) {
+	return s.create(ctx, &domain.Basket{Name: name, Rebalance: domain.RebalanceNone}, symbol, components)
+}
+
+// Publish defines a custom index owned by a player. Its components must be traded in the
+// active ladder, whose quotes are kept current, and its weights are restored on the given
+// rebalance schedule.
+func (s *Basket) Publish(
+	ctx context.Context,
+	ownerID int64,
+	symbol, name, rebalance string,
+	components []domain.BasketComponent,
+) (*domain.Basket, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, apperrors.ErrIndexNameRequired
+	}
+
+	if rebalance == "" {
+		rebalance = domain.RebalanceNone
+	}
+	switch rebalance {
+	case domain.RebalanceNone, domain.RebalanceWeekly, domain.RebalanceMonthly, domain.RebalanceQuarterly:
+	default:
+		return nil, apperrors.ErrInvalidRebalanceSchedule
+	}
+
+	published, err := s.basketRepo.CountBasketsByOwner(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if published >= maxIndicesPerUser {
+		return nil, apperrors.ErrIndexLimitReached
+	}
+
+	if err = s.checkListed(ctx, components); err != nil {
+		return nil, err
+	}
+
+	return s.create(ctx, &domain.Basket{Name: name, OwnerID: ownerID, Rebalance: rebalance}, symbol, components)
+}
+
+// checkListed ensures that every component is traded in the active ladder.
+func (s *Basket) checkListed(ctx context.Context, components []domain.BasketComponent) error {
+	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return err
+	}
+
+	tickers, err := s.ladderRepo.GetAllowedTickers(ctx, ladderID)
+	if err != nil {
+		return err
+	}
+
+	listed := make(map[string]bool, len(tickers))
+	for _, t := range tickers {
+		listed[t.Symbol] = true
+	}
+
+	for _, c := range components {
+		if !listed[c.Symbol] {
+			return fmt.Errorf("%w: %s", apperrors.ErrIndexComponentNotListed, c.Symbol)
+		}
+	}
+
+	return nil
+}
+
+// create completes and stores a basket of the given components.
+func (s *Basket) create(
+	ctx context.Context,
+	basket *domain.Basket,
+	symbol string,
+	components []domain.BasketComponent,
+) (*domain.Basket, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(domain.ProviderSymbol(symbol)))
+	if !basketSymbolPattern.MatchString(symbol) {
+		return nil, apperrors.ErrInvalidBasketSymbol
+	}
+
+	weights, err := normalizeBasketWeights(components)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.checkCurrencies(ctx, components); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	basket.Symbol = domain.InstrumentID(domain.SourceBasket, symbol)
+	basket.Name = strings.TrimSpace(basket.Name)
+	basket.BaseValue = basketBaseValue
+	basket.Components = make([]domain.BasketComponent, len(components))
+	basket.RebalancedAt = now
+	basket.CreatedAt = now
+	if basket.Name == "" {
+		basket.Name = symbol
+	}
+
+	for i, c := range components {
+		quote, errQuote := s.marketRepo.GetQuote(ctx, c.Symbol)
+		if errQuote != nil || !quote.Price.IsPositive() {
+			return nil, fmt.Errorf("%w: %s", apperrors.ErrBasketComponentUnpriced, c.Symbol)
+		}
+
+		basket.Components[i] = domain.BasketComponent{
+			Symbol: c.Symbol,
+			Weight: weights[i],
+			Units:  basketBaseValue.Mul(weights[i]).DivRound(quote.Price, basketUnitsPlaces),
+		}
+	}
+
+	if err = s.basketRepo.CreateBasket(ctx, basket); err != nil {
+		return nil, err
+	}
+
+	return basket, nil
+}
+
+// checkCurrencies ensures that every component is quoted in USD, so that the basket price
+// does not add up amounts of different currencies. Uncatalogued components count as USD.
+func (s *Basket) checkCurrencies(ctx context.Context, components []domain.BasketComponent) error {
+	symbols := make([]string, len(components))
+	for i, c := range components {
+		symbols[i] = c.Symbol
+	}
+
+	currencies, err := s.basketRepo.GetInstrumentCurrencies(ctx, symbols)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range components {
+		ticker := domain.TickerInfo{Symbol: c.Symbol, Currency: currencies[c.Symbol]}
+		if ticker.PriceCurrency() != domain.CurrencyUSD {
+			return fmt.Errorf("%w: %s", apperrors.ErrBasketComponentCurrency, c.Symbol)
+		}
+	}
+
+	return nil
+}
+
+// normalizeBasketWeights validates the components and returns their weights scaled to sum to 1.
+func normalizeBasketWeights(components []domain.BasketComponent) ([]decimal.Decimal, error) {
+	if len(components) < minBasketComponents || len(components) > maxBasketComponents {
+		return nil, apperrors.ErrInvalidBasketComponents
+	}
+
+	seen := make(map[string]bool, len(components))
+	weighted := components[0].Weight.IsPositive()
+	total := decimal.Zero
+	for _, c := range components {
+		// Components are instrument IDs of other sources; baskets do not nest.
+		if !strings.Contains(c.Symbol, ":") || seen[c.Symbol] || strings.HasPrefix(c.Symbol, basketPrefix) {
+			return nil, apperrors.ErrInvalidBasketComponents
+		}
+		seen[c.Symbol] = true
+
+		if c.Weight.IsNegative() || c.Weight.IsPositive() != weighted {
+			return nil, apperrors.ErrInvalidBasketComponents
+		}
+		total = total.Add(c.Weight)
+	}
+
+	weights := make([]decimal.Decimal, len(components))
+	for i, c := range components {
+		if weighted {
+			weights[i] = c.Weight.Div(total)
+		} else {
+			weights[i] = decimal.NewFromInt(1).Div(decimal.NewFromInt(int64(len(components))))
+		}
+	}
+
+	return weights, nil
+}
+
+// List returns all baskets in symbol order.
+func (s *Basket) List(ctx context.Context) ([]*domain.Basket, error) {
+	return s.basketRepo.ListBaskets(ctx)
+}
+
+// Delete removes a basket that no ladder lists.
+func (s *Basket) Delete(ctx context.Context, symbol string) error {
+	return s.basketRepo.DeleteBasket(ctx, symbol)
+}
+
+// Rebalance resets the units of the components so that the basket's current value is split
+// by the original weights again.
+func (s *Basket) Rebalance(ctx context.Context, basket *domain.Basket, now time.Time) error {
+	prices := make([]decimal.Decimal, len(basket.Components))
+	value := decimal.Zero
+	for i, c := range basket.Components {
+		quote, err := s.marketRepo.GetQuote(ctx, c.Symbol)
+		if err != nil || !quote.Price.IsPositive() {
+			return fmt.Errorf("%w: %s", apperrors.ErrBasketComponentUnpriced, c.Symbol)
+		}
+
+		prices[i] = quote.Price
+		value = value.Add(c.Units.Mul(quote.Price))
+	}
+
+	for i := range basket.Components {
+		c := &basket.Components[i]
+		c.Units = value.Mul(c.Weight).DivRound(prices[i], basketUnitsPlaces)
+	}
+	basket.RebalancedAt = now
+
+	return s.basketRepo.RebalanceBasket(ctx, basket)
+}
+
+// maxIndicesPage is the largest number of custom indices ListIndices returns at once.
+const maxIndicesPage = 100
+
+// ListIndices returns a page of the custom indices published by players with their
+// current performance, in symbol order. A limit outside 1..maxIndicesPage returns a full
+// page. The indices are priced from the quotes the basket worker publishes for them, read
+// at once; an index it has not priced yet is listed without a quote.
+func (s *Basket) ListIndices(ctx context.Context, offset, limit int) ([]*domain.IndexDetails, error) {
+	if limit <= 0 || limit > maxIndicesPage {
+		limit = maxIndicesPage
+	}
+
+	baskets, err := s.basketRepo.ListIndices(ctx, max(offset, 0), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := make([]string, len(baskets))
+	for i, b := range baskets {
+		symbols[i] = b.Symbol
+	}
+
+	quotes, err := s.marketRepo.GetQuotes(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]*domain.IndexDetails, len(baskets))
+	for i, b := range baskets {
+		indices[i] = newIndexDetails(b, quotes[b.Symbol])
+	}
+
+	return indices, nil
+}
+
+// GetIndex returns the public view of a custom index, with up to historyLimit of its most
+// recent recorded prices.
+func (s *Basket) GetIndex(ctx context.Context, symbol string, historyLimit int) (*domain.IndexDetails, error) {
+	b, err := s.getIndex(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	index := s.indexDetails(ctx, b)
+
+	index.Followers, err = s.basketRepo.ListBasketFollowers(ctx, b.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	index.History, err = s.historyRepo.GetHistory(ctx, b.Symbol, historyLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// Follow adds a player to the followers of a custom index. Following twice has no effect.
+func (s *Basket) Follow(ctx context.Context, userID int64, symbol string) error {
+	b, err := s.getIndex(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	return s.basketRepo.FollowBasket(ctx, b.Symbol, userID)
+}
+
+// Unfollow removes a player from the followers of a custom index.
+func (s *Basket) Unfollow(ctx context.Context, userID int64, symbol string) error {
+	b, err := s.getIndex(ctx, symbol)
+	if err != nil {
+		return err
+	}
+
+	return s.basketRepo.UnfollowBasket(ctx, b.Symbol, userID)
+}
+
+// getIndex returns the custom index with the given symbol, which may omit the basket prefix.
+func (s *Basket) getIndex(ctx context.Context, symbol string) (*domain.Basket, error) {
+	b, err := s.basketRepo.GetBasket(ctx, domain.InstrumentID(domain.SourceBasket, symbol))
+	if errors.Is(err, apperrors.ErrBasketNotFound) || (err == nil && b.OwnerID == 0) {
+		return nil, apperrors.ErrIndexNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// indexDetails prices a custom index from the current quotes of its components. Indices
+// whose components are not all priced are listed without a quote.
+func (s *Basket) indexDetails(ctx context.Context, b *domain.Basket) *domain.IndexDetails {
+	quote, err := s.Quote(ctx, b)
+	if err != nil {
+		return newIndexDetails(b, nil)
+	}
+
+	return newIndexDetails(b, quote)
+}
+
+// newIndexDetails returns the public view of a custom index at the given quote, which may
+// be nil.
+func newIndexDetails(b *domain.Basket, quote *domain.Quote) *domain.IndexDetails {
+	index := &domain.IndexDetails{Basket: b}
+	if quote == nil {
+		return index
+	}
+
+	index.Quote = quote
+	index.ReturnPercent = quote.Price.Sub(b.BaseValue).Div(b.BaseValue).Mul(decimal.NewFromInt(100)).Round(2)
+
+	return index
+}
+
+// Quote prices a basket from the current quotes of its components. Its change is the change
+// of the components held, and it is closed only while all of its components are.
+func (s *Basket) Quote(ctx context.Context, basket *domain.Basket) (*domain.Quote, error) {
+	price, change := decimal.Zero, decimal.Zero
+	closed := true
+	var timestamp time.Time
+	for _, c := range basket.Components {
+		quote, err := s.marketRepo.GetQuote(ctx, c.Symbol)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get quote of component %s: %w", c.Symbol, err)
+		}
+
+		price = price.Add(c.Units.Mul(quote.Price))
+		change = change.Add(c.Units.Mul(quote.Change))
+		closed = closed && quote.IsClosed
+		if quote.Timestamp.After(timestamp) {
+			timestamp = quote.Timestamp
+		}
+	}
+
+	changePercent := decimal.Zero
+	if previous := price.Sub(change); previous.IsPositive() {
+		changePercent = change.Div(previous).Mul(decimal.NewFromInt(100))
+	}
+
+	return &domain.Quote{
+		Symbol:        basket.Symbol,
+		Price:         price.Round(basketPrecision),
+		Change:        change.Round(basketPrecision),
+		ChangePercent: changePercent.Round(2),
+		Timestamp:     timestamp,
+		Source:        "BK",
+		IsClosed:      closed,
+		Precision:     basketPrecision,
+	}, nil
+}
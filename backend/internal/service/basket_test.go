@@ -0,0 +1,339 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+func newBasketService(basketRepo *mocks.MockBasketRepository, marketRepo *mocks.MockMarketRepository) *service.Basket {
+	return service.NewBasket(basketRepo, marketRepo, new(mocks.MockHistoryRepository), new(mocks.MockLadderRepository))
+}
+
+func TestBasketService_Create(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Weights Equally And Fixes Units", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+
+		mockBasketRepo.On("GetInstrumentCurrencies", ctx, []string{"FH:AAPL", "CG:bitcoin"}).
+			Return(map[string]string{"FH:AAPL": "USD"}, nil)
+		mockMarketRepo.On("GetQuote", ctx, "FH:AAPL").Return(&domain.Quote{Price: decimal.NewFromInt(200)}, nil)
+		mockMarketRepo.On("GetQuote", ctx, "CG:bitcoin").Return(&domain.Quote{Price: decimal.NewFromInt(50000)}, nil)
+		mockBasketRepo.On("CreateBasket", ctx, mock.AnythingOfType("*domain.Basket")).Return(nil)
+
+		s := newBasketService(mockBasketRepo, mockMarketRepo)
+		b, err := s.Create(ctx, "tech-2", "", []domain.BasketComponent{
+			{Symbol: "FH:AAPL"},
+			{Symbol: "CG:bitcoin"},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "BK:TECH-2", b.Symbol)
+		assert.Equal(t, "TECH-2", b.Name)
+		assert.True(t, b.Components[0].Weight.Equal(decimal.NewFromFloat(0.5)))
+		assert.True(t, b.Components[0].Units.Equal(decimal.NewFromFloat(0.25)))
+		assert.True(t, b.Components[1].Units.Equal(decimal.NewFromFloat(0.001)))
+		mockBasketRepo.AssertExpectations(t)
+	})
+
+	t.Run("Normalizes Weights", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+
+		mockMarketRepo.On("GetQuote", ctx, mock.Anything).Return(&domain.Quote{Price: decimal.NewFromInt(10)}, nil)
+		mockBasketRepo.On("GetInstrumentCurrencies", ctx, mock.Anything).Return(map[string]string{}, nil)
+		mockBasketRepo.On("CreateBasket", ctx, mock.Anything).Return(nil)
+
+		s := newBasketService(mockBasketRepo, mockMarketRepo)
+		b, err := s.Create(ctx, "MIX", "Mix", []domain.BasketComponent{
+			{Symbol: "FH:AAPL", Weight: decimal.NewFromInt(3)},
+			{Symbol: "FH:MSFT", Weight: decimal.NewFromInt(1)},
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, b.Components[0].Weight.Equal(decimal.NewFromFloat(0.75)))
+		assert.True(t, b.Components[1].Units.Equal(decimal.NewFromFloat(2.5)))
+	})
+
+	t.Run("Rejects Invalid Components", func(t *testing.T) {
+		cases := map[string][]domain.BasketComponent{
+			"too few":     {{Symbol: "FH:AAPL"}},
+			"duplicate":   {{Symbol: "FH:AAPL"}, {Symbol: "FH:AAPL"}},
+			"nested":      {{Symbol: "FH:AAPL"}, {Symbol: "BK:TECH"}},
+			"unqualified": {{Symbol: "FH:AAPL"}, {Symbol: "MSFT"}},
+			"mixed weights": {
+				{Symbol: "FH:AAPL", Weight: decimal.NewFromInt(1)},
+				{Symbol: "FH:MSFT"},
+			},
+		}
+
+		s := newBasketService(new(mocks.MockBasketRepository), new(mocks.MockMarketRepository))
+		for name, components := range cases {
+			_, err := s.Create(ctx, "BAD", "", components)
+			assert.ErrorIs(t, err, apperrors.ErrInvalidBasketComponents, name)
+		}
+	})
+
+	t.Run("Rejects Invalid Symbol", func(t *testing.T) {
+		s := newBasketService(new(mocks.MockBasketRepository), new(mocks.MockMarketRepository))
+		_, err := s.Create(ctx, "no spaces", "", nil)
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidBasketSymbol)
+	})
+
+	t.Run("Rejects Unpriced Component", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockBasketRepo.On("GetInstrumentCurrencies", ctx, mock.Anything).Return(map[string]string{}, nil)
+		mockMarketRepo.On("GetQuote", ctx, "FH:AAPL").Return(&domain.Quote{Price: decimal.NewFromInt(200)}, nil)
+		mockMarketRepo.On("GetQuote", ctx, "FH:XYZ").Return(nil, errors.New("not found"))
+
+		s := newBasketService(mockBasketRepo, mockMarketRepo)
+		_, err := s.Create(ctx, "TECH", "", []domain.BasketComponent{{Symbol: "FH:AAPL"}, {Symbol: "FH:XYZ"}})
+
+		assert.ErrorIs(t, err, apperrors.ErrBasketComponentUnpriced)
+	})
+
+	t.Run("Rejects Non-USD Component", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockBasketRepo.On("GetInstrumentCurrencies", ctx, mock.Anything).
+			Return(map[string]string{"FH:AAPL": "USD", "OA:EUR_GBP": "GBP"}, nil)
+
+		s := newBasketService(mockBasketRepo, new(mocks.MockMarketRepository))
+		_, err := s.Create(ctx, "MIX", "", []domain.BasketComponent{{Symbol: "FH:AAPL"}, {Symbol: "OA:EUR_GBP"}})
+
+		assert.ErrorIs(t, err, apperrors.ErrBasketComponentCurrency)
+	})
+}
+
+func TestBasketService_Quote(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	basket := &domain.Basket{
+		Symbol: "BK:TECH",
+		Components: []domain.BasketComponent{
+			{Symbol: "FH:AAPL", Units: decimal.NewFromFloat(0.25)},
+			{Symbol: "CG:bitcoin", Units: decimal.NewFromFloat(0.001)},
+		},
+	}
+
+	mockMarketRepo := new(mocks.MockMarketRepository)
+	mockMarketRepo.On("GetQuote", ctx, "FH:AAPL").Return(&domain.Quote{
+		Price: decimal.NewFromInt(220), Change: decimal.NewFromInt(20), Timestamp: now.Add(-time.Minute), IsClosed: true,
+	}, nil)
+	mockMarketRepo.On("GetQuote", ctx, "CG:bitcoin").Return(&domain.Quote{
+		Price: decimal.NewFromInt(50000), Change: decimal.Zero, Timestamp: now,
+	}, nil)
+
+	s := newBasketService(new(mocks.MockBasketRepository), mockMarketRepo)
+	q, err := s.Quote(ctx, basket)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "BK:TECH", q.Symbol)
+	assert.True(t, q.Price.Equal(decimal.NewFromInt(105)), q.Price.String())
+	assert.True(t, q.Change.Equal(decimal.NewFromInt(5)))
+	assert.True(t, q.ChangePercent.Equal(decimal.NewFromInt(5)))
+	assert.Equal(t, now, q.Timestamp)
+	assert.False(t, q.IsClosed)
+}
+
+func TestBasketService_Publish(t *testing.T) {
+	ctx := context.Background()
+	components := []domain.BasketComponent{{Symbol: "FH:AAPL"}, {Symbol: "FH:MSFT"}}
+
+	newService := func(basketRepo *mocks.MockBasketRepository, marketRepo *mocks.MockMarketRepository) *service.Basket {
+		ladderRepo := new(mocks.MockLadderRepository)
+		ladderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+		ladderRepo.On("GetAllowedTickers", ctx, int64(1)).Return([]*domain.TickerInfo{
+			{Symbol: "FH:AAPL"},
+			{Symbol: "FH:MSFT"},
+		}, nil)
+
+		return service.NewBasket(basketRepo, marketRepo, new(mocks.MockHistoryRepository), ladderRepo)
+	}
+
+	t.Run("Publishes Owned Index", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+
+		mockBasketRepo.On("CountBasketsByOwner", ctx, int64(7)).Return(int64(0), nil)
+		mockMarketRepo.On("GetQuote", ctx, mock.Anything).Return(&domain.Quote{Price: decimal.NewFromInt(100)}, nil)
+		mockBasketRepo.On("GetInstrumentCurrencies", ctx, mock.Anything).Return(map[string]string{}, nil)
+		mockBasketRepo.On("CreateBasket", ctx, mock.Anything).Return(nil)
+
+		b, err := newService(mockBasketRepo, mockMarketRepo).Publish(ctx, 7, "MYTECH", "My Tech", "monthly", components)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "BK:MYTECH", b.Symbol)
+		assert.Equal(t, int64(7), b.OwnerID)
+		assert.Equal(t, domain.RebalanceMonthly, b.Rebalance)
+		assert.Equal(t, b.CreatedAt.AddDate(0, 1, 0), b.NextRebalance())
+	})
+
+	t.Run("Rejects Invalid Schedule", func(t *testing.T) {
+		_, err := newService(new(mocks.MockBasketRepository), new(mocks.MockMarketRepository)).
+			Publish(ctx, 7, "MYTECH", "My Tech", "daily", components)
+
+		assert.ErrorIs(t, err, apperrors.ErrInvalidRebalanceSchedule)
+	})
+
+	t.Run("Rejects Missing Name", func(t *testing.T) {
+		_, err := newService(new(mocks.MockBasketRepository), new(mocks.MockMarketRepository)).
+			Publish(ctx, 7, "MYTECH", " ", "", components)
+
+		assert.ErrorIs(t, err, apperrors.ErrIndexNameRequired)
+	})
+
+	t.Run("Rejects Over Limit", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockBasketRepo.On("CountBasketsByOwner", ctx, int64(7)).Return(int64(5), nil)
+
+		_, err := newService(mockBasketRepo, new(mocks.MockMarketRepository)).
+			Publish(ctx, 7, "MYTECH", "My Tech", "", components)
+
+		assert.ErrorIs(t, err, apperrors.ErrIndexLimitReached)
+	})
+
+	t.Run("Rejects Components Outside Ladder", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockBasketRepo.On("CountBasketsByOwner", ctx, int64(7)).Return(int64(0), nil)
+
+		_, err := newService(mockBasketRepo, new(mocks.MockMarketRepository)).
+			Publish(ctx, 7, "MYTECH", "My Tech", "", []domain.BasketComponent{{Symbol: "FH:AAPL"}, {Symbol: "FH:NVDA"}})
+
+		assert.ErrorIs(t, err, apperrors.ErrIndexComponentNotListed)
+	})
+
+	t.Run("Rejects Non-USD Component", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockBasketRepo.On("CountBasketsByOwner", ctx, int64(7)).Return(int64(0), nil)
+		mockBasketRepo.On("GetInstrumentCurrencies", ctx, mock.Anything).Return(map[string]string{"FH:MSFT": "EUR"}, nil)
+
+		_, err := newService(mockBasketRepo, new(mocks.MockMarketRepository)).
+			Publish(ctx, 7, "MYTECH", "My Tech", "", components)
+
+		assert.ErrorIs(t, err, apperrors.ErrBasketComponentCurrency)
+	})
+}
+
+func TestBasketService_Rebalance(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	// AAPL doubled since creation, so the basket is worth 150 and drifted to 2/3 AAPL.
+	basket := &domain.Basket{
+		Symbol:    "BK:MYTECH",
+		Rebalance: domain.RebalanceWeekly,
+		Components: []domain.BasketComponent{
+			{Symbol: "FH:AAPL", Weight: decimal.NewFromFloat(0.5), Units: decimal.NewFromFloat(0.25)},
+			{Symbol: "FH:MSFT", Weight: decimal.NewFromFloat(0.5), Units: decimal.NewFromFloat(0.125)},
+		},
+	}
+
+	mockBasketRepo := new(mocks.MockBasketRepository)
+	mockMarketRepo := new(mocks.MockMarketRepository)
+	mockMarketRepo.On("GetQuote", ctx, "FH:AAPL").Return(&domain.Quote{Price: decimal.NewFromInt(400)}, nil)
+	mockMarketRepo.On("GetQuote", ctx, "FH:MSFT").Return(&domain.Quote{Price: decimal.NewFromInt(400)}, nil)
+	mockBasketRepo.On("RebalanceBasket", ctx, basket).Return(nil)
+
+	err := newBasketService(mockBasketRepo, mockMarketRepo).Rebalance(ctx, basket, now)
+
+	assert.NoError(t, err)
+	assert.True(t, basket.Components[0].Units.Equal(decimal.NewFromFloat(0.1875)))
+	assert.True(t, basket.Components[1].Units.Equal(decimal.NewFromFloat(0.1875)))
+	assert.Equal(t, now, basket.RebalancedAt)
+	mockBasketRepo.AssertExpectations(t)
+}
+
+func TestBasketService_ListIndices(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Prices Page From Published Quotes", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		indices := []*domain.Basket{
+			{Symbol: "BK:MYTECH", BaseValue: decimal.NewFromInt(100), OwnerID: 7},
+			{Symbol: "BK:NEW", BaseValue: decimal.NewFromInt(100), OwnerID: 8},
+		}
+
+		mockBasketRepo.On("ListIndices", ctx, 20, 2).Return(indices, nil)
+		mockMarketRepo.On("GetQuotes", ctx, []string{"BK:MYTECH", "BK:NEW"}).Return(map[string]*domain.Quote{
+			"BK:MYTECH": {Symbol: "BK:MYTECH", Price: decimal.NewFromInt(125)},
+		}, nil).Once()
+
+		page, err := newBasketService(mockBasketRepo, mockMarketRepo).ListIndices(ctx, 20, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, page, 2)
+		assert.True(t, page[0].ReturnPercent.Equal(decimal.NewFromInt(25)))
+		assert.Nil(t, page[1].Quote, "an index the worker has not priced yet is listed without a quote")
+		mockMarketRepo.AssertNotCalled(t, "GetQuote", mock.Anything, mock.Anything)
+		mockMarketRepo.AssertExpectations(t)
+	})
+
+	t.Run("Caps Page Size", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+
+		mockBasketRepo.On("ListIndices", ctx, 0, 100).Return([]*domain.Basket{}, nil)
+		mockMarketRepo.On("GetQuotes", ctx, []string{}).Return(map[string]*domain.Quote{}, nil)
+
+		_, err := newBasketService(mockBasketRepo, mockMarketRepo).ListIndices(ctx, -5, 1000)
+
+		assert.NoError(t, err)
+		mockBasketRepo.AssertExpectations(t)
+	})
+}
+
+func TestBasketService_GetIndex(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Returns Performance, Followers And History", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		basket := &domain.Basket{
+			Symbol:     "BK:MYTECH",
+			BaseValue:  decimal.NewFromInt(100),
+			OwnerID:    7,
+			Components: []domain.BasketComponent{{Symbol: "FH:AAPL", Units: decimal.NewFromFloat(0.5)}},
+		}
+		history := []*domain.Quote{{Symbol: "BK:MYTECH", Price: decimal.NewFromInt(110)}}
+
+		mockBasketRepo.On("GetBasket", ctx, "BK:MYTECH").Return(basket, nil)
+		mockBasketRepo.On("ListBasketFollowers", ctx, "BK:MYTECH").Return([]string{"alice"}, nil)
+		mockMarketRepo.On("GetQuote", ctx, "FH:AAPL").Return(&domain.Quote{Price: decimal.NewFromInt(240)}, nil)
+		mockHistoryRepo.On("GetHistory", ctx, "BK:MYTECH", 50).Return(history, nil)
+
+		s := service.NewBasket(mockBasketRepo, mockMarketRepo, mockHistoryRepo, new(mocks.MockLadderRepository))
+		index, err := s.GetIndex(ctx, "MYTECH", 50)
+
+		assert.NoError(t, err)
+		assert.True(t, index.Quote.Price.Equal(decimal.NewFromInt(120)))
+		assert.True(t, index.ReturnPercent.Equal(decimal.NewFromInt(20)))
+		assert.Equal(t, []string{"alice"}, index.Followers)
+		assert.Equal(t, history, index.History)
+	})
+
+	t.Run("Hides Admin Baskets", func(t *testing.T) {
+		mockBasketRepo := new(mocks.MockBasketRepository)
+		mockBasketRepo.On("GetBasket", ctx, "BK:TECH").Return(&domain.Basket{Symbol: "BK:TECH"}, nil)
+
+		_, err := newBasketService(mockBasketRepo, new(mocks.MockMarketRepository)).GetIndex(ctx, "BK:TECH", 50)
+
+		assert.ErrorIs(t, err, apperrors.ErrIndexNotFound)
+	})
+}
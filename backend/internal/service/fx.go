@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// FXRateRepository defines the interface for the FX rate cache.
+type FXRateRepository interface {
+	SaveRates(ctx context.Context, rates []*domain.FXRate) error
+	GetRate(ctx context.Context, currency string) (*domain.FXRate, error)
+}
+
+// FXRateProvider fetches the USD value of one unit of each of the given currencies.
+// Currencies the provider cannot price are omitted from the result.
+type FXRateProvider interface {
+	GetRates(ctx context.Context, currencies []string) ([]*domain.FXRate, error)
+}
+
+// FXRates converts amounts between currencies using the cached rates. Every rate is held
+// against USD, so converting between two other currencies crosses through USD.
+type FXRates struct {
+	repo   FXRateRepository
+	maxAge time.Duration
+}
+
+// NewFXRates creates a new instance of FXRates. Rates older than maxAge are treated as
+// unavailable; a zero maxAge accepts rates of any age.
+func NewFXRates(repo FXRateRepository, maxAge time.Duration) *FXRates {
+	return &FXRates{
+		repo:   repo,
+		maxAge: maxAge,
+	}
+}
+
+// Rate returns the number of units of the currency to that one unit of the currency from
+// is worth. It fails with apperrors.ErrFXRateUnavailable if either currency has no current
+// rate. Converting a currency into itself needs no rate.
+func (s *FXRates) Rate(ctx context.Context, from, to string) (decimal.Decimal, error) {
+	if from == to {
+		return decimal.NewFromInt(1), nil
+	}
+
+	fromUSD, err := s.usdRate(ctx, from)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	toUSD, err := s.usdRate(ctx, to)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return fromUSD.Div(toUSD), nil
+}
+
+// convertedAmountPlaces is the number of decimal places amounts are rounded to when they
+// are converted between currencies.
+const convertedAmountPlaces = 8
+
+// ConvertAmount converts an amount at the given rate. Amounts are only rounded if the rate
+// actually converts them, so that amounts in the base currency stay exact.
+func ConvertAmount(amount, rate decimal.Decimal) decimal.Decimal {
+	if rate.Equal(decimal.NewFromInt(1)) {
+		return amount
+	}
+
+	return amount.Mul(rate).Round(convertedAmountPlaces)
+}
+
+// Valuation values positions of a ladder in its base currency, fetching the rate of each
+// currency once. A Valuation is meant for a single pass over the ladder's portfolios.
+type Valuation struct {
+	fxRates *FXRates
+	ladder  *domain.Ladder
+	rates   map[string]decimal.Decimal
+}
+
+// NewValuation creates a Valuation for the ladder, whose allowed tickers determine the
+// currency each symbol is quoted in.
+func (s *FXRates) NewValuation(l *domain.Ladder) *Valuation {
+	return &Valuation{
+		fxRates: s,
+		ladder:  l,
+		rates:   make(map[string]decimal.Decimal),
+	}
+}
+
+// Value returns the value of a quantity of the symbol at the price in its currency,
+// converted into the ladder's base currency.
+func (v *Valuation) Value(ctx context.Context, symbol string, price, quantity decimal.Decimal) (decimal.Decimal, error) {
+	currency := domain.CurrencyUSD
+	if ticker, ok := v.ladder.Ticker(symbol); ok {
+		currency = ticker.PriceCurrency()
+	}
+
+	rate, ok := v.rates[currency]
+	if !ok {
+		var err error
+		rate, err = v.fxRates.Rate(ctx, currency, v.ladder.Currency())
+		if err != nil {
+			return decimal.Zero, err
+		}
+		v.rates[currency] = rate
+	}
+
+	return ConvertAmount(price.Mul(quantity), rate), nil
+}
+
+// usdRate returns the USD value of one unit of the currency.
+func (s *FXRates) usdRate(ctx context.Context, currency string) (decimal.Decimal, error) {
+	if currency == domain.CurrencyUSD {
+		return decimal.NewFromInt(1), nil
+	}
+
+	rate, err := s.repo.GetRate(ctx, currency)
+	if errors.Is(err, redis.Nil) {
+		return decimal.Zero, fmt.Errorf("%w: no rate for %s", apperrors.ErrFXRateUnavailable, currency)
+	}
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if s.maxAge > 0 && time.Since(rate.UpdatedAt) > s.maxAge {
+		return decimal.Zero, fmt.Errorf("%w: rate for %s is from %s", apperrors.ErrFXRateUnavailable,
+			currency, rate.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return rate.Rate, nil
+}
+
+// Refresh fetches the rates of the given currencies from the provider and caches them.
+// It returns the number of rates stored.
+func (s *FXRates) Refresh(ctx context.Context, provider FXRateProvider, currencies []string) (int, error) {
+	var wanted []string
+	for _, currency := range currencies {
+		if currency != domain.CurrencyUSD && currency != "" {
+			wanted = append(wanted, currency)
+		}
+	}
+	if len(wanted) == 0 {
+		return 0, nil
+	}
+
+	rates, err := provider.GetRates(ctx, wanted)
+	if err != nil {
+		return 0, err
+	}
+
+	var valid []*domain.FXRate
+	for _, rate := range rates {
+		if rate.Rate.IsPositive() {
+			valid = append(valid, rate)
+		}
+	}
+
+	if err = s.repo.SaveRates(ctx, valid); err != nil {
+		return 0, err
+	}
+
+	return len(valid), nil
+}
+
+// StaticFXRates is an FXRateProvider of fixed rates, used where no live FX provider is
+// configured. Its rates are always reported as current.
+type StaticFXRates map[string]decimal.Decimal
+
+// GetRates returns the fixed rates of the given currencies.
+func (r StaticFXRates) GetRates(_ context.Context, currencies []string) ([]*domain.FXRate, error) {
+	now := time.Now()
+
+	var rates []*domain.FXRate
+	for _, currency := range currencies {
+		if rate, ok := r[currency]; ok {
+			rates = append(rates, &domain.FXRate{Currency: currency, Rate: rate, UpdatedAt: now})
+		}
+	}
+
+	return rates, nil
+}
+
+// ParseStaticFXRates parses a list of fixed rates such as "EUR=1.08,GBP=1.27", each being
+// the USD value of one unit of the currency.
+func ParseStaticFXRates(list string) (StaticFXRates, error) {
+	rates := make(StaticFXRates)
+	for entry := range strings.SplitSeq(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		currency, value, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid FX rate %q: expected CURRENCY=rate", entry)
+		}
+
+		rate, err := decimal.NewFromString(strings.TrimSpace(value))
+		if err != nil || !rate.IsPositive() {
+			return nil, fmt.Errorf("invalid FX rate %q: rate must be a positive number", entry)
+		}
+		rates[strings.ToUpper(strings.TrimSpace(currency))] = rate
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,118 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+func newFXRateRepository(t *testing.T) *redisRepo.FXRateRepository {
+	t.Helper()
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	t.Cleanup(mr.Close)
+
+	return redisRepo.NewFXRateRepository(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+}
+
+func TestFXRates_Rate(t *testing.T) {
+	ctx := context.Background()
+	repo := newFXRateRepository(t)
+	require.NoError(t, repo.SaveRates(ctx, []*domain.FXRate{
+		{Currency: "EUR", Rate: decimal.RequireFromString("1.1"), UpdatedAt: time.Now()},
+		{Currency: "GBP", Rate: decimal.RequireFromString("1.32"), UpdatedAt: time.Now()},
+		{Currency: "CHF", Rate: decimal.RequireFromString("1.12"), UpdatedAt: time.Now().Add(-2 * time.Hour)},
+	}))
+	fxRates := service.NewFXRates(repo, time.Hour)
+
+	t.Run("Same Currency", func(t *testing.T) {
+		rate, err := fxRates.Rate(ctx, "JPY", "JPY")
+		require.NoError(t, err)
+		assert.Equal(t, "1", rate.String())
+	})
+
+	t.Run("Into USD", func(t *testing.T) {
+		rate, err := fxRates.Rate(ctx, "EUR", domain.CurrencyUSD)
+		require.NoError(t, err)
+		assert.Equal(t, "1.1", rate.String())
+	})
+
+	t.Run("Crosses Through USD", func(t *testing.T) {
+		rate, err := fxRates.Rate(ctx, "GBP", "EUR")
+		require.NoError(t, err)
+		assert.Equal(t, "1.2", rate.String())
+	})
+
+	t.Run("Missing Rate", func(t *testing.T) {
+		_, err := fxRates.Rate(ctx, "JPY", domain.CurrencyUSD)
+		assert.ErrorIs(t, err, apperrors.ErrFXRateUnavailable)
+	})
+
+	t.Run("Stale Rate", func(t *testing.T) {
+		_, err := fxRates.Rate(ctx, "CHF", domain.CurrencyUSD)
+		assert.ErrorIs(t, err, apperrors.ErrFXRateUnavailable)
+	})
+}
+
+func TestFXRates_Refresh(t *testing.T) {
+	ctx := context.Background()
+	repo := newFXRateRepository(t)
+	fxRates := service.NewFXRates(repo, time.Hour)
+	provider := service.StaticFXRates{"EUR": decimal.RequireFromString("1.08")}
+
+	n, err := fxRates.Refresh(ctx, provider, []string{domain.CurrencyUSD, "EUR", "SEK"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	rate, err := fxRates.Rate(ctx, "EUR", domain.CurrencyUSD)
+	require.NoError(t, err)
+	assert.Equal(t, "1.08", rate.String())
+}
+
+func TestFXRates_Valuation(t *testing.T) {
+	ctx := context.Background()
+	repo := newFXRateRepository(t)
+	require.NoError(t, repo.SaveRates(ctx, []*domain.FXRate{
+		{Currency: "EUR", Rate: decimal.RequireFromString("1.1"), UpdatedAt: time.Now()},
+	}))
+	ladder := &domain.Ladder{AllowedTickers: []domain.TickerInfo{
+		{Symbol: "FH:SAP", Currency: "EUR"},
+		{Symbol: "FH:AAPL", Currency: domain.CurrencyUSD},
+	}}
+	valuation := service.NewFXRates(repo, time.Hour).NewValuation(ladder)
+
+	value, err := valuation.Value(ctx, "FH:SAP", decimal.NewFromInt(200), decimal.NewFromInt(3))
+	require.NoError(t, err)
+	assert.Equal(t, "660", value.String())
+
+	value, err = valuation.Value(ctx, "FH:AAPL", decimal.NewFromInt(150), decimal.NewFromInt(2))
+	require.NoError(t, err)
+	assert.Equal(t, "300", value.String())
+}
+
+func TestParseStaticFXRates(t *testing.T) {
+	rates, err := service.ParseStaticFXRates(" eur=1.08, GBP=1.27 ,")
+	require.NoError(t, err)
+	assert.Equal(t, "1.08", rates["EUR"].String())
+	assert.Equal(t, "1.27", rates["GBP"].String())
+
+	_, err = service.ParseStaticFXRates("EUR")
+	assert.Error(t, err)
+
+	_, err = service.ParseStaticFXRates("EUR=-1")
+	assert.Error(t, err)
+}
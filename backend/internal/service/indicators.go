@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const (
+	minIndicatorPeriod = 2
+	maxIndicatorPeriod = 500
+	// defaultIndicatorLimit and maxIndicatorLimit bound the points returned per indicator.
+	defaultIndicatorLimit = 100
+	maxIndicatorLimit     = 1000
+	// maxIndicatorBars caps the stored quotes loaded for a single indicator request.
+	maxIndicatorBars = 5000
+	// maxIndicatorCacheEntries bounds the indicator cache; it is cleared once full.
+	maxIndicatorCacheEntries = 1024
+
+	macdFast   = 12
+	macdSlow   = 26
+	macdSignal = 9
+
+	bollingerWidth = 2
+)
+
+// defaultIndicatorPeriods are the periods used when a request sets none.
+var defaultIndicatorPeriods = map[string]int{
+	domain.IndicatorSMA:       20,
+	domain.IndicatorEMA:       20,
+	domain.IndicatorRSI:       14,
+	domain.IndicatorMACD:      0,
+	domain.IndicatorBollinger: 20,
+}
+
+// ParseIndicatorTypes splits a comma-separated list of indicator types, dropping duplicates.
+func ParseIndicatorTypes(list string) ([]string, error) {
+	var types []string
+	for _, t := range strings.Split(list, ",") {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			continue
+		}
+		if _, ok := defaultIndicatorPeriods[t]; !ok {
+			return nil, apperrors.ErrInvalidIndicator
+		}
+		if !slices.Contains(types, t) {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		return nil, apperrors.ErrInvalidIndicator
+	}
+
+	return types, nil
+}
+
+// GetIndicators computes technical indicators over a symbol's stored quotes and returns the
+// last limit points of each. A zero period selects each indicator's default and a non-positive
+// limit the default number of points. Results are cached until a newer quote is stored.
+func (s *Market) GetIndicators(
+	ctx context.Context,
+	symbol string,
+	types []string,
+	period int,
+	limit int,
+) ([]*domain.Indicator, error) {
+	if len(types) == 0 {
+		return nil, apperrors.ErrInvalidIndicator
+	}
+	if period != 0 && (period < minIndicatorPeriod || period > maxIndicatorPeriod) {
+		return nil, apperrors.ErrInvalidIndicatorPeriod
+	}
+	if limit <= 0 {
+		limit = defaultIndicatorLimit
+	}
+	limit = min(limit, maxIndicatorLimit)
+
+	allowed, err := s.isSymbolAllowed(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, apperrors.ErrSymbolNotAllowed
+	}
+
+	latest, err := s.historyRepo.GetLatestQuotes(ctx, []string{symbol})
+	if err != nil {
+		return nil, err
+	}
+	if len(latest) == 0 {
+		return computeIndicators(nil, types, period, limit), nil
+	}
+	lastBar := latest[0].Timestamp
+
+	key := fmt.Sprintf("%s|%s|%d|%d", symbol, strings.Join(types, ","), period, limit)
+	if indicators, ok := s.indicatorCache.get(key, lastBar); ok {
+		return indicators, nil
+	}
+
+	bars := limit
+	for _, t := range types {
+		bars = max(bars, limit+indicatorWarmup(t, indicatorPeriod(t, period)))
+	}
+
+	history, err := s.historyRepo.GetHistory(ctx, symbol, min(bars, maxIndicatorBars))
+	if err != nil {
+		return nil, err
+	}
+
+	indicators := computeIndicators(history, types, period, limit)
+	s.indicatorCache.put(key, lastBar, indicators)
+
+	return indicators, nil
+}
+
+func indicatorPeriod(indicatorType string, period int) int {
+	if indicatorType == domain.IndicatorMACD || period == 0 {
+		return defaultIndicatorPeriods[indicatorType]
+	}
+
+	return period
+}
+
+// indicatorWarmup is the number of quotes needed before an indicator's first reliable value.
+// Smoothed indicators get extra history so their seed has decayed.
+func indicatorWarmup(indicatorType string, period int) int {
+	switch indicatorType {
+	case domain.IndicatorEMA, domain.IndicatorRSI:
+		return 3 * period
+	case domain.IndicatorMACD:
+		return 3*macdSlow + macdSignal
+	default:
+		return period - 1
+	}
+}
+
+// computeIndicators evaluates each indicator over the quotes, which must be in time order,
+// and keeps the last limit points.
+func computeIndicators(quotes []*domain.Quote, types []string, period int, limit int) []*domain.Indicator {
+	prices := make([]float64, len(quotes))
+	for i, q := range quotes {
+		prices[i] = q.Price.InexactFloat64()
+	}
+
+	indicators := make([]*domain.Indicator, len(types))
+	for i, t := range types {
+		p := indicatorPeriod(t, period)
+
+		var points []domain.IndicatorPoint
+		switch t {
+		case domain.IndicatorSMA:
+			points = seriesPoints(quotes, sma(prices, p))
+		case domain.IndicatorEMA:
+			points = seriesPoints(quotes, ema(prices, p))
+		case domain.IndicatorRSI:
+			points = seriesPoints(quotes, rsi(prices, p))
+		case domain.IndicatorMACD:
+			points = macdPoints(quotes, prices)
+		case domain.IndicatorBollinger:
+			points = bollingerPoints(quotes, prices, p)
+		}
+		if len(points) > limit {
+			points = points[len(points)-limit:]
+		}
+
+		indicators[i] = &domain.Indicator{Type: t, Period: p, Points: points}
+	}
+
+	return indicators
+}
+
+// seriesPoints pairs a series with its quotes' timestamps, skipping the NaN warmup values.
+func seriesPoints(quotes []*domain.Quote, series []float64) []domain.IndicatorPoint {
+	points := make([]domain.IndicatorPoint, 0, len(series))
+	for i, v := range series {
+		if math.IsNaN(v) {
+			continue
+		}
+		points = append(points, domain.IndicatorPoint{Timestamp: quotes[i].Timestamp, Value: v})
+	}
+
+	return points
+}
+
+func macdPoints(quotes []*domain.Quote, prices []float64) []domain.IndicatorPoint {
+	fast, slow := ema(prices, macdFast), ema(prices, macdSlow)
+
+	// The MACD line starts once the slow EMA is defined; the signal is an EMA of that line.
+	start := min(macdSlow-1, len(prices))
+	line := make([]float64, len(prices)-start)
+	for i := range line {
+		line[i] = fast[start+i] - slow[start+i]
+	}
+	signal := ema(line, macdSignal)
+
+	points := make([]domain.IndicatorPoint, 0, len(line))
+	for i, v := range line {
+		if math.IsNaN(signal[i]) {
+			continue
+		}
+		points = append(points, domain.IndicatorPoint{
+			Timestamp: quotes[start+i].Timestamp,
+			Value:     v,
+			Signal:    signal[i],
+			Histogram: v - signal[i],
+		})
+	}
+
+	return points
+}
+
+func bollingerPoints(quotes []*domain.Quote, prices []float64, period int) []domain.IndicatorPoint {
+	middle := sma(prices, period)
+
+	points := make([]domain.IndicatorPoint, 0, len(prices))
+	for i, mid := range middle {
+		if math.IsNaN(mid) {
+			continue
+		}
+
+		var variance float64
+		for _, p := range prices[i-period+1 : i+1] {
+			variance += (p - mid) * (p - mid)
+		}
+		width := bollingerWidth * math.Sqrt(variance/float64(period))
+
+		points = append(points, domain.IndicatorPoint{
+			Timestamp: quotes[i].Timestamp,
+			Value:     mid,
+			Upper:     mid + width,
+			Lower:     mid - width,
+		})
+	}
+
+	return points
+}
+
+// sma returns the simple moving average of values, NaN until period values are available.
+func sma(values []float64, period int) []float64 {
+	out := nanSeries(len(values))
+
+	var sum float64
+	for i, v := range values {
+		sum += v
+		if i >= period {
+			sum -= values[i-period]
+		}
+		if i >= period-1 {
+			out[i] = sum / float64(period)
+		}
+	}
+
+	return out
+}
+
+// ema returns the exponential moving average of values, seeded with the SMA of the first period values.
+func ema(values []float64, period int) []float64 {
+	out := nanSeries(len(values))
+	if len(values) < period {
+		return out
+	}
+
+	k := 2 / float64(period+1)
+	seed := sma(values[:period], period)[period-1]
+	out[period-1] = seed
+	for i := period; i < len(values); i++ {
+		out[i] = values[i]*k + out[i-1]*(1-k)
+	}
+
+	return out
+}
+
+// rsi returns Wilder's relative strength index of values.
+func rsi(values []float64, period int) []float64 {
+	out := nanSeries(len(values))
+	if len(values) <= period {
+		return out
+	}
+
+	var avgGain, avgLoss float64
+	for i := 1; i < len(values); i++ {
+		change := values[i] - values[i-1]
+		gain, loss := max(change, 0), max(-change, 0)
+
+		if i <= period {
+			avgGain += gain / float64(period)
+			avgLoss += loss / float64(period)
+			if i < period {
+				continue
+			}
+		} else {
+			avgGain = (avgGain*float64(period-1) + gain) / float64(period)
+			avgLoss = (avgLoss*float64(period-1) + loss) / float64(period)
+		}
+
+		switch {
+		case avgLoss == 0 && avgGain == 0:
+			out[i] = 50
+		case avgLoss == 0:
+			out[i] = 100
+		default:
+			out[i] = 100 - 100/(1+avgGain/avgLoss)
+		}
+	}
+
+	return out
+}
+
+func nanSeries(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = math.NaN()
+	}
+
+	return out
+}
+
+// indicatorCache holds computed indicators until their symbol's last stored quote changes.
+type indicatorCache struct {
+	mu      sync.Mutex
+	entries map[string]indicatorCacheEntry
+}
+
+type indicatorCacheEntry struct {
+	lastBar    time.Time
+	indicators []*domain.Indicator
+}
+
+func newIndicatorCache() *indicatorCache {
+	return &indicatorCache{entries: make(map[string]indicatorCacheEntry)}
+}
+
+func (c *indicatorCache) get(key string, lastBar time.Time) ([]*domain.Indicator, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !entry.lastBar.Equal(lastBar) {
+		return nil, false
+	}
+
+	return entry.indicators, true
+}
+
+func (c *indicatorCache) put(key string, lastBar time.Time, indicators []*domain.Indicator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxIndicatorCacheEntries {
+		clear(c.entries)
+	}
+	c.entries[key] = indicatorCacheEntry{lastBar: lastBar, indicators: indicators}
+}
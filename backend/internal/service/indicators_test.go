@@ -0,0 +1,151 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+func quoteSeries(symbol string, start time.Time, prices ...float64) []*domain.Quote {
+	quotes := make([]*domain.Quote, len(prices))
+	for i, p := range prices {
+		quotes[i] = &domain.Quote{
+			Symbol:    symbol,
+			Price:     decimal.NewFromFloat(p),
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+		}
+	}
+
+	return quotes
+}
+
+func pointValues(points []domain.IndicatorPoint) []float64 {
+	values := make([]float64, len(points))
+	for i, p := range points {
+		values[i] = p.Value
+	}
+
+	return values
+}
+
+func TestParseIndicatorTypes(t *testing.T) {
+	types, err := service.ParseIndicatorTypes(" SMA,rsi,,sma ")
+	require.NoError(t, err)
+	assert.Equal(t, []string{domain.IndicatorSMA, domain.IndicatorRSI}, types)
+
+	_, err = service.ParseIndicatorTypes("sma,vwap")
+	assert.ErrorIs(t, err, apperrors.ErrInvalidIndicator)
+
+	_, err = service.ParseIndicatorTypes("")
+	assert.ErrorIs(t, err, apperrors.ErrInvalidIndicator)
+}
+
+func TestMarketService_GetIndicators(t *testing.T) {
+	const symbol = "AAPL"
+	ctx := context.Background()
+	start := time.Date(2026, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	newService := func() (*service.Market, *mocks.MockHistoryRepository) {
+		historyRepo := new(mocks.MockHistoryRepository)
+		ladderRepo := new(mocks.MockLadderRepository)
+		ladderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+		ladderRepo.On("GetAllowedTickers", ctx, int64(1)).Return([]*domain.TickerInfo{{Symbol: symbol}}, nil)
+
+		return service.NewMarket(new(mocks.MockMarketRepository), historyRepo, ladderRepo, nil), historyRepo
+	}
+
+	t.Run("Values", func(t *testing.T) {
+		s, historyRepo := newService()
+		rising := quoteSeries(symbol, start, 1, 2, 3, 4, 5)
+		historyRepo.On("GetLatestQuotes", ctx, []string{symbol}).Return(rising[4:], nil)
+		historyRepo.On("GetHistory", ctx, symbol, mock.Anything).Return(rising, nil)
+
+		indicators, err := s.GetIndicators(ctx, symbol, []string{
+			domain.IndicatorSMA, domain.IndicatorEMA, domain.IndicatorRSI, domain.IndicatorBollinger,
+		}, 3, 0)
+		require.NoError(t, err)
+		require.Len(t, indicators, 4)
+
+		sma := indicators[0]
+		assert.Equal(t, 3, sma.Period)
+		assert.Equal(t, []float64{2, 3, 4}, pointValues(sma.Points))
+		assert.Equal(t, rising[2].Timestamp, sma.Points[0].Timestamp)
+
+		// Seeded with the SMA of 1, 2, 3, then smoothed with k = 0.5.
+		assert.InDeltaSlice(t, []float64{2, 3, 4}, pointValues(indicators[1].Points), 1e-9)
+
+		// Prices only rise, so there are no losses.
+		assert.Equal(t, []float64{100, 100}, pointValues(indicators[2].Points))
+
+		bollinger := indicators[3].Points[0]
+		assert.Equal(t, 2.0, bollinger.Value)
+		assert.InDelta(t, 2+2*0.816497, bollinger.Upper, 1e-6)
+		assert.InDelta(t, 2-2*0.816497, bollinger.Lower, 1e-6)
+	})
+
+	t.Run("MACD", func(t *testing.T) {
+		s, historyRepo := newService()
+		prices := make([]float64, 60)
+		for i := range prices {
+			prices[i] = 100
+		}
+		flat := quoteSeries(symbol, start, prices...)
+		historyRepo.On("GetLatestQuotes", ctx, []string{symbol}).Return(flat[59:], nil)
+		historyRepo.On("GetHistory", ctx, symbol, 10+3*26+9).Return(flat, nil)
+
+		indicators, err := s.GetIndicators(ctx, symbol, []string{domain.IndicatorMACD}, 50, 10)
+		require.NoError(t, err)
+
+		macd := indicators[0]
+		assert.Equal(t, 0, macd.Period)
+		require.Len(t, macd.Points, 10)
+		assert.Equal(t, flat[59].Timestamp, macd.Points[9].Timestamp)
+		assert.InDelta(t, 0, macd.Points[9].Histogram, 1e-9)
+		historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("CachedUntilNewQuote", func(t *testing.T) {
+		s, historyRepo := newService()
+		quotes := quoteSeries(symbol, start, 1, 2, 3, 4)
+		historyRepo.On("GetLatestQuotes", ctx, []string{symbol}).Return(quotes[2:3], nil).Twice()
+		historyRepo.On("GetHistory", ctx, symbol, mock.Anything).Return(quotes[:3], nil).Once()
+
+		for range 2 {
+			indicators, err := s.GetIndicators(ctx, symbol, []string{domain.IndicatorSMA}, 2, 0)
+			require.NoError(t, err)
+			assert.Equal(t, []float64{1.5, 2.5}, pointValues(indicators[0].Points))
+		}
+
+		historyRepo.On("GetLatestQuotes", ctx, []string{symbol}).Return(quotes[3:], nil).Once()
+		historyRepo.On("GetHistory", ctx, symbol, mock.Anything).Return(quotes, nil).Once()
+
+		indicators, err := s.GetIndicators(ctx, symbol, []string{domain.IndicatorSMA}, 2, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []float64{1.5, 2.5, 3.5}, pointValues(indicators[0].Points))
+		historyRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidPeriod", func(t *testing.T) {
+		s, _ := newService()
+
+		_, err := s.GetIndicators(ctx, symbol, []string{domain.IndicatorSMA}, 1, 0)
+		assert.ErrorIs(t, err, apperrors.ErrInvalidIndicatorPeriod)
+	})
+
+	t.Run("SymbolNotAllowed", func(t *testing.T) {
+		s, _ := newService()
+
+		_, err := s.GetIndicators(ctx, "MSFT", []string{domain.IndicatorSMA}, 0, 0)
+		assert.ErrorIs(t, err, apperrors.ErrSymbolNotAllowed)
+	})
+}
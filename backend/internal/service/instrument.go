@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const (
+	defaultInstrumentSearchLimit = 20
+	maxInstrumentSearchLimit     = 50
+)
+
+// InstrumentRepository defines the interface for the instrument catalogue.
+type InstrumentRepository interface {
+	SaveInstrument(ctx context.Context, instrument *domain.Instrument) error
+	SearchInstruments(ctx context.Context, query string, limit int) ([]*domain.Instrument, error)
+	GetTrackedTickers(ctx context.Context) ([]*domain.TickerInfo, error)
+}
+
+// InstrumentProfileProvider looks up the metadata of a symbol at a market data provider.
+type InstrumentProfileProvider interface {
+	GetProfile(ctx context.Context, symbol string) (*domain.Instrument, error)
+}
+
+// Instrument handles the instrument catalogue.
+type Instrument struct {
+	instrumentRepo InstrumentRepository
+}
+
+// NewInstrument creates a new instance of Instrument.
+func NewInstrument(instrumentRepo InstrumentRepository) *Instrument {
+	return &Instrument{
+		instrumentRepo: instrumentRepo,
+	}
+}
+
+// Search returns the instruments whose symbol or name starts with query, followed by fuzzy
+// matches. A non-positive limit selects the default; larger limits are capped.
+func (s *Instrument) Search(ctx context.Context, query string, limit int) ([]*domain.Instrument, error) {
+	if limit <= 0 {
+		limit = defaultInstrumentSearchLimit
+	}
+	limit = min(limit, maxInstrumentSearchLimit)
+
+	return s.instrumentRepo.SearchInstruments(ctx, strings.TrimSpace(query), limit)
+}
+
+// Sync stores the seed instruments, then fetches profiles from the provider of their source
+// for tracked tickers the seed does not cover. Tickers whose profile cannot be fetched are
+// logged and skipped. It returns the number of instruments saved.
+func (s *Instrument) Sync(
+	ctx context.Context,
+	seed []*domain.Instrument,
+	providers map[string]InstrumentProfileProvider,
+) (int, error) {
+	seeded := make(map[string]bool, len(seed))
+	for _, instrument := range seed {
+		if err := s.instrumentRepo.SaveInstrument(ctx, instrument); err != nil {
+			return len(seeded), err
+		}
+		seeded[instrument.Symbol] = true
+	}
+
+	if len(providers) == 0 {
+		return len(seeded), nil
+	}
+
+	tickers, err := s.instrumentRepo.GetTrackedTickers(ctx)
+	if err != nil {
+		return len(seeded), err
+	}
+
+	saved := len(seeded)
+	for _, ticker := range tickers {
+		if seeded[ticker.Symbol] {
+			continue
+		}
+
+		provider, ok := providers[ticker.Source]
+		if !ok {
+			log.Printf("[Instruments] No profile provider for %s (source %s)", ticker.Symbol, ticker.Source)
+
+			continue
+		}
+
+		instrument, err := provider.GetProfile(ctx, ticker.Symbol)
+		if err != nil {
+			log.Printf("[Instruments] Failed to fetch profile of %s: %v", ticker.Symbol, err)
+
+			continue
+		}
+		instrument.Symbol = ticker.Symbol
+		instrument.Source = ticker.Source
+
+		if err := s.instrumentRepo.SaveInstrument(ctx, instrument); err != nil {
+			return saved, err
+		}
+		saved++
+	}
+
+	return saved, nil
+}
@@ -0,0 +1,70 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+func TestInstrumentService_Search(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("TrimsQueryAndDefaultsLimit", func(t *testing.T) {
+		mockRepo := new(mocks.MockInstrumentRepository)
+		mockRepo.On("SearchInstruments", ctx, "app", 20).Return([]*domain.Instrument{{Symbol: "AAPL"}}, nil)
+
+		instruments, err := service.NewInstrument(mockRepo).Search(ctx, "  app ", 0)
+
+		require.NoError(t, err)
+		assert.Len(t, instruments, 1)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("CapsLimit", func(t *testing.T) {
+		mockRepo := new(mocks.MockInstrumentRepository)
+		mockRepo.On("SearchInstruments", ctx, "", 50).Return([]*domain.Instrument{}, nil)
+
+		_, err := service.NewInstrument(mockRepo).Search(ctx, "", 1000)
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestInstrumentService_Sync(t *testing.T) {
+	ctx := context.Background()
+
+	mockRepo := new(mocks.MockInstrumentRepository)
+	finnhub := new(mocks.MockInstrumentProfileProvider)
+
+	seed := []*domain.Instrument{{Symbol: "AAPL", Source: "Finnhub", Name: "Apple Inc."}}
+	mockRepo.On("SaveInstrument", ctx, seed[0]).Return(nil).Once()
+	mockRepo.On("GetTrackedTickers", ctx).Return([]*domain.TickerInfo{
+		{Symbol: "AAPL", Source: "Finnhub"},
+		{Symbol: "MSFT", Source: "Finnhub"},
+		{Symbol: "TSLA", Source: "Finnhub"},
+		{Symbol: "bitcoin", Source: "CoinGecko"},
+	}, nil)
+	finnhub.On("GetProfile", ctx, "MSFT").Return(&domain.Instrument{Name: "Microsoft Corp"}, nil)
+	finnhub.On("GetProfile", ctx, "TSLA").Return(nil, errors.New("not found"))
+	mockRepo.On("SaveInstrument", ctx, mock.MatchedBy(func(i *domain.Instrument) bool {
+		return i.Symbol == "MSFT" && i.Source == "Finnhub" && i.Name == "Microsoft Corp"
+	})).Return(nil).Once()
+
+	saved, err := service.NewInstrument(mockRepo).Sync(ctx, seed, map[string]service.InstrumentProfileProvider{
+		"Finnhub": finnhub,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, saved)
+	mockRepo.AssertExpectations(t)
+	finnhub.AssertExpectations(t)
+}
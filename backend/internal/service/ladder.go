@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -23,17 +24,21 @@ type LadderRepository interface {
 	InsertLadderParticipant(ctx context.Context, ladderID int64, userID int64, finalBalance decimal.Decimal, finalRank int32) error
 	PruneLadderParticipants(ctx context.Context, ladderID int64, rankThreshold int32) error
 	DeleteLadderPortfolioItemsByLadder(ctx context.Context, ladderID int64) error
+	GetHeldSymbols(ctx context.Context, ladderID int64) ([]string, error)
+	GetBasketComponents(ctx context.Context, ladderID int64) ([]*domain.TickerInfo, error)
 }
 
 // Ladder handles ladder-related business logic.
 type Ladder struct {
 	ladderRepo LadderRepository
+	fxRates    *FXRates
 }
 
 // NewLadder creates a new instance of Ladder.
-func NewLadder(ladderRepo LadderRepository) *Ladder {
+func NewLadder(ladderRepo LadderRepository, fxRates *FXRates) *Ladder {
 	return &Ladder{
 		ladderRepo: ladderRepo,
+		fxRates:    fxRates,
 	}
 }
 
@@ -52,14 +57,31 @@ func (s *Ladder) GetAllowedTickers(ctx context.Context) ([]*domain.TickerInfo, e
 	return s.ladderRepo.GetAllowedTickers(ctx, ladderID)
 }
 
-// GetActiveLadder retrieves full metadata for the currently active ladder.
+// GetActiveLadder retrieves full metadata for the currently active ladder. Its tickers carry
+// the current FX rates into the base currency, or none where no rate is available.
 func (s *Ladder) GetActiveLadder(ctx context.Context) (*domain.Ladder, error) {
 	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.ladderRepo.GetLadder(ctx, ladderID)
+	l, err := s.ladderRepo.GetLadder(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range l.AllowedTickers {
+		ticker := &l.AllowedTickers[i]
+		rate, errRate := s.fxRates.Rate(ctx, ticker.PriceCurrency(), l.Currency())
+		if errRate != nil {
+			log.Printf("[Ladder] No FX rate for %s: %v", ticker.Symbol, errRate)
+
+			continue
+		}
+		ticker.FXRate = rate
+	}
+
+	return l, nil
 }
 
 // JoinLadder adds the user to the active ladder.
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
@@ -30,6 +31,8 @@ type Leaderboard struct {
 	marketRepo      MarketRepository
 	leaderboardRepo LeaderboardRepository
 	ladderRepo      LadderRepository
+	fxRates         *FXRates
+	predictions     *Predictions
 }
 
 // NewLeaderboard creates a new instance of Leaderboard with required dependencies.
@@ -39,6 +42,8 @@ func NewLeaderboard(
 	marketRepo MarketRepository,
 	ladderRepo LadderRepository,
 	leaderboardRepo LeaderboardRepository,
+	fxRates *FXRates,
+	predictions *Predictions,
 ) *Leaderboard {
 	return &Leaderboard{
 		userRepo:        userRepo,
@@ -46,27 +51,44 @@ func NewLeaderboard(
 		marketRepo:      marketRepo,
 		leaderboardRepo: leaderboardRepo,
 		ladderRepo:      ladderRepo,
+		fxRates:         fxRates,
+		predictions:     predictions,
 	}
 }
 
-// UpdateLeaderboard recalculates the net worth of all users and updates the Redis Sorted Set for the active ladder.
+// UpdateLeaderboard recalculates the net worth of all users in the ladder's base currency,
+// including their open prediction positions, and updates the Redis Sorted Set for the active ladder.
+// If a position cannot be converted into the base currency, no score is updated and the
+// previous ranking stays in place, rather than ranking its holder without it.
 func (s *Leaderboard) UpdateLeaderboard(ctx context.Context) error {
 	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
 	if err != nil {
 		return err
 	}
+	ladder, err := s.ladderRepo.GetLadder(ctx, ladderID)
+	if err != nil {
+		return err
+	}
 	users, err := s.userRepo.GetUsers(ctx)
 	if err != nil {
 		return err
 	}
 
+	predictionValues, err := s.predictions.OpenPositionValues(ctx, ladderID)
+	if err != nil {
+		return err
+	}
+
+	valuation := s.fxRates.NewValuation(ladder)
+
+	scores := make([]LeaderboardScore, 0, len(users))
 	for _, u := range users {
 		balance, errBalance := s.userRepo.GetUserBalance(ctx, u.ID, ladderID)
 		if errBalance != nil {
 			continue
 		}
 
-		totalWorth := balance
+		totalWorth := balance.Add(predictionValues[u.ID])
 
 		portfolio, errPortfolio := s.portfolioRepo.GetPortfolio(ctx, u.ID, ladderID)
 		if errPortfolio != nil {
@@ -79,12 +101,19 @@ func (s *Leaderboard) UpdateLeaderboard(ctx context.Context) error {
 				continue
 			}
 
-			itemValue := quote.Price.Mul(item.Quantity)
+			itemValue, errValue := valuation.Value(ctx, item.StockSymbol, quote.Price, item.Quantity)
+			if errValue != nil {
+				return fmt.Errorf("failed to value %s of user %d: %w", item.StockSymbol, u.ID, errValue)
+			}
 			totalWorth = totalWorth.Add(itemValue)
 		}
 
 		scoreVal, _ := totalWorth.Float64()
-		err = s.leaderboardRepo.UpdateRank(ctx, ladderID, u.ID, scoreVal)
+		scores = append(scores, LeaderboardScore{UserID: u.ID, Score: scoreVal})
+	}
+
+	for _, score := range scores {
+		err = s.leaderboardRepo.UpdateRank(ctx, ladderID, score.UserID, score.Score)
 		if err != nil {
 			continue
 		}
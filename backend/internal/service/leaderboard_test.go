@@ -3,12 +3,14 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
 	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
 	"github.com/tmythicator/ticker-rush/backend/internal/service"
@@ -32,7 +34,12 @@ func TestLeaderBoardService_UpdateLeaderboard(t *testing.T) {
 	mockLadderRepo := new(mocks.MockLadderRepository)
 	leaderboardRepo := redisRepo.NewLeaderboardRepository(redisClient)
 
-	lbService := service.NewLeaderboard(mockUserRepo, mockPortfolioRepo, mockMarketRepo, mockLadderRepo, leaderboardRepo)
+	mockPredictionRepo := new(mocks.MockPredictionRepository)
+	predictions := service.NewPredictions(mockPredictionRepo, mockUserRepo, nil, mockLadderRepo, nil, 100, time.Minute)
+
+	lbService := service.NewLeaderboard(
+		mockUserRepo, mockPortfolioRepo, mockMarketRepo, mockLadderRepo, leaderboardRepo, service.NewFXRates(nil, 0), predictions,
+	)
 
 	ctx := context.Background()
 
@@ -52,6 +59,7 @@ func TestLeaderBoardService_UpdateLeaderboard(t *testing.T) {
 	quoteGOOG := &domain.Quote{Symbol: "GOOG", Price: decimal.NewFromFloat(200.0)}
 
 	mockLadderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+	mockLadderRepo.On("GetLadder", ctx, int64(1)).Return(&domain.Ladder{ID: 1}, nil)
 	mockUserRepo.On("GetUsers", ctx).Return(users, nil)
 
 	mockUserRepo.On("GetUserBalance", ctx, int64(1), int64(1)).Return(decimal.NewFromFloat(1000.0), nil)
@@ -63,21 +71,79 @@ func TestLeaderBoardService_UpdateLeaderboard(t *testing.T) {
 	mockMarketRepo.On("GetQuote", ctx, "AAPL").Return(quoteAAPL, nil)
 	mockMarketRepo.On("GetQuote", ctx, "GOOG").Return(quoteGOOG, nil)
 
+	// Alice holds 400 YES shares of a balanced prediction market, trading at 0.5.
+	mockPredictionRepo.On("ListOpenPredictionPositions", ctx, int64(1)).Return([]*domain.PredictionPosition{
+		{MarketID: 5, UserID: 1, YesShares: decimal.NewFromInt(400)},
+	}, nil)
+	mockPredictionRepo.On("ListPredictionMarkets", ctx, int64(1)).Return([]*domain.PredictionMarket{
+		{ID: 5, LadderID: 1, Liquidity: decimal.NewFromInt(100)},
+	}, nil)
+
 	err = lbService.UpdateLeaderboard(ctx)
 	assert.NoError(t, err)
 
-	// Alice: 1000 + (10 * 150) = 1000 + 1500 = 2500
+	// Alice: 1000 + (10 * 150) + (400 * 0.5) = 1000 + 1500 + 200 = 2700
 	// Bob:   2000 + (5 * 200) = 2000 + 1000 = 3000
 
 	scoreAlice, err := redisClient.ZScore(ctx, "leaderboard:1", "1").Result()
 	assert.NoError(t, err)
-	assert.Equal(t, 2500.0, scoreAlice)
+	assert.Equal(t, 2700.0, scoreAlice)
 
 	scoreBob, err := redisClient.ZScore(ctx, "leaderboard:1", "2").Result()
 	assert.NoError(t, err)
 	assert.Equal(t, 3000.0, scoreBob)
 }
 
+func TestLeaderBoardService_UpdateLeaderboard_MissingFXRate(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+
+	mockUserRepo := new(mocks.MockUserRepository)
+	mockPortfolioRepo := new(mocks.MockPortfolioRepository)
+	mockMarketRepo := new(mocks.MockMarketRepository)
+	mockLadderRepo := new(mocks.MockLadderRepository)
+	mockPredictionRepo := new(mocks.MockPredictionRepository)
+	leaderboardRepo := redisRepo.NewLeaderboardRepository(redisClient)
+	fxRates := service.NewFXRates(redisRepo.NewFXRateRepository(redisClient), time.Hour)
+	predictions := service.NewPredictions(mockPredictionRepo, mockUserRepo, nil, mockLadderRepo, nil, 100, time.Minute)
+
+	lbService := service.NewLeaderboard(
+		mockUserRepo, mockPortfolioRepo, mockMarketRepo, mockLadderRepo, leaderboardRepo, fxRates, predictions,
+	)
+
+	ctx := context.Background()
+
+	// There is no EUR rate, so SAP cannot be converted into the ladder's base currency.
+	mockLadderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+	mockLadderRepo.On("GetLadder", ctx, int64(1)).Return(&domain.Ladder{ID: 1, AllowedTickers: []domain.TickerInfo{
+		{Symbol: "FH:SAP", Currency: "EUR"},
+	}}, nil)
+	mockUserRepo.On("GetUsers", ctx).Return([]*domain.User{{ID: 1}}, nil)
+	mockUserRepo.On("GetUserBalance", ctx, int64(1), int64(1)).Return(decimal.NewFromInt(1000), nil)
+	mockPortfolioRepo.On("GetPortfolio", ctx, int64(1), int64(1)).Return([]*domain.PortfolioItem{
+		{StockSymbol: "FH:SAP", Quantity: decimal.NewFromInt(10)},
+	}, nil)
+	mockMarketRepo.On("GetQuote", ctx, "FH:SAP").Return(&domain.Quote{Symbol: "FH:SAP", Price: decimal.NewFromInt(200)}, nil)
+	mockPredictionRepo.On("ListOpenPredictionPositions", ctx, int64(1)).Return([]*domain.PredictionPosition{}, nil)
+
+	assert.NoError(t, leaderboardRepo.UpdateRank(ctx, 1, 1, 3000))
+
+	err = lbService.UpdateLeaderboard(ctx)
+	assert.ErrorIs(t, err, apperrors.ErrFXRateUnavailable)
+
+	// The previous score is kept instead of dropping the SAP position.
+	score, err := redisClient.ZScore(ctx, "leaderboard:1", "1").Result()
+	assert.NoError(t, err)
+	assert.Equal(t, 3000.0, score)
+}
+
 func TestLeaderBoardService_GetLeaderboard(t *testing.T) {
 	mr, err := miniredis.Run()
 	if err != nil {
@@ -92,7 +158,7 @@ func TestLeaderBoardService_GetLeaderboard(t *testing.T) {
 	mockUserRepo := new(mocks.MockUserRepository)
 	mockLadderRepo := new(mocks.MockLadderRepository)
 	leaderboardRepo := redisRepo.NewLeaderboardRepository(redisClient)
-	lbService := service.NewLeaderboard(mockUserRepo, nil, nil, mockLadderRepo, leaderboardRepo)
+	lbService := service.NewLeaderboard(mockUserRepo, nil, nil, mockLadderRepo, leaderboardRepo, service.NewFXRates(nil, 0), nil)
 
 	ctx := context.Background()
 
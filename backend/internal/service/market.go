@@ -2,9 +2,9 @@ package service
 
 import (
 	"context"
+	"maps"
 	"slices"
-
-	"github.com/redis/go-redis/v9"
+	"time"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
@@ -13,14 +13,20 @@ import (
 // MarketRepository defines the interface for market data persistence.
 type MarketRepository interface {
 	GetQuote(ctx context.Context, symbol string) (*domain.Quote, error)
+	GetQuotes(ctx context.Context, symbols []string) (map[string]*domain.Quote, error)
 	SaveQuote(ctx context.Context, quote *domain.Quote) error
-	SubscribeToQuotes(ctx context.Context, symbol string) *redis.PubSub
+	SaveQuoteFenced(ctx context.Context, quote *domain.Quote, fence domain.Fence) error
+	RestoreQuote(ctx context.Context, quote *domain.Quote) (bool, error)
+	QuotesSince(ctx context.Context, symbols []string, lastID string) ([]*domain.Quote, error)
+	CountSubscribers(ctx context.Context, symbols []string) (map[string]int64, error)
 }
 
 // HistoryRepository defines the interface for historical market data persistence.
 type HistoryRepository interface {
 	SaveQuote(ctx context.Context, quote *domain.Quote) error
 	GetHistory(ctx context.Context, symbol string, limit int) ([]*domain.Quote, error)
+	GetLatestQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error)
+	GetQuoteAt(ctx context.Context, symbol string, at time.Time) (*domain.Quote, error)
 }
 
 // Market handles stock market data operations.
@@ -28,6 +34,9 @@ type Market struct {
 	marketRepo  MarketRepository
 	historyRepo HistoryRepository
 	ladderRepo  LadderRepository
+	quoteHub    *QuoteHub
+
+	indicatorCache *indicatorCache
 }
 
 // NewMarket creates a new instance of Market.
@@ -35,11 +44,15 @@ func NewMarket(
 	marketRepo MarketRepository,
 	historyRepo HistoryRepository,
 	ladderRepo LadderRepository,
+	quoteHub *QuoteHub,
 ) *Market {
 	return &Market{
 		marketRepo:  marketRepo,
 		historyRepo: historyRepo,
 		ladderRepo:  ladderRepo,
+		quoteHub:    quoteHub,
+
+		indicatorCache: newIndicatorCache(),
 	}
 }
 
@@ -56,20 +69,66 @@ func (s *Market) GetQuote(ctx context.Context, symbol string) (*domain.Quote, er
 	return s.marketRepo.GetQuote(ctx, symbol)
 }
 
-// SubscribeToQuotes returns a PubSub for real-time quotes, if allowed.
+// SubscribeToQuotes subscribes to real-time quotes for the given symbols through the
+// shared quote hub, if all of them are allowed. Without symbols it streams every ticker
+// of the active ladder, following RefreshStreamTickers.
 func (s *Market) SubscribeToQuotes(
 	ctx context.Context,
-	symbol string,
-) (*redis.PubSub, error) {
-	allowed, err := s.isSymbolAllowed(ctx, symbol)
+	symbols ...string,
+) (*QuoteSubscription, error) {
+	allowed, err := s.allowedSymbols(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if !allowed {
-		return nil, apperrors.ErrSymbolNotAllowed
+
+	if len(symbols) == 0 {
+		// Expand to the ladder's tickers so every upstream channel is counted as demand.
+		return s.quoteHub.SubscribeAll(slices.Sorted(maps.Keys(allowed))...)
+	}
+
+	symbols = slices.Compact(slices.Sorted(slices.Values(symbols)))
+	for _, symbol := range symbols {
+		if _, ok := allowed[symbol]; !ok {
+			return nil, apperrors.ErrSymbolNotAllowed
+		}
+	}
+
+	return s.quoteHub.Subscribe(symbols...)
+}
+
+// RefreshStreamTickers moves the clients streaming every ticker to the tickers of the
+// active ladder, which change as ladders start and end.
+func (s *Market) RefreshStreamTickers(ctx context.Context) error {
+	if !s.quoteHub.StreamsAllTickers() {
+		return nil
+	}
+
+	allowed, err := s.allowedSymbols(ctx)
+	if err != nil {
+		return err
+	}
+	s.quoteHub.SetAllTickers(slices.Sorted(maps.Keys(allowed))...)
+
+	return nil
+}
+
+// MissedQuotes returns the quotes published after lastEventID for the given symbols, or for
+// every ticker of the active ladder if none are given, so that a reconnecting stream can catch
+// up. The symbols are expected to have been checked by SubscribeToQuotes.
+func (s *Market) MissedQuotes(ctx context.Context, lastEventID string, symbols ...string) ([]*domain.Quote, error) {
+	if !domain.IsQuoteEventID(lastEventID) {
+		return nil, nil
+	}
+
+	if len(symbols) == 0 {
+		allowed, err := s.allowedSymbols(ctx)
+		if err != nil {
+			return nil, err
+		}
+		symbols = slices.Sorted(maps.Keys(allowed))
 	}
 
-	return s.marketRepo.SubscribeToQuotes(ctx, symbol), nil
+	return s.marketRepo.QuotesSince(ctx, symbols, lastEventID)
 }
 
 // GetHistory retrieves historical quotes for a symbol.
@@ -86,17 +145,30 @@ func (s *Market) GetHistory(ctx context.Context, symbol string, limit int) ([]*d
 }
 
 func (s *Market) isSymbolAllowed(ctx context.Context, symbol string) (bool, error) {
-	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	allowed, err := s.allowedSymbols(ctx)
 	if err != nil {
 		return false, err
 	}
+	_, ok := allowed[symbol]
+
+	return ok, nil
+}
+
+func (s *Market) allowedSymbols(ctx context.Context) (map[string]struct{}, error) {
+	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	allowedTickers, err := s.ladderRepo.GetAllowedTickers(ctx, ladderID)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+
+	allowed := make(map[string]struct{}, len(allowedTickers))
+	for _, t := range allowedTickers {
+		allowed[t.Symbol] = struct{}{}
 	}
 
-	return slices.ContainsFunc(allowedTickers, func(t *domain.TickerInfo) bool {
-		return t.Symbol == symbol
-	}), nil
+	return allowed, nil
 }
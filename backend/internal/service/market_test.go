@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
 	"github.com/tmythicator/ticker-rush/backend/internal/service"
 	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
 )
@@ -31,7 +35,7 @@ func TestMarketService_GetQuote(t *testing.T) {
 		}, nil)
 		mockMarketRepo.On("GetQuote", ctx, symbol).Return(expectedQuote, nil)
 
-		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo)
+		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo, nil)
 		q, err := s.GetQuote(ctx, symbol)
 
 		assert.NoError(t, err)
@@ -50,7 +54,7 @@ func TestMarketService_GetQuote(t *testing.T) {
 			{Symbol: "GOOG"},
 		}, nil)
 
-		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo)
+		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo, nil)
 		q, err := s.GetQuote(ctx, symbol)
 
 		assert.ErrorIs(t, err, apperrors.ErrSymbolNotAllowed)
@@ -66,7 +70,7 @@ func TestMarketService_GetQuote(t *testing.T) {
 		expectedErr := errors.New("db error")
 		mockLadderRepo.On("GetActiveLadder", ctx).Return(int64(0), expectedErr)
 
-		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo)
+		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo, nil)
 		q, err := s.GetQuote(ctx, symbol)
 
 		assert.ErrorIs(t, err, expectedErr)
@@ -79,44 +83,171 @@ func TestMarketService_SubscribeToQuotes(t *testing.T) {
 	ctx := context.Background()
 	symbol := "AAPL"
 
-	t.Run("Success", func(t *testing.T) {
-		mockMarketRepo := new(mocks.MockMarketRepository)
-		mockHistoryRepo := new(mocks.MockHistoryRepository)
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer func() { _ = redisClient.Close() }()
+
+	valkeyRepo := redisRepo.NewMarketRepository(redisClient)
+	hub := service.NewQuoteHub(valkeyRepo, 8)
+	defer hub.Close()
+
+	newLadderRepo := func(allowed ...string) *mocks.MockLadderRepository {
 		mockLadderRepo := new(mocks.MockLadderRepository)
+		tickers := make([]*domain.TickerInfo, len(allowed))
+		for i, s := range allowed {
+			tickers[i] = &domain.TickerInfo{Symbol: s}
+		}
+		mockLadderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+		mockLadderRepo.On("GetAllowedTickers", ctx, int64(1)).Return(tickers, nil)
 
-		expectedPubSub := &redis.PubSub{}
+		return mockLadderRepo
+	}
+
+	t.Run("MultipleSymbols", func(t *testing.T) {
+		mockLadderRepo := newLadderRepo(symbol, "bitcoin", "MSFT")
+
+		s := service.NewMarket(new(mocks.MockMarketRepository), new(mocks.MockHistoryRepository), mockLadderRepo, hub)
+		sub, err := s.SubscribeToQuotes(ctx, "bitcoin", symbol, "bitcoin")
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		waitForSubscribers(t, valkeyRepo, "bitcoin")
+		assert.NoError(t, valkeyRepo.SaveQuote(ctx, &domain.Quote{Symbol: "bitcoin", Price: decimal.NewFromInt(65000), Timestamp: time.Now()}))
+
+		quote := <-sub.Quotes()
+		assert.Equal(t, "bitcoin", quote.Symbol)
+		mockLadderRepo.AssertExpectations(t)
+	})
+
+	t.Run("SymbolNotAllowed", func(t *testing.T) {
+		mockLadderRepo := newLadderRepo("MSFT")
 
+		s := service.NewMarket(new(mocks.MockMarketRepository), new(mocks.MockHistoryRepository), mockLadderRepo, hub)
+		sub, err := s.SubscribeToQuotes(ctx, "MSFT", symbol)
+
+		assert.ErrorIs(t, err, apperrors.ErrSymbolNotAllowed)
+		assert.Nil(t, sub)
+		mockLadderRepo.AssertExpectations(t)
+	})
+
+	t.Run("AllSymbols", func(t *testing.T) {
+		mockLadderRepo := newLadderRepo(symbol, "MSFT")
+
+		s := service.NewMarket(new(mocks.MockMarketRepository), new(mocks.MockHistoryRepository), mockLadderRepo, hub)
+		sub, err := s.SubscribeToQuotes(ctx)
+		assert.NoError(t, err)
+		defer sub.Close()
+
+		waitForSubscribers(t, valkeyRepo, symbol)
+		waitForSubscribers(t, valkeyRepo, "MSFT")
+		n, err := redisClient.PubSubNumPat(ctx).Result()
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+
+		assert.NoError(t, valkeyRepo.SaveQuote(ctx, &domain.Quote{Symbol: "bitcoin", Price: decimal.NewFromInt(65000), Timestamp: time.Now()}))
+		assert.NoError(t, valkeyRepo.SaveQuote(ctx, &domain.Quote{Symbol: "MSFT", Price: decimal.NewFromInt(400), Timestamp: time.Now()}))
+
+		quote := <-sub.Quotes()
+		assert.Equal(t, "MSFT", quote.Symbol, "tickers outside the ladder are not streamed")
+		mockLadderRepo.AssertExpectations(t)
+	})
+
+	t.Run("AllSymbolsFollowLadderChanges", func(t *testing.T) {
+		mockLadderRepo := new(mocks.MockLadderRepository)
 		mockLadderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
 		mockLadderRepo.On("GetAllowedTickers", ctx, int64(1)).Return([]*domain.TickerInfo{
 			{Symbol: symbol},
-		}, nil)
-		mockMarketRepo.On("SubscribeToQuotes", ctx, symbol).Return(expectedPubSub)
-
-		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo)
-		pb, err := s.SubscribeToQuotes(ctx, symbol)
+			{Symbol: "MSFT"},
+		}, nil).Once()
+		mockLadderRepo.On("GetAllowedTickers", ctx, int64(1)).Return([]*domain.TickerInfo{
+			{Symbol: "MSFT"},
+			{Symbol: "bitcoin"},
+		}, nil).Once()
 
+		s := service.NewMarket(new(mocks.MockMarketRepository), new(mocks.MockHistoryRepository), mockLadderRepo, hub)
+		sub, err := s.SubscribeToQuotes(ctx)
 		assert.NoError(t, err)
-		assert.Equal(t, expectedPubSub, pb)
+		defer sub.Close()
+		waitForSubscribers(t, valkeyRepo, symbol)
+
+		// The next ladder drops AAPL and lists bitcoin.
+		assert.NoError(t, s.RefreshStreamTickers(ctx))
+		waitForSubscribers(t, valkeyRepo, "bitcoin")
+		assert.Eventually(t, func() bool {
+			counts, err := valkeyRepo.CountSubscribers(ctx, []string{symbol})
+
+			return err == nil && counts[symbol] == 0
+		}, time.Second, 5*time.Millisecond)
+
+		assert.NoError(t, valkeyRepo.SaveQuote(ctx, &domain.Quote{Symbol: symbol, Price: decimal.NewFromInt(190), Timestamp: time.Now()}))
+		assert.NoError(t, valkeyRepo.SaveQuote(ctx, &domain.Quote{Symbol: "bitcoin", Price: decimal.NewFromInt(65000), Timestamp: time.Now()}))
+
+		quote := <-sub.Quotes()
+		assert.Equal(t, "bitcoin", quote.Symbol, "the stream follows the active ladder's tickers")
 		mockLadderRepo.AssertExpectations(t)
-		mockMarketRepo.AssertExpectations(t)
 	})
+}
 
-	t.Run("SymbolNotAllowed", func(t *testing.T) {
+// waitForSubscribers blocks until the hub's upstream subscription for symbol is registered.
+func waitForSubscribers(t *testing.T, repo *redisRepo.MarketRepository, symbol string) {
+	t.Helper()
+
+	assert.Eventually(t, func() bool {
+		counts, err := repo.CountSubscribers(context.Background(), []string{symbol})
+
+		return err == nil && counts[symbol] == 1
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestMarketService_MissedQuotes(t *testing.T) {
+	ctx := context.Background()
+	missed := []*domain.Quote{{Symbol: "AAPL", EventID: "1700000000001-0"}}
+
+	t.Run("GivenSymbols", func(t *testing.T) {
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockMarketRepo.On("QuotesSince", ctx, []string{"AAPL"}, "1700000000000-0").Return(missed, nil)
+
+		s := service.NewMarket(mockMarketRepo, new(mocks.MockHistoryRepository), new(mocks.MockLadderRepository), nil)
+		quotes, err := s.MissedQuotes(ctx, "1700000000000-0", "AAPL")
+
+		assert.NoError(t, err)
+		assert.Equal(t, missed, quotes)
+	})
+
+	t.Run("AllSymbols", func(t *testing.T) {
 		mockMarketRepo := new(mocks.MockMarketRepository)
-		mockHistoryRepo := new(mocks.MockHistoryRepository)
 		mockLadderRepo := new(mocks.MockLadderRepository)
 
 		mockLadderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
 		mockLadderRepo.On("GetAllowedTickers", ctx, int64(1)).Return([]*domain.TickerInfo{
-			{Symbol: "MSFT"},
+			{Symbol: "bitcoin"}, {Symbol: "AAPL"},
 		}, nil)
+		mockMarketRepo.On("QuotesSince", ctx, []string{"AAPL", "bitcoin"}, "1700000000000-0").Return(missed, nil)
 
-		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo)
-		pb, err := s.SubscribeToQuotes(ctx, symbol)
+		s := service.NewMarket(mockMarketRepo, new(mocks.MockHistoryRepository), mockLadderRepo, nil)
+		quotes, err := s.MissedQuotes(ctx, "1700000000000-0")
 
-		assert.ErrorIs(t, err, apperrors.ErrSymbolNotAllowed)
-		assert.Nil(t, pb)
-		mockLadderRepo.AssertExpectations(t)
+		assert.NoError(t, err)
+		assert.Equal(t, missed, quotes)
+		mockMarketRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidEventID", func(t *testing.T) {
+		mockMarketRepo := new(mocks.MockMarketRepository)
+
+		s := service.NewMarket(mockMarketRepo, new(mocks.MockHistoryRepository), new(mocks.MockLadderRepository), nil)
+		quotes, err := s.MissedQuotes(ctx, "not-an-id", "AAPL")
+
+		assert.NoError(t, err)
+		assert.Empty(t, quotes)
+		mockMarketRepo.AssertNotCalled(t, "QuotesSince", ctx, []string{"AAPL"}, "not-an-id")
 	})
 }
 
@@ -140,7 +271,7 @@ func TestMarketService_GetHistory(t *testing.T) {
 		}, nil)
 		mockHistoryRepo.On("GetHistory", ctx, symbol, limit).Return(expectedHistory, nil)
 
-		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo)
+		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo, nil)
 		h, err := s.GetHistory(ctx, symbol, limit)
 
 		assert.NoError(t, err)
@@ -159,7 +290,7 @@ func TestMarketService_GetHistory(t *testing.T) {
 			{Symbol: "GOOG"},
 		}, nil)
 
-		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo)
+		s := service.NewMarket(mockMarketRepo, mockHistoryRepo, mockLadderRepo, nil)
 		h, err := s.GetHistory(ctx, symbol, limit)
 
 		assert.ErrorIs(t, err, apperrors.ErrSymbolNotAllowed)
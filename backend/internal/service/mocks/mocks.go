@@ -5,7 +5,6 @@ import (
 	"context"
 	"time"
 
-	"github.com/redis/go-redis/v9"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/mock"
 
@@ -223,6 +222,25 @@ func (m *MockPortfolioRepository) WithTx(tx service.Transaction) service.Portfol
 	return args.Get(0).(service.PortfolioRepository)
 }
 
+// MockFillRepository is a mock implementation of FillRepository.
+type MockFillRepository struct {
+	mock.Mock
+}
+
+// RecordFill records an executed trade.
+func (m *MockFillRepository) RecordFill(ctx context.Context, fill *domain.Fill) error {
+	args := m.Called(ctx, fill)
+
+	return args.Error(0)
+}
+
+// WithTx returns a new FillRepository with the transaction.
+func (m *MockFillRepository) WithTx(tx service.Transaction) service.FillRepository {
+	args := m.Called(tx)
+
+	return args.Get(0).(service.FillRepository)
+}
+
 // MockMarketRepository is a mock implementation of MarketRepository.
 type MockMarketRepository struct {
 	mock.Mock
@@ -238,6 +256,16 @@ func (m *MockMarketRepository) GetQuote(ctx context.Context, symbol string) (*do
 	return args.Get(0).(*domain.Quote), args.Error(1)
 }
 
+// GetQuotes retrieves the quotes of several stocks.
+func (m *MockMarketRepository) GetQuotes(ctx context.Context, symbols []string) (map[string]*domain.Quote, error) {
+	args := m.Called(ctx, symbols)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(map[string]*domain.Quote), args.Error(1)
+}
+
 // SaveQuote saves a stock quote.
 func (m *MockMarketRepository) SaveQuote(ctx context.Context, quote *domain.Quote) error {
 	args := m.Called(ctx, quote)
@@ -245,11 +273,38 @@ func (m *MockMarketRepository) SaveQuote(ctx context.Context, quote *domain.Quot
 	return args.Error(0)
 }
 
-// SubscribeToQuotes subscribes to quotes.
-func (m *MockMarketRepository) SubscribeToQuotes(ctx context.Context, symbol string) *redis.PubSub {
-	args := m.Called(ctx, symbol)
+// SaveQuoteFenced saves a stock quote on behalf of a lease holder.
+func (m *MockMarketRepository) SaveQuoteFenced(ctx context.Context, quote *domain.Quote, fence domain.Fence) error {
+	args := m.Called(ctx, quote, fence)
 
-	return args.Get(0).(*redis.PubSub)
+	return args.Error(0)
+}
+
+// RestoreQuote mock.
+func (m *MockMarketRepository) RestoreQuote(ctx context.Context, quote *domain.Quote) (bool, error) {
+	args := m.Called(ctx, quote)
+
+	return args.Bool(0), args.Error(1)
+}
+
+// QuotesSince mock.
+func (m *MockMarketRepository) QuotesSince(ctx context.Context, symbols []string, lastID string) ([]*domain.Quote, error) {
+	args := m.Called(ctx, symbols, lastID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Quote), args.Error(1)
+}
+
+// CountSubscribers mock.
+func (m *MockMarketRepository) CountSubscribers(ctx context.Context, symbols []string) (map[string]int64, error) {
+	args := m.Called(ctx, symbols)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(map[string]int64), args.Error(1)
 }
 
 // MockLadderRepository is a mock implementation of LadderRepository.
@@ -356,6 +411,26 @@ func (m *MockLadderRepository) DeleteLadderPortfolioItemsByLadder(ctx context.Co
 	return args.Error(0)
 }
 
+// GetHeldSymbols mock.
+func (m *MockLadderRepository) GetHeldSymbols(ctx context.Context, ladderID int64) ([]string, error) {
+	args := m.Called(ctx, ladderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// GetBasketComponents mock.
+func (m *MockLadderRepository) GetBasketComponents(ctx context.Context, ladderID int64) ([]*domain.TickerInfo, error) {
+	args := m.Called(ctx, ladderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.TickerInfo), args.Error(1)
+}
+
 // MockLeaderboardRepository is a mock implementation of LeaderboardRepository.
 type MockLeaderboardRepository struct {
 	mock.Mock
@@ -427,3 +502,414 @@ func (m *MockHistoryRepository) GetHistory(ctx context.Context, symbol string, l
 
 	return args.Get(0).([]*domain.Quote), args.Error(1)
 }
+
+// GetLatestQuotes mock.
+func (m *MockHistoryRepository) GetLatestQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error) {
+	args := m.Called(ctx, symbols)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Quote), args.Error(1)
+}
+
+// GetQuoteAt mock.
+func (m *MockHistoryRepository) GetQuoteAt(ctx context.Context, symbol string, at time.Time) (*domain.Quote, error) {
+	args := m.Called(ctx, symbol, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Quote), args.Error(1)
+}
+
+// MockQuarantineRepository is a mock implementation of QuarantineRepository.
+type MockQuarantineRepository struct {
+	mock.Mock
+}
+
+// Quarantine mock.
+func (m *MockQuarantineRepository) Quarantine(ctx context.Context, entry *domain.QuarantinedQuote) error {
+	args := m.Called(ctx, entry)
+
+	return args.Error(0)
+}
+
+// ListQuarantined mock.
+func (m *MockQuarantineRepository) ListQuarantined(ctx context.Context) ([]*domain.QuarantinedQuote, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.QuarantinedQuote), args.Error(1)
+}
+
+// TakeQuarantined mock.
+func (m *MockQuarantineRepository) TakeQuarantined(ctx context.Context, id string) (*domain.QuarantinedQuote, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.QuarantinedQuote), args.Error(1)
+}
+
+// MockInstrumentRepository is a mock implementation of InstrumentRepository.
+type MockInstrumentRepository struct {
+	mock.Mock
+}
+
+// SaveInstrument mock.
+func (m *MockInstrumentRepository) SaveInstrument(ctx context.Context, instrument *domain.Instrument) error {
+	args := m.Called(ctx, instrument)
+
+	return args.Error(0)
+}
+
+// SearchInstruments mock.
+func (m *MockInstrumentRepository) SearchInstruments(ctx context.Context, query string, limit int) ([]*domain.Instrument, error) {
+	args := m.Called(ctx, query, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Instrument), args.Error(1)
+}
+
+// GetTrackedTickers mock.
+func (m *MockInstrumentRepository) GetTrackedTickers(ctx context.Context) ([]*domain.TickerInfo, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.TickerInfo), args.Error(1)
+}
+
+// MockInstrumentProfileProvider is a mock implementation of InstrumentProfileProvider.
+type MockInstrumentProfileProvider struct {
+	mock.Mock
+}
+
+// GetProfile mock.
+func (m *MockInstrumentProfileProvider) GetProfile(ctx context.Context, symbol string) (*domain.Instrument, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Instrument), args.Error(1)
+}
+
+// MockCandleProvider is a mock implementation of CandleProvider.
+type MockCandleProvider struct {
+	mock.Mock
+}
+
+// GetCandles mock.
+func (m *MockCandleProvider) GetCandles(
+	ctx context.Context,
+	symbol string,
+	resolution time.Duration,
+	from, to time.Time,
+) ([]*domain.Candle, error) {
+	args := m.Called(ctx, symbol, resolution, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Candle), args.Error(1)
+}
+
+// CandleWindow mock.
+func (m *MockCandleProvider) CandleWindow(resolution time.Duration) time.Duration {
+	args := m.Called(resolution)
+
+	return args.Get(0).(time.Duration)
+}
+
+// MockBackfillRepository is a mock implementation of BackfillRepository.
+type MockBackfillRepository struct {
+	mock.Mock
+}
+
+// GetBackfillCheckpoint mock.
+func (m *MockBackfillRepository) GetBackfillCheckpoint(
+	ctx context.Context,
+	symbol string,
+	resolution time.Duration,
+	rangeStart time.Time,
+) (time.Time, error) {
+	args := m.Called(ctx, symbol, resolution, rangeStart)
+
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+// LoadCandles mock.
+func (m *MockBackfillRepository) LoadCandles(
+	ctx context.Context,
+	candles []*domain.Candle,
+	checkpoint *domain.BackfillCheckpoint,
+) (int64, error) {
+	args := m.Called(ctx, candles, checkpoint)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// MockBasketRepository is a mock implementation of BasketRepository.
+type MockBasketRepository struct {
+	mock.Mock
+}
+
+// CreateBasket mock.
+func (m *MockBasketRepository) CreateBasket(ctx context.Context, basket *domain.Basket) error {
+	args := m.Called(ctx, basket)
+
+	return args.Error(0)
+}
+
+// ListBaskets mock.
+func (m *MockBasketRepository) ListBaskets(ctx context.Context) ([]*domain.Basket, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Basket), args.Error(1)
+}
+
+// ListIndices mock.
+func (m *MockBasketRepository) ListIndices(ctx context.Context, offset, limit int) ([]*domain.Basket, error) {
+	args := m.Called(ctx, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Basket), args.Error(1)
+}
+
+// DeleteBasket mock.
+func (m *MockBasketRepository) DeleteBasket(ctx context.Context, symbol string) error {
+	args := m.Called(ctx, symbol)
+
+	return args.Error(0)
+}
+
+// GetBasket mock.
+func (m *MockBasketRepository) GetBasket(ctx context.Context, symbol string) (*domain.Basket, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.Basket), args.Error(1)
+}
+
+// CountBasketsByOwner mock.
+func (m *MockBasketRepository) CountBasketsByOwner(ctx context.Context, ownerID int64) (int64, error) {
+	args := m.Called(ctx, ownerID)
+
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// RebalanceBasket mock.
+func (m *MockBasketRepository) RebalanceBasket(ctx context.Context, basket *domain.Basket) error {
+	args := m.Called(ctx, basket)
+
+	return args.Error(0)
+}
+
+// FollowBasket mock.
+func (m *MockBasketRepository) FollowBasket(ctx context.Context, symbol string, userID int64) error {
+	args := m.Called(ctx, symbol, userID)
+
+	return args.Error(0)
+}
+
+// UnfollowBasket mock.
+func (m *MockBasketRepository) UnfollowBasket(ctx context.Context, symbol string, userID int64) error {
+	args := m.Called(ctx, symbol, userID)
+
+	return args.Error(0)
+}
+
+// ListBasketFollowers mock.
+func (m *MockBasketRepository) ListBasketFollowers(ctx context.Context, symbol string) ([]string, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// GetInstrumentCurrencies mock.
+func (m *MockBasketRepository) GetInstrumentCurrencies(ctx context.Context, symbols []string) (map[string]string, error) {
+	args := m.Called(ctx, symbols)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
+// MockOptionRepository is a mock implementation of OptionRepository.
+type MockOptionRepository struct {
+	mock.Mock
+}
+
+// CreateOptionContract mock.
+func (m *MockOptionRepository) CreateOptionContract(ctx context.Context, contract *domain.OptionContract) error {
+	args := m.Called(ctx, contract)
+
+	return args.Error(0)
+}
+
+// ListOptionContracts mock.
+func (m *MockOptionRepository) ListOptionContracts(ctx context.Context, ladderID int64) ([]*domain.OptionContract, error) {
+	args := m.Called(ctx, ladderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.OptionContract), args.Error(1)
+}
+
+// GetOptionContract mock.
+func (m *MockOptionRepository) GetOptionContract(ctx context.Context, symbol string) (*domain.OptionContract, error) {
+	args := m.Called(ctx, symbol)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.OptionContract), args.Error(1)
+}
+
+// ListExpiredOptionContracts mock.
+func (m *MockOptionRepository) ListExpiredOptionContracts(ctx context.Context, now time.Time) ([]*domain.OptionContract, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.OptionContract), args.Error(1)
+}
+
+// SettleOptionContract mock.
+func (m *MockOptionRepository) SettleOptionContract(
+	ctx context.Context,
+	contract *domain.OptionContract,
+	payout decimal.Decimal,
+) error {
+	args := m.Called(ctx, contract, payout)
+
+	return args.Error(0)
+}
+
+// MockPredictionRepository is a mock implementation of PredictionRepository.
+type MockPredictionRepository struct {
+	mock.Mock
+}
+
+// CreatePredictionMarket mock.
+func (m *MockPredictionRepository) CreatePredictionMarket(ctx context.Context, market *domain.PredictionMarket) error {
+	args := m.Called(ctx, market)
+
+	return args.Error(0)
+}
+
+// ListPredictionMarkets mock.
+func (m *MockPredictionRepository) ListPredictionMarkets(ctx context.Context, ladderID int64) ([]*domain.PredictionMarket, error) {
+	args := m.Called(ctx, ladderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.PredictionMarket), args.Error(1)
+}
+
+// GetPredictionMarketForUpdate mock.
+func (m *MockPredictionRepository) GetPredictionMarketForUpdate(ctx context.Context, id int64) (*domain.PredictionMarket, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.PredictionMarket), args.Error(1)
+}
+
+// UpdatePredictionMarketShares mock.
+func (m *MockPredictionRepository) UpdatePredictionMarketShares(ctx context.Context, market *domain.PredictionMarket) error {
+	args := m.Called(ctx, market)
+
+	return args.Error(0)
+}
+
+// AddPredictionPosition mock.
+func (m *MockPredictionRepository) AddPredictionPosition(
+	ctx context.Context,
+	position *domain.PredictionPosition,
+) (*domain.PredictionPosition, error) {
+	args := m.Called(ctx, position)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).(*domain.PredictionPosition), args.Error(1)
+}
+
+// ListPredictionPositions mock.
+func (m *MockPredictionRepository) ListPredictionPositions(
+	ctx context.Context,
+	ladderID int64,
+	userID int64,
+) ([]*domain.PredictionPosition, error) {
+	args := m.Called(ctx, ladderID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.PredictionPosition), args.Error(1)
+}
+
+// ListOpenPredictionPositions mock.
+func (m *MockPredictionRepository) ListOpenPredictionPositions(ctx context.Context, ladderID int64) ([]*domain.PredictionPosition, error) {
+	args := m.Called(ctx, ladderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.PredictionPosition), args.Error(1)
+}
+
+// ListDuePredictionMarkets mock.
+func (m *MockPredictionRepository) ListDuePredictionMarkets(ctx context.Context, now time.Time) ([]*domain.PredictionMarket, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.PredictionMarket), args.Error(1)
+}
+
+// MarkPredictionMarketResolved mock.
+func (m *MockPredictionRepository) MarkPredictionMarketResolved(ctx context.Context, market *domain.PredictionMarket) (bool, error) {
+	args := m.Called(ctx, market)
+
+	return args.Bool(0), args.Error(1)
+}
+
+// CreditPredictionPayouts mock.
+func (m *MockPredictionRepository) CreditPredictionPayouts(ctx context.Context, market *domain.PredictionMarket) error {
+	args := m.Called(ctx, market)
+
+	return args.Error(0)
+}
+
+// WithTx returns a new PredictionRepository with the transaction.
+func (m *MockPredictionRepository) WithTx(tx service.Transaction) service.PredictionRepository {
+	args := m.Called(tx)
+
+	return args.Get(0).(service.PredictionRepository)
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const (
+	// optionPrecision is the minimum number of decimal places premiums are quoted to.
+	optionPrecision = 2
+	// volatilityWindow is the number of recorded prices historical volatility is estimated from.
+	volatilityWindow = 500
+	// minVolatilitySamples is the number of recorded prices needed to estimate volatility.
+	minVolatilitySamples = 30
+	secondsPerYear       = 365 * 24 * 60 * 60
+)
+
+// OptionRepository defines the interface for option contract persistence.
+type OptionRepository interface {
+	CreateOptionContract(ctx context.Context, contract *domain.OptionContract) error
+	ListOptionContracts(ctx context.Context, ladderID int64) ([]*domain.OptionContract, error)
+	GetOptionContract(ctx context.Context, symbol string) (*domain.OptionContract, error)
+	ListExpiredOptionContracts(ctx context.Context, now time.Time) ([]*domain.OptionContract, error)
+	SettleOptionContract(ctx context.Context, contract *domain.OptionContract, payout decimal.Decimal) error
+}
+
+// optionPrefix is the prefix of option instrument IDs.
+var optionPrefix = domain.InstrumentID(domain.SourceOptions, "")
+
+// OptionPricing configures how option premiums are computed.
+type OptionPricing struct {
+	// Volatility is the annualized volatility used when it is not estimated from history.
+	Volatility float64
+	// HistoricalVolatility estimates volatility from the underlying's recorded prices.
+	HistoricalVolatility bool
+	// RiskFreeRate is the annualized, continuously compounded risk-free interest rate.
+	RiskFreeRate float64
+}
+
+// Options lists option contracts on ladder underlyings, prices them with Black-Scholes and
+// settles them at expiry. Contracts are traded like any other symbol: buying opens or adds
+// to a position and selling closes it.
+type Options struct {
+	optionRepo  OptionRepository
+	marketRepo  MarketRepository
+	historyRepo HistoryRepository
+	ladderRepo  LadderRepository
+	fxRates     *FXRates
+	pricing     OptionPricing
+}
+
+// NewOptions creates a new instance of Options.
+func NewOptions(
+	optionRepo OptionRepository,
+	marketRepo MarketRepository,
+	historyRepo HistoryRepository,
+	ladderRepo LadderRepository,
+	fxRates *FXRates,
+	pricing OptionPricing,
+) *Options {
+	return &Options{
+		optionRepo:  optionRepo,
+		marketRepo:  marketRepo,
+		historyRepo: historyRepo,
+		ladderRepo:  ladderRepo,
+		fxRates:     fxRates,
+		pricing:     pricing,
+	}
+}
+
+// OptionSymbol returns the instrument ID of an option, e.g. "OPT:AAPL-261120-C200" for a call
+// on FH:AAPL with a strike of 200 expiring on 20 November 2026.
+func OptionSymbol(underlying, optionType string, strike decimal.Decimal, expiresAt time.Time) string {
+	kind := "C"
+	if optionType == domain.OptionTypePut {
+		kind = "P"
+	}
+
+	return domain.InstrumentID(domain.SourceOptions, fmt.Sprintf(
+		"%s-%s-%s%s", domain.ProviderSymbol(underlying), expiresAt.UTC().Format("060102"), kind, strike.String(),
+	))
+}
+
+// Create lists an option on an underlying of the active ladder. The underlying must be
+// quoted in USD, the currency premiums are quoted in, and the option must expire before
+// the ladder ends so that it is settled within it. A zero volatility prices the option
+// with the configured or historical volatility.
+func (s *Options) Create(
+	ctx context.Context,
+	underlying, optionType string,
+	strike decimal.Decimal,
+	expiresAt time.Time,
+	volatility decimal.Decimal,
+) (*domain.OptionContract, error) {
+	if optionType != domain.OptionTypeCall && optionType != domain.OptionTypePut {
+		return nil, apperrors.ErrInvalidOptionType
+	}
+	if !strike.IsPositive() {
+		return nil, apperrors.ErrInvalidOptionStrike
+	}
+	if volatility.IsNegative() {
+		return nil, apperrors.ErrInvalidOptionVolatility
+	}
+
+	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := s.ladderRepo.GetLadder(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker, ok := l.Ticker(underlying)
+	if !ok || ticker.PriceCurrency() != domain.CurrencyUSD {
+		return nil, apperrors.ErrOptionUnderlyingNotListed
+	}
+
+	now := time.Now()
+	if !expiresAt.After(now) || expiresAt.After(l.EndTime) {
+		return nil, apperrors.ErrInvalidOptionExpiry
+	}
+
+	contract := &domain.OptionContract{
+		Symbol:     OptionSymbol(underlying, optionType, strike, expiresAt),
+		LadderID:   l.ID,
+		Underlying: underlying,
+		Type:       optionType,
+		Strike:     strike,
+		ExpiresAt:  expiresAt,
+		Volatility: volatility,
+		CreatedAt:  now,
+	}
+	if err = s.optionRepo.CreateOptionContract(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	return contract, nil
+}
+
+// List returns the option contracts of the active ladder.
+func (s *Options) List(ctx context.Context) ([]*domain.OptionContract, error) {
+	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.optionRepo.ListOptionContracts(ctx, ladderID)
+}
+
+// Premium returns the last published quote of an option, or nil if it has not been priced.
+func (s *Options) Premium(ctx context.Context, contract *domain.OptionContract) *domain.Quote {
+	quote, err := s.marketRepo.GetQuote(ctx, contract.Symbol)
+	if err != nil {
+		return nil
+	}
+
+	return quote
+}
+
+// Quote prices an option from the current quote of its underlying. Its change is the change
+// of the premium implied by the underlying's change. Expired options are quoted closed at
+// their intrinsic value, so that they can no longer be traded.
+func (s *Options) Quote(ctx context.Context, contract *domain.OptionContract, now time.Time) (*domain.Quote, error) {
+	underlying, err := s.marketRepo.GetQuote(ctx, contract.Underlying)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote of underlying %s: %w", contract.Underlying, err)
+	}
+
+	precision := max(underlying.Precision, optionPrecision)
+	quote := &domain.Quote{
+		Symbol:    contract.Symbol,
+		Timestamp: underlying.Timestamp,
+		Source:    "OPT",
+		IsClosed:  underlying.IsClosed,
+		Precision: precision,
+	}
+
+	years := contract.ExpiresAt.Sub(now).Seconds() / secondsPerYear
+	if contract.SettledAt != nil || years <= 0 {
+		spot := underlying.Price
+		if contract.SettledAt != nil {
+			spot = contract.SettlementPrice
+		}
+		quote.Price = contract.IntrinsicValue(spot).Round(precision)
+		quote.IsClosed = true
+
+		return quote, nil
+	}
+
+	volatility := s.volatility(ctx, contract)
+	call := contract.Type == domain.OptionTypeCall
+	strike := contract.Strike.InexactFloat64()
+	spot := underlying.Price.InexactFloat64()
+	premium := BlackScholes(spot, strike, years, s.pricing.RiskFreeRate, volatility, call)
+	previous := BlackScholes(spot-underlying.Change.InexactFloat64(), strike, years, s.pricing.RiskFreeRate, volatility, call)
+
+	quote.Price = decimal.NewFromFloat(premium).Round(precision)
+	quote.Change = decimal.NewFromFloat(premium - previous).Round(precision)
+	if previous > 0 {
+		quote.ChangePercent = decimal.NewFromFloat((premium - previous) / previous * 100).Round(2)
+	}
+
+	return quote, nil
+}
+
+// volatility returns the annualized volatility an option is priced with.
+func (s *Options) volatility(ctx context.Context, contract *domain.OptionContract) float64 {
+	if contract.Volatility.IsPositive() {
+		return contract.Volatility.InexactFloat64()
+	}
+
+	if s.pricing.HistoricalVolatility {
+		history, err := s.historyRepo.GetHistory(ctx, contract.Underlying, volatilityWindow)
+		if err == nil {
+			if v, ok := HistoricalVolatility(history); ok {
+				return v
+			}
+		}
+	}
+
+	return s.pricing.Volatility
+}
+
+// Settle settles an expired option at its intrinsic value, using the underlying's last
+// recorded price at expiry. Holders are credited in the ladder's base currency and their
+// positions are closed.
+func (s *Options) Settle(ctx context.Context, contract *domain.OptionContract, now time.Time) error {
+	recorded, err := s.historyRepo.GetQuoteAt(ctx, contract.Underlying, contract.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to get price of %s at expiry: %w", contract.Underlying, err)
+	}
+
+	l, err := s.ladderRepo.GetLadder(ctx, contract.LadderID)
+	if err != nil {
+		return err
+	}
+
+	rate, err := s.fxRates.Rate(ctx, domain.CurrencyUSD, l.Currency())
+	if err != nil {
+		return err
+	}
+
+	contract.SettlementPrice = recorded.Price
+	contract.SettledAt = &now
+
+	return s.optionRepo.SettleOptionContract(ctx, contract, contract.IntrinsicValue(recorded.Price).Mul(rate))
+}
+
+// SettleExpired settles all options that have expired and returns how many were settled.
+// Options that cannot be settled yet are retried on the next call.
+func (s *Options) SettleExpired(ctx context.Context, now time.Time) (int, error) {
+	expired, err := s.optionRepo.ListExpiredOptionContracts(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		settled int
+		errs    []error
+	)
+	for _, contract := range expired {
+		if errSettle := s.Settle(ctx, contract, now); errSettle != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", contract.Symbol, errSettle))
+
+			continue
+		}
+		settled++
+	}
+
+	return settled, errors.Join(errs...)
+}
+
+// BlackScholes returns the Black-Scholes price of a European option on a non-dividend-paying
+// underlying. years is the time to expiry, and rate and volatility are annualized. Without
+// time or volatility left the option is worth its discounted intrinsic value.
+func BlackScholes(spot, strike, years, rate, volatility float64, call bool) float64 {
+	discountedStrike := strike * math.Exp(-rate*years)
+	if years <= 0 || volatility <= 0 || spot <= 0 {
+		if call {
+			return math.Max(spot-discountedStrike, 0)
+		}
+
+		return math.Max(discountedStrike-spot, 0)
+	}
+
+	stdDev := volatility * math.Sqrt(years)
+	d1 := (math.Log(spot/strike) + (rate+volatility*volatility/2)*years) / stdDev
+	d2 := d1 - stdDev
+
+	if call {
+		return spot*normalCDF(d1) - discountedStrike*normalCDF(d2)
+	}
+
+	return discountedStrike*normalCDF(-d2) - spot*normalCDF(-d1)
+}
+
+func normalCDF(x float64) float64 {
+	return math.Erfc(-x/math.Sqrt2) / 2
+}
+
+// HistoricalVolatility estimates the annualized volatility of recorded prices, in
+// chronological order, from the standard deviation of their log returns scaled by their
+// average spacing. It reports false if there are too few prices.
+func HistoricalVolatility(history []*domain.Quote) (float64, bool) {
+	if len(history) < minVolatilitySamples {
+		return 0, false
+	}
+
+	returns := make([]float64, 0, len(history)-1)
+	for i := 1; i < len(history); i++ {
+		prev, curr := history[i-1].Price.InexactFloat64(), history[i].Price.InexactFloat64()
+		if prev <= 0 || curr <= 0 {
+			continue
+		}
+		returns = append(returns, math.Log(curr/prev))
+	}
+
+	span := history[len(history)-1].Timestamp.Sub(history[0].Timestamp).Seconds()
+	if len(returns) < 2 || span <= 0 {
+		return 0, false
+	}
+
+	var mean float64
+	for _, r := range returns {
+		mean += r
+	}
+	mean /= float64(len(returns))
+
+	var variance float64
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+	}
+	variance /= float64(len(returns) - 1)
+
+	interval := span / float64(len(history)-1)
+
+	return math.Sqrt(variance * secondsPerYear / interval), true
+}
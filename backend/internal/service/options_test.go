@@ -0,0 +1,215 @@
+package service_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+func TestBlackScholes(t *testing.T) {
+	call := service.BlackScholes(100, 100, 1, 0.05, 0.2, true)
+	put := service.BlackScholes(100, 100, 1, 0.05, 0.2, false)
+
+	assert.InDelta(t, 10.4506, call, 1e-4)
+	assert.InDelta(t, 5.5735, put, 1e-4)
+
+	// Put-call parity: C - P = S - K·e^(-rT).
+	assert.InDelta(t, 100-100*math.Exp(-0.05), call-put, 1e-9)
+
+	assert.InDelta(t, 20.0, service.BlackScholes(120, 100, 0, 0.05, 0.2, true), 1e-9)
+	assert.InDelta(t, 0.0, service.BlackScholes(120, 100, 0, 0.05, 0.2, false), 1e-9)
+}
+
+func TestOptionSymbol(t *testing.T) {
+	expiresAt := time.Date(2026, time.November, 20, 21, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "OPT:AAPL-261120-C200", service.OptionSymbol("FH:AAPL", domain.OptionTypeCall, decimal.NewFromInt(200), expiresAt))
+	assert.Equal(t, "OPT:bitcoin-261120-P50000.5", service.OptionSymbol(
+		"CG:bitcoin", domain.OptionTypePut, decimal.NewFromFloat(50000.5), expiresAt,
+	))
+}
+
+func TestOptionsService_Create(t *testing.T) {
+	ctx := context.Background()
+	ladder := &domain.Ladder{
+		ID:      1,
+		EndTime: time.Now().Add(30 * 24 * time.Hour),
+		AllowedTickers: []domain.TickerInfo{
+			{Symbol: "FH:AAPL"},
+			{Symbol: "OA:EUR_GBP", Currency: "GBP"},
+		},
+	}
+
+	newService := func(optionRepo *mocks.MockOptionRepository) *service.Options {
+		ladderRepo := new(mocks.MockLadderRepository)
+		ladderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+		ladderRepo.On("GetLadder", ctx, int64(1)).Return(ladder, nil)
+
+		return service.NewOptions(
+			optionRepo, new(mocks.MockMarketRepository), new(mocks.MockHistoryRepository), ladderRepo, nil, service.OptionPricing{},
+		)
+	}
+
+	t.Run("Lists Option", func(t *testing.T) {
+		optionRepo := new(mocks.MockOptionRepository)
+		optionRepo.On("CreateOptionContract", ctx, mock.AnythingOfType("*domain.OptionContract")).Return(nil)
+
+		expiresAt := time.Now().Add(7 * 24 * time.Hour)
+		contract, err := newService(optionRepo).Create(
+			ctx, "FH:AAPL", domain.OptionTypeCall, decimal.NewFromInt(200), expiresAt, decimal.Zero,
+		)
+
+		assert.NoError(t, err)
+		assert.Equal(t, service.OptionSymbol("FH:AAPL", domain.OptionTypeCall, decimal.NewFromInt(200), expiresAt), contract.Symbol)
+		assert.Equal(t, int64(1), contract.LadderID)
+		optionRepo.AssertExpectations(t)
+	})
+
+	t.Run("Rejects Invalid Contracts", func(t *testing.T) {
+		week := time.Now().Add(7 * 24 * time.Hour)
+		cases := map[string]struct {
+			underlying, optionType string
+			strike                 int64
+			expiresAt              time.Time
+			err                    error
+		}{
+			"type":         {"FH:AAPL", "straddle", 200, week, apperrors.ErrInvalidOptionType},
+			"strike":       {"FH:AAPL", domain.OptionTypePut, 0, week, apperrors.ErrInvalidOptionStrike},
+			"not listed":   {"FH:MSFT", domain.OptionTypePut, 200, week, apperrors.ErrOptionUnderlyingNotListed},
+			"not USD":      {"OA:EUR_GBP", domain.OptionTypePut, 1, week, apperrors.ErrOptionUnderlyingNotListed},
+			"expired":      {"FH:AAPL", domain.OptionTypePut, 200, time.Now().Add(-time.Hour), apperrors.ErrInvalidOptionExpiry},
+			"after ladder": {"FH:AAPL", domain.OptionTypePut, 200, ladder.EndTime.Add(time.Hour), apperrors.ErrInvalidOptionExpiry},
+		}
+
+		s := newService(new(mocks.MockOptionRepository))
+		for name, tc := range cases {
+			_, err := s.Create(ctx, tc.underlying, tc.optionType, decimal.NewFromInt(tc.strike), tc.expiresAt, decimal.Zero)
+			assert.ErrorIs(t, err, tc.err, name)
+		}
+	})
+}
+
+func TestOptionsService_Quote(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	marketRepo := new(mocks.MockMarketRepository)
+	marketRepo.On("GetQuote", ctx, "FH:AAPL").Return(&domain.Quote{
+		Symbol:    "FH:AAPL",
+		Price:     decimal.NewFromInt(100),
+		Change:    decimal.NewFromInt(2),
+		Timestamp: now,
+	}, nil)
+
+	s := service.NewOptions(
+		new(mocks.MockOptionRepository), marketRepo, new(mocks.MockHistoryRepository), new(mocks.MockLadderRepository), nil,
+		service.OptionPricing{Volatility: 0.2, RiskFreeRate: 0.05},
+	)
+
+	t.Run("Prices With Black-Scholes", func(t *testing.T) {
+		contract := &domain.OptionContract{
+			Symbol:     "OPT:AAPL-C100",
+			Underlying: "FH:AAPL",
+			Type:       domain.OptionTypeCall,
+			Strike:     decimal.NewFromInt(100),
+			ExpiresAt:  now.Add(365 * 24 * time.Hour),
+		}
+
+		quote, err := s.Quote(ctx, contract, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "10.45", quote.Price.String())
+		assert.True(t, quote.Change.IsPositive())
+		assert.False(t, quote.IsClosed)
+	})
+
+	t.Run("Quotes Expired Options Closed At Intrinsic Value", func(t *testing.T) {
+		contract := &domain.OptionContract{
+			Symbol:     "OPT:AAPL-P120",
+			Underlying: "FH:AAPL",
+			Type:       domain.OptionTypePut,
+			Strike:     decimal.NewFromInt(120),
+			ExpiresAt:  now.Add(-time.Minute),
+		}
+
+		quote, err := s.Quote(ctx, contract, now)
+
+		assert.NoError(t, err)
+		assert.True(t, quote.Price.Equal(decimal.NewFromInt(20)))
+		assert.True(t, quote.IsClosed)
+	})
+}
+
+func TestOptionsService_SettleExpired(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	expiresAt := now.Add(-time.Hour)
+
+	contract := &domain.OptionContract{
+		Symbol:     "OPT:AAPL-C100",
+		LadderID:   1,
+		Underlying: "FH:AAPL",
+		Type:       domain.OptionTypeCall,
+		Strike:     decimal.NewFromInt(100),
+		ExpiresAt:  expiresAt,
+	}
+
+	optionRepo := new(mocks.MockOptionRepository)
+	historyRepo := new(mocks.MockHistoryRepository)
+	ladderRepo := new(mocks.MockLadderRepository)
+
+	optionRepo.On("ListExpiredOptionContracts", ctx, now).Return([]*domain.OptionContract{contract}, nil)
+	historyRepo.On("GetQuoteAt", ctx, "FH:AAPL", expiresAt).Return(&domain.Quote{Price: decimal.NewFromInt(112)}, nil)
+	ladderRepo.On("GetLadder", ctx, int64(1)).Return(&domain.Ladder{ID: 1}, nil)
+	optionRepo.On("SettleOptionContract", ctx, contract, mock.MatchedBy(func(payout decimal.Decimal) bool {
+		return payout.Equal(decimal.NewFromInt(12))
+	})).Return(nil)
+
+	s := service.NewOptions(
+		optionRepo, new(mocks.MockMarketRepository), historyRepo, ladderRepo, service.NewFXRates(nil, 0), service.OptionPricing{},
+	)
+	settled, err := s.SettleExpired(ctx, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, settled)
+	assert.True(t, contract.SettlementPrice.Equal(decimal.NewFromInt(112)))
+	assert.NotNil(t, contract.SettledAt)
+	optionRepo.AssertExpectations(t)
+}
+
+func TestHistoricalVolatility(t *testing.T) {
+	start := time.Now()
+
+	_, ok := service.HistoricalVolatility([]*domain.Quote{{Price: decimal.NewFromInt(1), Timestamp: start}})
+	assert.False(t, ok)
+
+	// Alternating ±1% daily moves.
+	history := make([]*domain.Quote, 60)
+	price := 100.0
+	for i := range history {
+		if i%2 == 0 {
+			price *= 1.01
+		} else {
+			price /= 1.01
+		}
+		history[i] = &domain.Quote{
+			Price:     decimal.NewFromFloat(price),
+			Timestamp: start.Add(time.Duration(i) * 24 * time.Hour),
+		}
+	}
+
+	volatility, ok := service.HistoricalVolatility(history)
+
+	assert.True(t, ok)
+	assert.InDelta(t, math.Log(1.01)*math.Sqrt(365), volatility, 0.01)
+}
@@ -0,0 +1,422 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const (
+	// predictionSharePrecision is the number of decimal places shares are issued to.
+	predictionSharePrecision = 8
+	// maxStake bounds a single stake, like validateQuantity bounds a trade.
+	maxStake = 1_000_000_000
+)
+
+// PredictionRepository defines the interface for prediction market persistence.
+type PredictionRepository interface {
+	CreatePredictionMarket(ctx context.Context, market *domain.PredictionMarket) error
+	ListPredictionMarkets(ctx context.Context, ladderID int64) ([]*domain.PredictionMarket, error)
+	GetPredictionMarketForUpdate(ctx context.Context, id int64) (*domain.PredictionMarket, error)
+	UpdatePredictionMarketShares(ctx context.Context, market *domain.PredictionMarket) error
+	AddPredictionPosition(ctx context.Context, position *domain.PredictionPosition) (*domain.PredictionPosition, error)
+	ListPredictionPositions(ctx context.Context, ladderID int64, userID int64) ([]*domain.PredictionPosition, error)
+	ListOpenPredictionPositions(ctx context.Context, ladderID int64) ([]*domain.PredictionPosition, error)
+	ListDuePredictionMarkets(ctx context.Context, now time.Time) ([]*domain.PredictionMarket, error)
+	MarkPredictionMarketResolved(ctx context.Context, market *domain.PredictionMarket) (bool, error)
+	CreditPredictionPayouts(ctx context.Context, market *domain.PredictionMarket) error
+	WithTx(tx Transaction) PredictionRepository
+}
+
+// Predictions runs binary price-prediction markets in the active ladder. Players stake cash
+// from their ladder balance on YES or NO against a logarithmic market scoring rule market
+// maker, and markets are resolved from the instrument's recorded price at resolution time.
+type Predictions struct {
+	predictionRepo PredictionRepository
+	userRepo       UserRepo
+	historyRepo    HistoryRepository
+	ladderRepo     LadderRepository
+	transactor     Transactor
+	liquidity      decimal.Decimal
+	// resolveInterval is how often due markets are resolved. Markets must resolve at least
+	// one interval before their ladder ends, so that payouts land before final ranking.
+	resolveInterval time.Duration
+}
+
+// NewPredictions creates a new instance of Predictions. liquidity is the market maker's
+// liquidity parameter for markets created without one, and resolveInterval is how often due
+// markets are resolved.
+func NewPredictions(
+	predictionRepo PredictionRepository,
+	userRepo UserRepo,
+	historyRepo HistoryRepository,
+	ladderRepo LadderRepository,
+	transactor Transactor,
+	liquidity float64,
+	resolveInterval time.Duration,
+) *Predictions {
+	return &Predictions{
+		predictionRepo:  predictionRepo,
+		userRepo:        userRepo,
+		historyRepo:     historyRepo,
+		ladderRepo:      ladderRepo,
+		transactor:      transactor,
+		liquidity:       decimal.NewFromFloat(liquidity),
+		resolveInterval: resolveInterval,
+	}
+}
+
+// Create opens a market on whether a ticker of the active ladder is above the threshold at
+// the resolution time, which must fall at least one resolve interval before the ladder ends.
+// An empty question is phrased from the terms, and a zero liquidity uses the configured one.
+func (s *Predictions) Create(
+	ctx context.Context,
+	symbol string,
+	threshold decimal.Decimal,
+	resolvesAt time.Time,
+	question string,
+	liquidity decimal.Decimal,
+) (*domain.PredictionMarket, error) {
+	if !threshold.IsPositive() {
+		return nil, apperrors.ErrInvalidPredictionThreshold
+	}
+	if liquidity.IsNegative() {
+		return nil, apperrors.ErrInvalidPredictionLiquidity
+	}
+	if liquidity.IsZero() {
+		liquidity = s.liquidity
+	}
+
+	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := s.ladderRepo.GetLadder(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker, ok := l.Ticker(symbol)
+	if !ok {
+		return nil, apperrors.ErrPredictionSymbolNotListed
+	}
+
+	if !resolvesAt.After(time.Now()) || resolvesAt.After(l.EndTime.Add(-s.resolveInterval)) {
+		return nil, apperrors.ErrInvalidPredictionResolution
+	}
+
+	question = strings.TrimSpace(question)
+	if question == "" {
+		question = predictionQuestion(ticker, threshold, resolvesAt)
+	}
+
+	market := &domain.PredictionMarket{
+		LadderID:   l.ID,
+		Symbol:     symbol,
+		Question:   question,
+		Threshold:  threshold,
+		ResolvesAt: resolvesAt,
+		Liquidity:  liquidity,
+	}
+	if err = s.predictionRepo.CreatePredictionMarket(ctx, market); err != nil {
+		return nil, err
+	}
+
+	return market, nil
+}
+
+// predictionQuestion phrases a market's question, e.g. "Will Bitcoin close above 100000 USD on
+// Fri 23 Oct 2026 20:00 UTC?".
+func predictionQuestion(ticker *domain.TickerInfo, threshold decimal.Decimal, resolvesAt time.Time) string {
+	name := ticker.Name
+	if name == "" {
+		name = domain.ProviderSymbol(ticker.Symbol)
+	}
+
+	return fmt.Sprintf(
+		"Will %s close above %s %s on %s?",
+		name, threshold.String(), ticker.PriceCurrency(), resolvesAt.UTC().Format("Mon 2 Jan 2006 15:04 MST"),
+	)
+}
+
+// List returns the prediction markets of the active ladder.
+func (s *Predictions) List(ctx context.Context) ([]*domain.PredictionMarket, error) {
+	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.predictionRepo.ListPredictionMarkets(ctx, ladderID)
+}
+
+// Positions returns a player's positions in the prediction markets of the active ladder.
+func (s *Predictions) Positions(ctx context.Context, userID int64) ([]*domain.PredictionPosition, error) {
+	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.predictionRepo.ListPredictionPositions(ctx, ladderID, userID)
+}
+
+// Stake spends an amount of the player's ladder balance on shares of an outcome, at the
+// market maker's prices. It returns the market and the player's position after the stake.
+func (s *Predictions) Stake(
+	ctx context.Context,
+	userID int64,
+	marketID int64,
+	outcome string,
+	amount float64,
+) (*domain.PredictionMarket, *domain.PredictionPosition, error) {
+	if outcome != domain.PredictionYes && outcome != domain.PredictionNo {
+		return nil, nil, apperrors.ErrInvalidPredictionOutcome
+	}
+	if math.IsNaN(amount) || math.IsInf(amount, 0) || amount > maxStake {
+		return nil, nil, apperrors.ErrInvalidStake
+	}
+	cost := decimal.NewFromFloat(amount).Round(2)
+	if !cost.IsPositive() {
+		return nil, nil, apperrors.ErrInvalidStake
+	}
+
+	ladderID, err := s.activeLadderFor(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// START TRANSACTION
+	tx, err := s.transactor.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	txUserRepo := s.userRepo.WithTx(tx)
+	txPredictionRepo := s.predictionRepo.WithTx(tx)
+
+	market, err := txPredictionRepo.GetPredictionMarketForUpdate(ctx, marketID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if market.LadderID != ladderID {
+		return nil, nil, apperrors.ErrPredictionMarketNotFound
+	}
+	if !market.IsOpen(time.Now()) {
+		return nil, nil, apperrors.ErrPredictionMarketClosed
+	}
+
+	if _, err = txUserRepo.GetUserForUpdate(ctx, userID); err != nil {
+		return nil, nil, err
+	}
+
+	balance, err := txUserRepo.GetUserBalance(ctx, userID, ladderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if balance.LessThan(cost) {
+		return nil, nil, apperrors.ErrInsufficientFunds
+	}
+
+	bought, other := market.YesShares, market.NoShares
+	if outcome == domain.PredictionNo {
+		bought, other = other, bought
+	}
+	shares := decimal.NewFromFloat(LMSRShares(
+		bought.InexactFloat64(), other.InexactFloat64(), market.Liquidity.InexactFloat64(), cost.InexactFloat64(),
+	)).Truncate(predictionSharePrecision)
+
+	position := &domain.PredictionPosition{MarketID: market.ID, UserID: userID, Staked: cost}
+	if outcome == domain.PredictionYes {
+		market.YesShares = market.YesShares.Add(shares)
+		position.YesShares = shares
+	} else {
+		market.NoShares = market.NoShares.Add(shares)
+		position.NoShares = shares
+	}
+
+	if err = txUserRepo.UpdateUserBalance(ctx, userID, ladderID, balance.Sub(cost)); err != nil {
+		return nil, nil, err
+	}
+
+	if err = txPredictionRepo.UpdatePredictionMarketShares(ctx, market); err != nil {
+		return nil, nil, err
+	}
+
+	position, err = txPredictionRepo.AddPredictionPosition(ctx, position)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return market, position, nil
+}
+
+// activeLadderFor returns the ID of the active ladder if it is running and the player has
+// joined it.
+func (s *Predictions) activeLadderFor(ctx context.Context, userID int64) (int64, error) {
+	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	l, err := s.ladderRepo.GetLadder(ctx, ladderID)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	if now.Before(l.StartTime) || now.After(l.EndTime) || !l.IsActive {
+		return 0, apperrors.ErrLadderNotActive
+	}
+
+	joined, err := s.ladderRepo.IsUserInLadder(ctx, ladderID, userID)
+	if err != nil {
+		return 0, err
+	}
+	if !joined {
+		return 0, apperrors.ErrNotJoinedLadder
+	}
+
+	return ladderID, nil
+}
+
+// Resolve settles a market from the instrument's last recorded price at the resolution time.
+// The market resolves YES if that price is above the threshold, and every winning share pays
+// out one unit of the ladder's base currency.
+func (s *Predictions) Resolve(ctx context.Context, market *domain.PredictionMarket, now time.Time) error {
+	recorded, err := s.historyRepo.GetQuoteAt(ctx, market.Symbol, market.ResolvesAt)
+	if err != nil {
+		return fmt.Errorf("failed to get price of %s at resolution: %w", market.Symbol, err)
+	}
+
+	market.Outcome = domain.PredictionNo
+	if recorded.Price.GreaterThan(market.Threshold) {
+		market.Outcome = domain.PredictionYes
+	}
+	market.ResolutionPrice = recorded.Price
+	market.ResolvedAt = &now
+
+	tx, err := s.transactor.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	txPredictionRepo := s.predictionRepo.WithTx(tx)
+
+	resolved, err := txPredictionRepo.MarkPredictionMarketResolved(ctx, market)
+	if err != nil {
+		return err
+	}
+	if !resolved {
+		return nil
+	}
+
+	if err = txPredictionRepo.CreditPredictionPayouts(ctx, market); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ResolveDue resolves all markets past their resolution time and returns how many were
+// resolved. Markets that cannot be resolved yet are retried on the next call.
+func (s *Predictions) ResolveDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.predictionRepo.ListDuePredictionMarkets(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.resolveAll(ctx, due, now)
+}
+
+// ResolveDueInLadder resolves the markets of a ladder past their resolution time, so that
+// their payouts are in the ladder balances before the ladder is finalized.
+func (s *Predictions) ResolveDueInLadder(ctx context.Context, ladderID int64, now time.Time) (int, error) {
+	due, err := s.predictionRepo.ListDuePredictionMarkets(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.resolveAll(ctx, slices.DeleteFunc(due, func(m *domain.PredictionMarket) bool {
+		return m.LadderID != ladderID
+	}), now)
+}
+
+// resolveAll resolves the given markets and returns how many were resolved.
+func (s *Predictions) resolveAll(ctx context.Context, due []*domain.PredictionMarket, now time.Time) (int, error) {
+	var (
+		resolved int
+		errs     []error
+	)
+	for _, market := range due {
+		if errResolve := s.Resolve(ctx, market, now); errResolve != nil {
+			errs = append(errs, fmt.Errorf("market %d: %w", market.ID, errResolve))
+
+			continue
+		}
+		resolved++
+	}
+
+	return resolved, errors.Join(errs...)
+}
+
+// OpenPositionValues returns what each player's positions in the unresolved markets of a
+// ladder are worth at the current share prices, keyed by user ID. Positions in resolved
+// markets are left out, since their payouts are already in the ladder balance.
+func (s *Predictions) OpenPositionValues(ctx context.Context, ladderID int64) (map[int64]decimal.Decimal, error) {
+	positions, err := s.predictionRepo.ListOpenPredictionPositions(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) == 0 {
+		return map[int64]decimal.Decimal{}, nil
+	}
+
+	markets, err := s.predictionRepo.ListPredictionMarkets(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*domain.PredictionMarket, len(markets))
+	for _, m := range markets {
+		byID[m.ID] = m
+	}
+
+	values := make(map[int64]decimal.Decimal)
+	for _, p := range positions {
+		market, ok := byID[p.MarketID]
+		if !ok || market.Outcome != "" {
+			continue
+		}
+		value := p.YesShares.Mul(market.Price(domain.PredictionYes)).
+			Add(p.NoShares.Mul(market.Price(domain.PredictionNo)))
+		values[p.UserID] = values[p.UserID].Add(value)
+	}
+
+	return values, nil
+}
+
+// LMSRShares returns how many shares of an outcome an amount buys from a logarithmic market
+// scoring rule market maker with liquidity b, i.e. the Δ for which the cost function
+// C(q) = b·ln(e^(q_yes/b) + e^(q_no/b)) grows by the amount. It is computed in log space so
+// that large stakes or imbalances do not overflow.
+func LMSRShares(shares, otherShares, liquidity, amount float64) float64 {
+	x := amount / liquidity
+	// Δ/b = x + ln(1 + e^((q_other - q)/b)·(1 - e^-x))
+	t := (otherShares-shares)/liquidity + math.Log(-math.Expm1(-x))
+
+	return liquidity * (x + math.Max(t, 0) + math.Log1p(math.Exp(-math.Abs(t))))
+}
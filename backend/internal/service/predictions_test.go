@@ -0,0 +1,274 @@
+package service_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+func TestLMSRShares(t *testing.T) {
+	const b = 100.0
+
+	// C(q) = b·ln(e^(q_yes/b) + e^(q_no/b))
+	cost := func(yes, no float64) float64 {
+		return b * math.Log(math.Exp(yes/b)+math.Exp(no/b))
+	}
+
+	for _, tc := range []struct{ yes, no, amount float64 }{
+		{0, 0, 10},
+		{0, 0, 0.01},
+		{50, 0, 25},
+		{0, 300, 5},
+	} {
+		shares := service.LMSRShares(tc.yes, tc.no, b, tc.amount)
+		assert.InDelta(t, tc.amount, cost(tc.yes+shares, tc.no)-cost(tc.yes, tc.no), 1e-9)
+	}
+
+	// Large stakes and imbalances stay finite.
+	assert.False(t, math.IsInf(service.LMSRShares(0, 1e6, b, 1e6), 0))
+}
+
+func TestPredictionMarket_Price(t *testing.T) {
+	market := &domain.PredictionMarket{Liquidity: decimal.NewFromInt(100)}
+	assert.Equal(t, "0.5", market.Price(domain.PredictionYes).String())
+
+	market.YesShares = decimal.NewFromInt(100)
+	yes, no := market.Price(domain.PredictionYes), market.Price(domain.PredictionNo)
+	assert.Equal(t, "0.7311", yes.String())
+	assert.True(t, yes.Add(no).Equal(decimal.NewFromInt(1)))
+
+	market.Outcome = domain.PredictionNo
+	assert.True(t, market.Price(domain.PredictionYes).IsZero())
+	assert.True(t, market.Price(domain.PredictionNo).Equal(decimal.NewFromInt(1)))
+}
+
+func TestPredictionsService_Create(t *testing.T) {
+	ctx := context.Background()
+	ladder := &domain.Ladder{
+		ID:             1,
+		EndTime:        time.Now().Add(30 * 24 * time.Hour),
+		AllowedTickers: []domain.TickerInfo{{Symbol: "CG:bitcoin", Name: "Bitcoin"}},
+	}
+
+	newService := func(predictionRepo *mocks.MockPredictionRepository) *service.Predictions {
+		ladderRepo := new(mocks.MockLadderRepository)
+		ladderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+		ladderRepo.On("GetLadder", ctx, int64(1)).Return(ladder, nil)
+
+		return service.NewPredictions(
+			predictionRepo, new(mocks.MockUserRepository), new(mocks.MockHistoryRepository), ladderRepo, new(mocks.MockTransactor), 100, time.Minute,
+		)
+	}
+
+	t.Run("Phrases Question And Uses Default Liquidity", func(t *testing.T) {
+		predictionRepo := new(mocks.MockPredictionRepository)
+		predictionRepo.On("CreatePredictionMarket", ctx, mock.AnythingOfType("*domain.PredictionMarket")).Return(nil)
+
+		resolvesAt := time.Now().Add(24 * time.Hour)
+		market, err := newService(predictionRepo).Create(ctx, "CG:bitcoin", decimal.NewFromInt(100000), resolvesAt, " ", decimal.Zero)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Will Bitcoin close above 100000 USD on "+resolvesAt.UTC().Format("Mon 2 Jan 2006 15:04 MST")+"?", market.Question)
+		assert.True(t, market.Liquidity.Equal(decimal.NewFromInt(100)))
+		predictionRepo.AssertExpectations(t)
+	})
+
+	t.Run("Rejects Invalid Markets", func(t *testing.T) {
+		week := time.Now().Add(7 * 24 * time.Hour)
+		cases := map[string]struct {
+			symbol     string
+			threshold  int64
+			resolvesAt time.Time
+			liquidity  int64
+			err        error
+		}{
+			"threshold":    {"CG:bitcoin", 0, week, 0, apperrors.ErrInvalidPredictionThreshold},
+			"liquidity":    {"CG:bitcoin", 1, week, -1, apperrors.ErrInvalidPredictionLiquidity},
+			"not listed":   {"FH:AAPL", 1, week, 0, apperrors.ErrPredictionSymbolNotListed},
+			"past":         {"CG:bitcoin", 1, time.Now().Add(-time.Hour), 0, apperrors.ErrInvalidPredictionResolution},
+			"after ladder": {"CG:bitcoin", 1, ladder.EndTime.Add(time.Hour), 0, apperrors.ErrInvalidPredictionResolution},
+			// Payouts must land at least one resolve interval before the ladder is finalized.
+			"at ladder end": {"CG:bitcoin", 1, ladder.EndTime.Add(-30 * time.Second), 0, apperrors.ErrInvalidPredictionResolution},
+		}
+
+		s := newService(new(mocks.MockPredictionRepository))
+		for name, tc := range cases {
+			_, err := s.Create(ctx, tc.symbol, decimal.NewFromInt(tc.threshold), tc.resolvesAt, "", decimal.NewFromInt(tc.liquidity))
+			assert.ErrorIs(t, err, tc.err, name)
+		}
+	})
+}
+
+func TestPredictionsService_Stake(t *testing.T) {
+	const userID int64 = 7
+
+	ctx := context.Background()
+
+	setup := func(market *domain.PredictionMarket, balance int64) (
+		*service.Predictions, *mocks.MockPredictionRepository, *mocks.MockUserRepository,
+	) {
+		predictionRepo := new(mocks.MockPredictionRepository)
+		userRepo := new(mocks.MockUserRepository)
+		ladderRepo := new(mocks.MockLadderRepository)
+		transactor := new(mocks.MockTransactor)
+		tx := new(mocks.MockTransaction)
+
+		ladderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+		ladderRepo.On("GetLadder", ctx, int64(1)).Return(&domain.Ladder{
+			ID:        1,
+			IsActive:  true,
+			StartTime: time.Now().Add(-time.Hour),
+			EndTime:   time.Now().Add(24 * time.Hour),
+		}, nil)
+		ladderRepo.On("IsUserInLadder", ctx, int64(1), userID).Return(true, nil)
+		transactor.On("Begin", ctx).Return(tx, nil)
+		tx.On("Commit", ctx).Return(nil)
+		tx.On("Rollback", ctx).Return(nil)
+		userRepo.On("WithTx", tx).Return(userRepo)
+		predictionRepo.On("WithTx", tx).Return(predictionRepo)
+		predictionRepo.On("GetPredictionMarketForUpdate", ctx, market.ID).Return(market, nil)
+		userRepo.On("GetUserForUpdate", ctx, userID).Return(&domain.User{ID: userID}, nil)
+		userRepo.On("GetUserBalance", ctx, userID, int64(1)).Return(decimal.NewFromInt(balance), nil)
+
+		s := service.NewPredictions(predictionRepo, userRepo, new(mocks.MockHistoryRepository), ladderRepo, transactor, 100, time.Minute)
+
+		return s, predictionRepo, userRepo
+	}
+
+	newMarket := func() *domain.PredictionMarket {
+		return &domain.PredictionMarket{
+			ID:         3,
+			LadderID:   1,
+			Liquidity:  decimal.NewFromInt(100),
+			ResolvesAt: time.Now().Add(time.Hour),
+		}
+	}
+
+	t.Run("Buys Shares At Market Maker Prices", func(t *testing.T) {
+		market := newMarket()
+		s, predictionRepo, userRepo := setup(market, 1000)
+
+		userRepo.On("UpdateUserBalance", ctx, userID, int64(1), mock.MatchedBy(func(d decimal.Decimal) bool {
+			return d.Equal(decimal.NewFromInt(990))
+		})).Return(nil)
+		predictionRepo.On("UpdatePredictionMarketShares", ctx, market).Return(nil)
+		var position *domain.PredictionPosition
+		predictionRepo.On("AddPredictionPosition", ctx, mock.AnythingOfType("*domain.PredictionPosition")).
+			Run(func(args mock.Arguments) { position = args.Get(1).(*domain.PredictionPosition) }).
+			Return(&domain.PredictionPosition{}, nil)
+
+		updated, _, err := s.Stake(ctx, userID, 3, domain.PredictionNo, 10)
+
+		assert.NoError(t, err)
+		assert.True(t, updated.YesShares.IsZero())
+		assert.True(t, position.NoShares.Equal(updated.NoShares))
+		assert.InDelta(t, service.LMSRShares(0, 0, 100, 10), position.NoShares.InexactFloat64(), 1e-8)
+		assert.True(t, position.Staked.Equal(decimal.NewFromInt(10)))
+		assert.True(t, updated.Price(domain.PredictionNo).GreaterThan(decimal.NewFromFloat(0.5)))
+		userRepo.AssertExpectations(t)
+		predictionRepo.AssertExpectations(t)
+	})
+
+	t.Run("Rejects Insufficient Funds", func(t *testing.T) {
+		s, _, _ := setup(newMarket(), 5)
+
+		_, _, err := s.Stake(ctx, userID, 3, domain.PredictionYes, 10)
+		assert.ErrorIs(t, err, apperrors.ErrInsufficientFunds)
+	})
+
+	t.Run("Rejects Closed Markets", func(t *testing.T) {
+		market := newMarket()
+		market.ResolvesAt = time.Now().Add(-time.Minute)
+		s, _, _ := setup(market, 1000)
+
+		_, _, err := s.Stake(ctx, userID, 3, domain.PredictionYes, 10)
+		assert.ErrorIs(t, err, apperrors.ErrPredictionMarketClosed)
+	})
+
+	t.Run("Rejects Invalid Stakes", func(t *testing.T) {
+		s, _, _ := setup(newMarket(), 1000)
+
+		_, _, err := s.Stake(ctx, userID, 3, "maybe", 10)
+		assert.ErrorIs(t, err, apperrors.ErrInvalidPredictionOutcome)
+
+		_, _, err = s.Stake(ctx, userID, 3, domain.PredictionYes, 0.001)
+		assert.ErrorIs(t, err, apperrors.ErrInvalidStake)
+
+		_, _, err = s.Stake(ctx, userID, 3, domain.PredictionYes, math.NaN())
+		assert.ErrorIs(t, err, apperrors.ErrInvalidStake)
+	})
+}
+
+func TestPredictionsService_ResolveDue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	resolvesAt := now.Add(-time.Minute)
+
+	above := &domain.PredictionMarket{ID: 1, Symbol: "CG:bitcoin", Threshold: decimal.NewFromInt(100), ResolvesAt: resolvesAt}
+	below := &domain.PredictionMarket{ID: 2, Symbol: "FH:AAPL", Threshold: decimal.NewFromInt(200), ResolvesAt: resolvesAt}
+
+	predictionRepo := new(mocks.MockPredictionRepository)
+	historyRepo := new(mocks.MockHistoryRepository)
+	transactor := new(mocks.MockTransactor)
+	tx := new(mocks.MockTransaction)
+
+	predictionRepo.On("ListDuePredictionMarkets", ctx, now).Return([]*domain.PredictionMarket{above, below}, nil)
+	historyRepo.On("GetQuoteAt", ctx, "CG:bitcoin", resolvesAt).Return(&domain.Quote{Price: decimal.NewFromInt(101)}, nil)
+	historyRepo.On("GetQuoteAt", ctx, "FH:AAPL", resolvesAt).Return(&domain.Quote{Price: decimal.NewFromInt(200)}, nil)
+	transactor.On("Begin", ctx).Return(tx, nil)
+	tx.On("Commit", ctx).Return(nil)
+	tx.On("Rollback", ctx).Return(nil)
+	predictionRepo.On("WithTx", tx).Return(predictionRepo)
+	predictionRepo.On("MarkPredictionMarketResolved", ctx, mock.Anything).Return(true, nil)
+	predictionRepo.On("CreditPredictionPayouts", ctx, mock.Anything).Return(nil)
+
+	s := service.NewPredictions(
+		predictionRepo, new(mocks.MockUserRepository), historyRepo, new(mocks.MockLadderRepository), transactor, 100, time.Minute,
+	)
+	resolved, err := s.ResolveDue(ctx, now)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resolved)
+	assert.Equal(t, domain.PredictionYes, above.Outcome)
+	// A price at the threshold is not above it.
+	assert.Equal(t, domain.PredictionNo, below.Outcome)
+	assert.True(t, below.ResolutionPrice.Equal(decimal.NewFromInt(200)))
+	predictionRepo.AssertNumberOfCalls(t, "CreditPredictionPayouts", 2)
+}
+
+func TestPredictionsService_OpenPositionValues(t *testing.T) {
+	ctx := context.Background()
+
+	open := &domain.PredictionMarket{ID: 1, LadderID: 1, Liquidity: decimal.NewFromInt(100)}
+	resolved := &domain.PredictionMarket{ID: 2, LadderID: 1, Liquidity: decimal.NewFromInt(100), Outcome: domain.PredictionYes}
+
+	predictionRepo := new(mocks.MockPredictionRepository)
+	predictionRepo.On("ListOpenPredictionPositions", ctx, int64(1)).Return([]*domain.PredictionPosition{
+		{MarketID: 1, UserID: 7, YesShares: decimal.NewFromInt(10), NoShares: decimal.NewFromInt(4)},
+		{MarketID: 1, UserID: 8, NoShares: decimal.NewFromInt(2)},
+		// Resolved since it was listed; its payout is already in the balance.
+		{MarketID: 2, UserID: 7, YesShares: decimal.NewFromInt(100)},
+	}, nil)
+	predictionRepo.On("ListPredictionMarkets", ctx, int64(1)).Return([]*domain.PredictionMarket{open, resolved}, nil)
+
+	s := service.NewPredictions(
+		predictionRepo, new(mocks.MockUserRepository), new(mocks.MockHistoryRepository), new(mocks.MockLadderRepository),
+		new(mocks.MockTransactor), 100, time.Minute,
+	)
+	values, err := s.OpenPositionValues(ctx, 1)
+
+	assert.NoError(t, err)
+	// Both outcomes trade at 0.5 in a balanced market.
+	assert.Equal(t, "7", values[7].String())
+	assert.Equal(t, "1", values[8].String())
+}
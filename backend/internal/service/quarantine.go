@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"log"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// QuarantineRepository defines the interface for storing quotes that failed validation.
+type QuarantineRepository interface {
+	Quarantine(ctx context.Context, entry *domain.QuarantinedQuote) error
+	ListQuarantined(ctx context.Context) ([]*domain.QuarantinedQuote, error)
+	TakeQuarantined(ctx context.Context, id string) (*domain.QuarantinedQuote, error)
+}
+
+// Quarantine lets admins review quotes the fetcher held back.
+type Quarantine struct {
+	quarantineRepo QuarantineRepository
+	marketRepo     MarketRepository
+	historyRepo    HistoryRepository
+}
+
+// NewQuarantine creates a new instance of Quarantine.
+func NewQuarantine(
+	quarantineRepo QuarantineRepository,
+	marketRepo MarketRepository,
+	historyRepo HistoryRepository,
+) *Quarantine {
+	return &Quarantine{
+		quarantineRepo: quarantineRepo,
+		marketRepo:     marketRepo,
+		historyRepo:    historyRepo,
+	}
+}
+
+// List returns all quarantined quotes, oldest first.
+func (s *Quarantine) List(ctx context.Context) ([]*domain.QuarantinedQuote, error) {
+	return s.quarantineRepo.ListQuarantined(ctx)
+}
+
+// Release publishes a quarantined quote. It always goes into history, but only replaces
+// the live quote if nothing newer has been stored in the meantime.
+func (s *Quarantine) Release(ctx context.Context, id string) (*domain.Quote, error) {
+	entry, err := s.quarantineRepo.TakeQuarantined(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	quote := &entry.Quote
+
+	if err := s.historyRepo.SaveQuote(ctx, quote); err != nil {
+		return nil, s.restore(ctx, entry, err)
+	}
+
+	current, err := s.marketRepo.GetQuote(ctx, quote.Symbol)
+	if err != nil || !current.Timestamp.After(quote.Timestamp) {
+		if err := s.marketRepo.SaveQuote(ctx, quote); err != nil {
+			return nil, s.restore(ctx, entry, err)
+		}
+	}
+
+	log.Printf("[Quarantine] Released %s quote %s: $%s", quote.Symbol, entry.ID, quote.Price)
+
+	return quote, nil
+}
+
+// Discard drops a quarantined quote.
+func (s *Quarantine) Discard(ctx context.Context, id string) error {
+	entry, err := s.quarantineRepo.TakeQuarantined(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[Quarantine] Discarded %s quote %s (%s)", entry.Quote.Symbol, entry.ID, entry.Reason)
+
+	return nil
+}
+
+// restore puts an entry back after a failed release so it is not lost.
+func (s *Quarantine) restore(ctx context.Context, entry *domain.QuarantinedQuote, cause error) error {
+	if err := s.quarantineRepo.Quarantine(ctx, entry); err != nil {
+		log.Printf("[Quarantine] Failed to restore %s: %v", entry.ID, err)
+	}
+
+	return cause
+}
@@ -0,0 +1,94 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+func TestQuarantineService_Release(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	newEntry := func() *domain.QuarantinedQuote {
+		return &domain.QuarantinedQuote{
+			ID:     "abc",
+			Quote:  domain.Quote{Symbol: "AAPL", Price: decimal.NewFromInt(500), Timestamp: now},
+			Reason: domain.QuarantineReasonDeviation,
+		}
+	}
+
+	t.Run("Publishes Newer Quote", func(t *testing.T) {
+		mockQuarantineRepo := new(mocks.MockQuarantineRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		entry := newEntry()
+
+		mockQuarantineRepo.On("TakeQuarantined", ctx, "abc").Return(entry, nil)
+		mockHistoryRepo.On("SaveQuote", ctx, &entry.Quote).Return(nil)
+		mockMarketRepo.On("GetQuote", ctx, "AAPL").Return(&domain.Quote{Timestamp: now.Add(-time.Minute)}, nil)
+		mockMarketRepo.On("SaveQuote", ctx, &entry.Quote).Return(nil)
+
+		s := service.NewQuarantine(mockQuarantineRepo, mockMarketRepo, mockHistoryRepo)
+		q, err := s.Release(ctx, "abc")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &entry.Quote, q)
+		mockMarketRepo.AssertExpectations(t)
+		mockHistoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("Keeps Newer Live Quote", func(t *testing.T) {
+		mockQuarantineRepo := new(mocks.MockQuarantineRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		entry := newEntry()
+
+		mockQuarantineRepo.On("TakeQuarantined", ctx, "abc").Return(entry, nil)
+		mockHistoryRepo.On("SaveQuote", ctx, &entry.Quote).Return(nil)
+		mockMarketRepo.On("GetQuote", ctx, "AAPL").Return(&domain.Quote{Timestamp: now.Add(time.Minute)}, nil)
+
+		s := service.NewQuarantine(mockQuarantineRepo, mockMarketRepo, mockHistoryRepo)
+		_, err := s.Release(ctx, "abc")
+
+		assert.NoError(t, err)
+		mockMarketRepo.AssertNotCalled(t, "SaveQuote", ctx, &entry.Quote)
+	})
+
+	t.Run("Restores Entry On Failure", func(t *testing.T) {
+		mockQuarantineRepo := new(mocks.MockQuarantineRepository)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		entry := newEntry()
+		saveErr := errors.New("db down")
+
+		mockQuarantineRepo.On("TakeQuarantined", ctx, "abc").Return(entry, nil)
+		mockQuarantineRepo.On("Quarantine", ctx, entry).Return(nil)
+		mockHistoryRepo.On("SaveQuote", ctx, &entry.Quote).Return(saveErr)
+
+		s := service.NewQuarantine(mockQuarantineRepo, mockMarketRepo, mockHistoryRepo)
+		_, err := s.Release(ctx, "abc")
+
+		assert.ErrorIs(t, err, saveErr)
+		mockQuarantineRepo.AssertExpectations(t)
+	})
+
+	t.Run("Not Found", func(t *testing.T) {
+		mockQuarantineRepo := new(mocks.MockQuarantineRepository)
+		mockQuarantineRepo.On("TakeQuarantined", ctx, "missing").Return(nil, apperrors.ErrQuoteNotQuarantined)
+
+		s := service.NewQuarantine(mockQuarantineRepo, new(mocks.MockMarketRepository), new(mocks.MockHistoryRepository))
+		_, err := s.Release(ctx, "missing")
+
+		assert.ErrorIs(t, err, apperrors.ErrQuoteNotQuarantined)
+	})
+}
@@ -0,0 +1,402 @@
+package service
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// DefaultQuoteBufferSize is the per-client buffer used when none is configured.
+const DefaultQuoteBufferSize = 32
+
+// QuoteSource opens upstream quote subscriptions for the QuoteHub.
+type QuoteSource interface {
+	SubscribeToQuotes(ctx context.Context, symbols ...string) *redis.PubSub
+	DecodeQuote(payload string) (*domain.Quote, error)
+}
+
+// QuoteHub fans real-time quotes out to streaming clients. It keeps a single upstream
+// subscription per symbol, shared by every client interested in it, instead of one per client.
+//
+// Clients streaming every ticker of the active ladder subscribe with SubscribeAll and are
+// moved to the new tickers by SetAllTickers when the ladder changes.
+//
+// Each client gets a bounded buffer. When a client falls behind, its oldest buffered quote
+// is dropped in favour of the newest, so a slow consumer never stalls the others.
+type QuoteHub struct {
+	source     QuoteSource
+	bufferSize int
+
+	mu     sync.RWMutex
+	topics map[string]*hubTopic
+	all    map[*QuoteSubscription]struct{}
+	closed bool
+	wg     sync.WaitGroup
+
+	clients   metric.Int64UpDownCounter
+	upstreams metric.Int64UpDownCounter
+	delivered metric.Int64Counter
+	dropped   metric.Int64Counter
+}
+
+// hubTopic is one upstream subscription. Its pubsub is nil while the subscription is
+// still being opened.
+type hubTopic struct {
+	pubsub *redis.PubSub
+	subs   map[*QuoteSubscription]struct{}
+}
+
+// QuoteSubscription is a single client's view of the hub.
+type QuoteSubscription struct {
+	hub    *QuoteHub
+	topics []string
+	ch     chan *domain.Quote
+	once   sync.Once
+}
+
+// NewQuoteHub creates a new instance of QuoteHub.
+func NewQuoteHub(source QuoteSource, bufferSize int) *QuoteHub {
+	if bufferSize <= 0 {
+		bufferSize = DefaultQuoteBufferSize
+	}
+
+	meter := otel.Meter("quote-hub")
+
+	clients, err := meter.Int64UpDownCounter(
+		"hub.clients",
+		metric.WithDescription("Streaming clients connected to the quote hub"),
+	)
+	if err != nil {
+		log.Printf("[QuoteHub] Failed to create clients counter: %v", err)
+	}
+
+	upstreams, err := meter.Int64UpDownCounter(
+		"hub.upstream_subscriptions",
+		metric.WithDescription("Valkey subscriptions held by the quote hub"),
+	)
+	if err != nil {
+		log.Printf("[QuoteHub] Failed to create upstream subscriptions counter: %v", err)
+	}
+
+	delivered, err := meter.Int64Counter(
+		"hub.quotes_delivered",
+		metric.WithDescription("Quotes handed to client buffers"),
+	)
+	if err != nil {
+		log.Printf("[QuoteHub] Failed to create delivered counter: %v", err)
+	}
+
+	dropped, err := meter.Int64Counter(
+		"hub.quotes_dropped",
+		metric.WithDescription("Quotes dropped because a client buffer was full"),
+	)
+	if err != nil {
+		log.Printf("[QuoteHub] Failed to create dropped counter: %v", err)
+	}
+
+	return &QuoteHub{
+		source:     source,
+		bufferSize: bufferSize,
+		topics:     make(map[string]*hubTopic),
+		all:        make(map[*QuoteSubscription]struct{}),
+		clients:    clients,
+		upstreams:  upstreams,
+		delivered:  delivered,
+		dropped:    dropped,
+	}
+}
+
+// Subscribe registers a client for the given symbols.
+// It returns apperrors.ErrShuttingDown once the hub has been closed.
+func (h *QuoteHub) Subscribe(symbols ...string) (*QuoteSubscription, error) {
+	return h.subscribe(false, symbols)
+}
+
+// SubscribeAll registers a client for every ticker of the active ladder, given as symbols.
+// Unlike with Subscribe, the client's symbols follow SetAllTickers.
+func (h *QuoteHub) SubscribeAll(symbols ...string) (*QuoteSubscription, error) {
+	return h.subscribe(true, symbols)
+}
+
+func (h *QuoteHub) subscribe(all bool, symbols []string) (*QuoteSubscription, error) {
+	sub := &QuoteSubscription{
+		hub:    h,
+		topics: symbols,
+		ch:     make(chan *domain.Quote, h.bufferSize),
+	}
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+
+		return nil, apperrors.ErrShuttingDown
+	}
+	pending := h.attach(sub, symbols)
+	if all {
+		h.all[sub] = struct{}{}
+	}
+	h.add(h.clients, 1)
+	h.mu.Unlock()
+
+	h.openTopics(pending)
+
+	return sub, nil
+}
+
+// StreamsAllTickers reports whether any client subscribed with SubscribeAll.
+func (h *QuoteHub) StreamsAllTickers() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.all) > 0
+}
+
+// SetAllTickers moves the clients of SubscribeAll to the given symbols, opening upstream
+// subscriptions for new tickers and releasing those nobody needs anymore.
+func (h *QuoteHub) SetAllTickers(symbols ...string) {
+	var pending []string
+	var idle []*hubTopic
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+
+		return
+	}
+	for sub := range h.all {
+		var added, removed []string
+		for _, name := range symbols {
+			if !slices.Contains(sub.topics, name) {
+				added = append(added, name)
+			}
+		}
+		for _, name := range sub.topics {
+			if !slices.Contains(symbols, name) {
+				removed = append(removed, name)
+			}
+		}
+		idle = append(idle, h.detach(sub, removed)...)
+		pending = append(pending, h.attach(sub, added)...)
+		sub.topics = slices.Clone(symbols)
+	}
+	h.mu.Unlock()
+
+	for _, t := range idle {
+		h.closeTopic(t)
+	}
+	h.openTopics(pending)
+}
+
+// attach adds a client to the given topics and returns the topics that are new. Their
+// upstream subscriptions are opened with openTopics after releasing the lock, so a slow
+// Valkey round trip does not stall deliveries and other clients. Must be called with
+// h.mu held.
+func (h *QuoteHub) attach(sub *QuoteSubscription, names []string) []string {
+	var pending []string
+	for _, name := range names {
+		t, ok := h.topics[name]
+		if !ok {
+			t = &hubTopic{subs: make(map[*QuoteSubscription]struct{})}
+			h.topics[name] = t
+			pending = append(pending, name)
+		}
+		t.subs[sub] = struct{}{}
+	}
+
+	return pending
+}
+
+// detach removes a client from the given topics and returns the topics nobody needs
+// anymore. Their upstream subscriptions are released with closeTopic after releasing the
+// lock. Must be called with h.mu held.
+func (h *QuoteHub) detach(sub *QuoteSubscription, names []string) []*hubTopic {
+	var idle []*hubTopic
+	for _, name := range names {
+		t, ok := h.topics[name]
+		if !ok {
+			continue
+		}
+		delete(t.subs, sub)
+		if len(t.subs) == 0 {
+			delete(h.topics, name)
+			idle = append(idle, t)
+		}
+	}
+
+	return idle
+}
+
+// Close disconnects every client and releases all upstream subscriptions. Clients see
+// their quote channel closed, which lets streaming handlers return before the server
+// stops waiting for open connections.
+func (h *QuoteHub) Close() {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+
+		return
+	}
+	h.closed = true
+
+	topics := h.topics
+	h.topics = make(map[string]*hubTopic)
+	clear(h.all)
+
+	// Detach clients before closing their channels so that no pump delivers to them afterwards.
+	clients := make(map[*QuoteSubscription]struct{})
+	for _, t := range topics {
+		for sub := range t.subs {
+			clients[sub] = struct{}{}
+		}
+		t.subs = nil
+	}
+	for sub := range clients {
+		sub.once.Do(func() { close(sub.ch) })
+	}
+	h.add(h.clients, -int64(len(clients)))
+	h.mu.Unlock()
+
+	log.Printf("[QuoteHub] Draining %d clients and %d upstream subscriptions", len(clients), len(topics))
+	for _, t := range topics {
+		h.closeTopic(t)
+	}
+	h.wg.Wait()
+}
+
+// openTopics opens the upstream subscriptions of pending topics. Must be called without
+// h.mu held.
+func (h *QuoteHub) openTopics(names []string) {
+	for _, name := range names {
+		h.openTopic(name)
+	}
+}
+
+// openTopic subscribes upstream for a pending topic and starts its pump. If the topic was
+// dropped in the meantime, because its clients left or the hub closed, the subscription is
+// released again. Must be called without h.mu held.
+func (h *QuoteHub) openTopic(name string) {
+	pubsub := h.source.SubscribeToQuotes(context.Background(), name)
+
+	h.mu.Lock()
+	t, ok := h.topics[name]
+	if h.closed || !ok || t.pubsub != nil {
+		h.mu.Unlock()
+		if err := pubsub.Close(); err != nil {
+			log.Printf("[QuoteHub] Failed to close upstream subscription: %v", err)
+		}
+
+		return
+	}
+	t.pubsub = pubsub
+	h.add(h.upstreams, 1)
+	h.wg.Add(1)
+	h.mu.Unlock()
+
+	go h.pump(t)
+}
+
+// closeTopic releases a topic's upstream subscription. Pending topics have none yet;
+// their opener releases it once it sees the topic is gone.
+func (h *QuoteHub) closeTopic(t *hubTopic) {
+	if t.pubsub == nil {
+		return
+	}
+	if err := t.pubsub.Close(); err != nil {
+		log.Printf("[QuoteHub] Failed to close upstream subscription: %v", err)
+	}
+	h.add(h.upstreams, -1)
+}
+
+// pump forwards messages of one upstream subscription until it is closed.
+func (h *QuoteHub) pump(t *hubTopic) {
+	defer h.wg.Done()
+
+	for msg := range t.pubsub.Channel() {
+		quote, err := h.source.DecodeQuote(msg.Payload)
+		if err != nil {
+			log.Printf("[QuoteHub] Failed to decode quote on %s: %v", msg.Channel, err)
+
+			continue
+		}
+
+		h.mu.RLock()
+		for sub := range t.subs {
+			sub.deliver(quote)
+		}
+		h.mu.RUnlock()
+	}
+}
+
+// unsubscribe removes a client and releases upstream subscriptions nobody needs anymore.
+func (h *QuoteHub) unsubscribe(sub *QuoteSubscription) {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+
+		return
+	}
+	idle := h.detach(sub, sub.topics)
+	delete(h.all, sub)
+	sub.once.Do(func() { close(sub.ch) })
+	h.add(h.clients, -1)
+	h.mu.Unlock()
+
+	// Closing outside the lock lets the topic's pump finish its current delivery.
+	for _, t := range idle {
+		h.closeTopic(t)
+	}
+}
+
+func (h *QuoteHub) add(counter metric.Int64UpDownCounter, n int64) {
+	if counter != nil {
+		counter.Add(context.Background(), n)
+	}
+}
+
+func (h *QuoteHub) inc(counter metric.Int64Counter) {
+	if counter != nil {
+		counter.Add(context.Background(), 1)
+	}
+}
+
+// Quotes returns the client's quote feed. It is closed when the client unsubscribes
+// or the hub shuts down.
+func (s *QuoteSubscription) Quotes() <-chan *domain.Quote {
+	return s.ch
+}
+
+// Close unsubscribes the client from the hub.
+func (s *QuoteSubscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// deliver hands a quote to the client without blocking, dropping the oldest buffered quote
+// if the client is behind. Must be called with the hub's read lock held.
+func (s *QuoteSubscription) deliver(quote *domain.Quote) {
+	for range 2 {
+		select {
+		case s.ch <- quote:
+			s.hub.inc(s.hub.delivered)
+
+			return
+		default:
+		}
+
+		select {
+		case <-s.ch:
+			s.hub.inc(s.hub.dropped)
+		default:
+		}
+	}
+
+	// Another pump refilled the buffer in between; give up on this quote.
+	s.hub.inc(s.hub.dropped)
+}
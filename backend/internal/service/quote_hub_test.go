@@ -0,0 +1,184 @@
+package service_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	redisRepo "github.com/tmythicator/ticker-rush/backend/internal/repository/redis"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+func TestQuoteHub(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatalf("failed to start miniredis: %v", err)
+	}
+	defer mr.Close()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer func() { _ = redisClient.Close() }()
+
+	ctx := context.Background()
+	repo := redisRepo.NewMarketRepository(redisClient)
+	publish := func(symbol string, price int64) {
+		require.NoError(t, repo.SaveQuote(ctx, &domain.Quote{Symbol: symbol, Price: decimal.NewFromInt(price), Timestamp: time.Now()}))
+	}
+	subscribers := func(symbol string) int64 {
+		counts, err := repo.CountSubscribers(ctx, []string{symbol})
+		require.NoError(t, err)
+
+		return counts[symbol]
+	}
+
+	t.Run("Shares One Upstream Subscription", func(t *testing.T) {
+		hub := service.NewQuoteHub(repo, 8)
+		defer hub.Close()
+
+		first, err := hub.Subscribe("AAPL")
+		require.NoError(t, err)
+		second, err := hub.Subscribe("AAPL", "MSFT")
+		require.NoError(t, err)
+
+		waitForSubscribers(t, repo, "AAPL")
+		waitForSubscribers(t, repo, "MSFT")
+		publish("AAPL", 190)
+
+		assert.Equal(t, "AAPL", (<-first.Quotes()).Symbol)
+		assert.Equal(t, "AAPL", (<-second.Quotes()).Symbol)
+		assert.Equal(t, int64(1), subscribers("AAPL"))
+
+		second.Close()
+		assert.Eventually(t, func() bool { return subscribers("MSFT") == 0 }, time.Second, 5*time.Millisecond)
+		assert.Equal(t, int64(1), subscribers("AAPL"), "still needed by the first client")
+
+		_, open := <-second.Quotes()
+		assert.False(t, open)
+
+		first.Close()
+		assert.Eventually(t, func() bool { return subscribers("AAPL") == 0 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("Moves All-Ticker Clients To New Tickers", func(t *testing.T) {
+		hub := service.NewQuoteHub(repo, 8)
+		defer hub.Close()
+
+		all, err := hub.SubscribeAll("AAPL", "MSFT")
+		require.NoError(t, err)
+		fixed, err := hub.Subscribe("AAPL")
+		require.NoError(t, err)
+		assert.True(t, hub.StreamsAllTickers())
+
+		hub.SetAllTickers("MSFT", "bitcoin")
+		waitForSubscribers(t, repo, "bitcoin")
+		assert.Equal(t, int64(1), subscribers("AAPL"), "still needed by the fixed client")
+
+		publish("AAPL", 190)
+		publish("bitcoin", 65000)
+		assert.Equal(t, "AAPL", (<-fixed.Quotes()).Symbol)
+		assert.Equal(t, "bitcoin", (<-all.Quotes()).Symbol)
+
+		fixed.Close()
+		assert.Eventually(t, func() bool { return subscribers("AAPL") == 0 }, time.Second, 5*time.Millisecond)
+
+		all.Close()
+		assert.False(t, hub.StreamsAllTickers())
+		assert.Eventually(t, func() bool { return subscribers("bitcoin") == 0 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("Slow Consumer Keeps Latest Quotes", func(t *testing.T) {
+		hub := service.NewQuoteHub(repo, 2)
+		defer hub.Close()
+
+		slow, err := hub.Subscribe("AAPL")
+		require.NoError(t, err)
+		defer slow.Close()
+		fast, err := hub.Subscribe("AAPL")
+		require.NoError(t, err)
+		defer fast.Close()
+
+		waitForSubscribers(t, repo, "AAPL")
+		for price := int64(1); price <= 5; price++ {
+			publish("AAPL", price)
+			assert.True(t, decimal.NewFromInt(price).Equal((<-fast.Quotes()).Price))
+		}
+
+		assert.True(t, decimal.NewFromInt(4).Equal((<-slow.Quotes()).Price))
+		assert.True(t, decimal.NewFromInt(5).Equal((<-slow.Quotes()).Price))
+	})
+
+	t.Run("Close Drains Clients", func(t *testing.T) {
+		hub := service.NewQuoteHub(repo, 8)
+
+		sub, err := hub.Subscribe("AAPL")
+		require.NoError(t, err)
+		waitForSubscribers(t, repo, "AAPL")
+
+		hub.Close()
+
+		_, open := <-sub.Quotes()
+		assert.False(t, open)
+		sub.Close()
+
+		_, err = hub.Subscribe("AAPL")
+		assert.ErrorIs(t, err, apperrors.ErrShuttingDown)
+
+		assert.Eventually(t, func() bool { return subscribers("AAPL") == 0 }, time.Second, 5*time.Millisecond)
+	})
+	t.Run("Slow Upstream Does Not Block Other Clients", func(t *testing.T) {
+		source := &gatedQuoteSource{
+			MarketRepository: repo,
+			symbol:           "AAPL",
+			entered:          make(chan struct{}),
+			gate:             make(chan struct{}),
+		}
+		hub := service.NewQuoteHub(source, 8)
+		defer hub.Close()
+
+		opened := make(chan *service.QuoteSubscription)
+		go func() {
+			sub, _ := hub.Subscribe("AAPL")
+			opened <- sub
+		}()
+		<-source.entered
+
+		other, err := hub.Subscribe("MSFT")
+		require.NoError(t, err)
+		other.Close()
+
+		close(source.gate)
+		sub := <-opened
+		require.NotNil(t, sub)
+		waitForSubscribers(t, repo, "AAPL")
+		sub.Close()
+		assert.Eventually(t, func() bool { return subscribers("AAPL") == 0 }, time.Second, 5*time.Millisecond)
+	})
+}
+
+// gatedQuoteSource holds up upstream subscriptions to one symbol until its gate is closed.
+type gatedQuoteSource struct {
+	*redisRepo.MarketRepository
+	symbol  string
+	entered chan struct{}
+	gate    chan struct{}
+}
+
+func (s *gatedQuoteSource) SubscribeToQuotes(ctx context.Context, symbols ...string) *redis.PubSub {
+	if slices.Contains(symbols, s.symbol) {
+		close(s.entered)
+		<-s.gate
+	}
+
+	return s.MarketRepository.SubscribeToQuotes(ctx, symbols...)
+}
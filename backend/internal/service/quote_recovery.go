@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// quoteRecoveryTimeout bounds a shared history lookup, which outlives any single caller.
+const quoteRecoveryTimeout = 5 * time.Second
+
+// QuoteRecovery is a MarketRepository that rehydrates missing live quotes from the
+// Postgres history, so that a Valkey restart does not leave the exchange without
+// prices until the fetcher has cycled through every symbol.
+//
+// Restored quotes keep their original timestamps, so they are reported as closed once
+// they are older than domain.MarketStalenessThreshold.
+type QuoteRecovery struct {
+	MarketRepository
+	historyRepo HistoryRepository
+	ladderRepo  LadderRepository
+	group       singleflight.Group
+}
+
+// NewQuoteRecovery wraps marketRepo with read-through recovery from historyRepo.
+func NewQuoteRecovery(
+	marketRepo MarketRepository,
+	historyRepo HistoryRepository,
+	ladderRepo LadderRepository,
+) *QuoteRecovery {
+	return &QuoteRecovery{
+		MarketRepository: marketRepo,
+		historyRepo:      historyRepo,
+		ladderRepo:       ladderRepo,
+	}
+}
+
+// GetQuote returns the cached quote, restoring it from history if the key is missing.
+// It returns redis.Nil if the symbol has no history either.
+func (r *QuoteRecovery) GetQuote(ctx context.Context, symbol string) (*domain.Quote, error) {
+	quote, err := r.MarketRepository.GetQuote(ctx, symbol)
+	if !errors.Is(err, redis.Nil) {
+		return quote, err
+	}
+
+	// Collapse concurrent misses for the same symbol into a single history lookup. The lookup
+	// runs on a detached context so one caller going away does not fail the others.
+	ch := r.group.DoChan(symbol, func() (any, error) {
+		restoreCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), quoteRecoveryTimeout)
+		defer cancel()
+
+		if _, errRestore := r.restore(restoreCtx, []string{symbol}); errRestore != nil {
+			return nil, errRestore
+		}
+
+		// The fetcher may have won the race, so read back whatever is cached now.
+		return r.MarketRepository.GetQuote(restoreCtx, symbol)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		return res.Val.(*domain.Quote), nil
+	}
+}
+
+// WarmUp restores every tradable symbol of the active ladder that is missing from the cache.
+// It returns the number of quotes restored.
+func (r *QuoteRecovery) WarmUp(ctx context.Context) (int, error) {
+	ladderID, err := r.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tickers, err := r.ladderRepo.GetAllowedTickers(ctx, ladderID)
+	if err != nil {
+		return 0, err
+	}
+
+	symbols := make([]string, len(tickers))
+	for i, t := range tickers {
+		symbols[i] = t.Symbol
+	}
+
+	restored, err := r.restore(ctx, symbols)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(restored), nil
+}
+
+// restore writes the latest stored quote of each symbol into the cache unless one is
+// already there, and returns the symbols that were written.
+func (r *QuoteRecovery) restore(ctx context.Context, symbols []string) ([]string, error) {
+	if len(symbols) == 0 {
+		return nil, nil
+	}
+
+	quotes, err := r.historyRepo.GetLatestQuotes(ctx, symbols)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored []string
+	for _, quote := range quotes {
+		ok, errRestore := r.MarketRepository.RestoreQuote(ctx, quote)
+		if errRestore != nil {
+			return restored, errRestore
+		}
+		if ok {
+			log.Printf("Restored %s quote from history (as of %s)", quote.Symbol, quote.Timestamp.Format("2006-01-02 15:04:05"))
+			restored = append(restored, quote.Symbol)
+		}
+	}
+
+	return restored, nil
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+func TestQuoteRecovery_GetQuote(t *testing.T) {
+	ctx := context.Background()
+	stored := &domain.Quote{Symbol: "AAPL", Price: decimal.NewFromInt(190), Source: "Finnhub", Timestamp: time.Now().Add(-time.Hour)}
+
+	t.Run("Cache Hit", func(t *testing.T) {
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+
+		mockMarketRepo.On("GetQuote", ctx, "AAPL").Return(stored, nil)
+
+		r := service.NewQuoteRecovery(mockMarketRepo, mockHistoryRepo, new(mocks.MockLadderRepository))
+		q, err := r.GetQuote(ctx, "AAPL")
+
+		assert.NoError(t, err)
+		assert.Equal(t, stored, q)
+		mockHistoryRepo.AssertNotCalled(t, "GetLatestQuotes", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Restores Missing Key From History", func(t *testing.T) {
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+
+		mockMarketRepo.On("GetQuote", ctx, "AAPL").Return(nil, redis.Nil).Once()
+		mockHistoryRepo.On("GetLatestQuotes", mock.Anything, []string{"AAPL"}).Return([]*domain.Quote{stored}, nil)
+		mockMarketRepo.On("RestoreQuote", mock.Anything, stored).Return(true, nil)
+		mockMarketRepo.On("GetQuote", mock.Anything, "AAPL").Return(stored, nil).Once()
+
+		r := service.NewQuoteRecovery(mockMarketRepo, mockHistoryRepo, new(mocks.MockLadderRepository))
+		q, err := r.GetQuote(ctx, "AAPL")
+
+		assert.NoError(t, err)
+		assert.Equal(t, stored.Timestamp, q.Timestamp)
+		mockMarketRepo.AssertExpectations(t)
+	})
+
+	t.Run("Lookup Outlives Cancelled Caller", func(t *testing.T) {
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		restored := make(chan struct{})
+		live := mock.MatchedBy(func(c context.Context) bool { return c.Err() == nil })
+
+		mockMarketRepo.On("GetQuote", mock.Anything, "AAPL").Return(nil, redis.Nil).Once()
+		mockHistoryRepo.On("GetLatestQuotes", live, []string{"AAPL"}).Return([]*domain.Quote{stored}, nil)
+		mockMarketRepo.On("RestoreQuote", live, stored).Return(true, nil).Run(func(mock.Arguments) { close(restored) })
+		mockMarketRepo.On("GetQuote", live, "AAPL").Return(stored, nil).Once()
+
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		r := service.NewQuoteRecovery(mockMarketRepo, mockHistoryRepo, new(mocks.MockLadderRepository))
+		_, _ = r.GetQuote(cancelled, "AAPL")
+
+		select {
+		case <-restored:
+		case <-time.After(time.Second):
+			t.Fatal("history lookup was abandoned with the caller")
+		}
+	})
+
+	t.Run("No History", func(t *testing.T) {
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+
+		mockMarketRepo.On("GetQuote", mock.Anything, "AAPL").Return(nil, redis.Nil)
+		mockHistoryRepo.On("GetLatestQuotes", mock.Anything, []string{"AAPL"}).Return([]*domain.Quote{}, nil)
+
+		r := service.NewQuoteRecovery(mockMarketRepo, mockHistoryRepo, new(mocks.MockLadderRepository))
+		_, err := r.GetQuote(ctx, "AAPL")
+
+		assert.ErrorIs(t, err, redis.Nil)
+		mockMarketRepo.AssertNotCalled(t, "RestoreQuote", mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuoteRecovery_WarmUp(t *testing.T) {
+	ctx := context.Background()
+	mockMarketRepo := new(mocks.MockMarketRepository)
+	mockHistoryRepo := new(mocks.MockHistoryRepository)
+	mockLadderRepo := new(mocks.MockLadderRepository)
+
+	aapl := &domain.Quote{Symbol: "AAPL", Price: decimal.NewFromInt(190)}
+	btc := &domain.Quote{Symbol: "bitcoin", Price: decimal.NewFromInt(65000)}
+
+	mockLadderRepo.On("GetActiveLadder", ctx).Return(int64(1), nil)
+	mockLadderRepo.On("GetAllowedTickers", ctx, int64(1)).Return([]*domain.TickerInfo{
+		{Symbol: "AAPL"}, {Symbol: "bitcoin"}, {Symbol: "MSFT"},
+	}, nil)
+	mockHistoryRepo.On("GetLatestQuotes", ctx, []string{"AAPL", "bitcoin", "MSFT"}).Return([]*domain.Quote{aapl, btc}, nil)
+	mockMarketRepo.On("RestoreQuote", ctx, aapl).Return(true, nil)
+	mockMarketRepo.On("RestoreQuote", ctx, btc).Return(false, nil)
+
+	r := service.NewQuoteRecovery(mockMarketRepo, mockHistoryRepo, mockLadderRepo)
+	n, err := r.WarmUp(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n, "cached quotes are left alone")
+	mockMarketRepo.AssertExpectations(t)
+}
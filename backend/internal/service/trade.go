@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -36,12 +37,21 @@ type PortfolioRepository interface {
 	WithTx(tx Transaction) PortfolioRepository
 }
 
+// FillRepository defines the interface for recording executed trades.
+type FillRepository interface {
+	RecordFill(ctx context.Context, fill *domain.Fill) error
+	WithTx(tx Transaction) FillRepository
+}
+
 // Trade handles stock trading operations.
 type Trade struct {
 	userRepo      UserRepo
 	portfolioRepo PortfolioRepository
+	fillRepo      FillRepository
 	marketRepo    MarketRepository
 	ladderRepo    LadderRepository
+	optionRepo    OptionRepository
+	fxRates       *FXRates
 	transactor    Transactor
 }
 
@@ -49,15 +59,21 @@ type Trade struct {
 func NewTrade(
 	userRepo UserRepo,
 	portfolioRepo PortfolioRepository,
+	fillRepo FillRepository,
 	marketRepo MarketRepository,
 	ladderRepo LadderRepository,
+	optionRepo OptionRepository,
+	fxRates *FXRates,
 	transactor Transactor,
 ) *Trade {
 	return &Trade{
 		userRepo:      userRepo,
 		portfolioRepo: portfolioRepo,
+		fillRepo:      fillRepo,
 		marketRepo:    marketRepo,
 		ladderRepo:    ladderRepo,
+		optionRepo:    optionRepo,
+		fxRates:       fxRates,
 		transactor:    transactor,
 	}
 }
@@ -74,13 +90,20 @@ func (s *Trade) BuyStock(
 		return nil, err
 	}
 
-	price, ladderID, err := s.validateMarketAndParticipation(ctx, userID, symbol)
+	quote, ladder, err := s.validateMarketAndParticipation(ctx, userID, symbol)
 	if err != nil {
 		return nil, err
 	}
 
+	// Two-sided quotes, such as those of currency pairs, fill buys at the ask. The cost is
+	// paid in the ladder's base currency.
 	quantityDec := decimal.NewFromFloat(validQty)
-	cost := price.Mul(quantityDec)
+	fill, err := s.newFill(ctx, ladder, userID, symbol, domain.FillSideBuy, quantityDec, quote.BuyPrice())
+	if err != nil {
+		return nil, err
+	}
+	ladderID := ladder.ID
+	cost := fill.Amount
 
 	// START TRANSACTION
 	tx, err := s.transactor.Begin(ctx)
@@ -119,10 +142,10 @@ func (s *Trade) BuyStock(
 		currentAvg = item.AveragePrice
 	}
 
-	// 3. Execute Trade Logic
+	// 3. Execute Trade Logic; the average price stays in the instrument's currency
 	newBalance := balance.Sub(cost)
 	newTotalQuantity := currentQty.Add(quantityDec)
-	newAvgPrice := currentQty.Mul(currentAvg).Add(cost).Div(newTotalQuantity)
+	newAvgPrice := currentQty.Mul(currentAvg).Add(fill.Price.Mul(quantityDec)).Div(newTotalQuantity)
 
 	// 4. Persistence
 	if err := txUserRepo.UpdateUserBalance(ctx, userID, ladderID, newBalance); err != nil {
@@ -134,6 +157,10 @@ func (s *Trade) BuyStock(
 		return nil, err
 	}
 
+	if err := s.fillRepo.WithTx(tx).RecordFill(ctx, fill); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
@@ -153,13 +180,19 @@ func (s *Trade) SellStock(
 		return nil, err
 	}
 
-	price, ladderID, err := s.validateMarketAndParticipation(ctx, userID, symbol)
+	quote, ladder, err := s.validateMarketAndParticipation(ctx, userID, symbol)
 	if err != nil {
 		return nil, err
 	}
 
+	// Two-sided quotes fill sells at the bid.
 	quantityDec := decimal.NewFromFloat(validQty)
-	totalSaleValue := price.Mul(quantityDec)
+	fill, err := s.newFill(ctx, ladder, userID, symbol, domain.FillSideSell, quantityDec, quote.SellPrice())
+	if err != nil {
+		return nil, err
+	}
+	ladderID := ladder.ID
+	totalSaleValue := fill.Amount
 
 	// START TRANSACTION
 	tx, err := s.transactor.Begin(ctx)
@@ -210,6 +243,10 @@ func (s *Trade) SellStock(
 		return nil, err
 	}
 
+	if err := s.fillRepo.WithTx(tx).RecordFill(ctx, fill); err != nil {
+		return nil, err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
@@ -217,40 +254,84 @@ func (s *Trade) SellStock(
 	return user, nil
 }
 
-func (s *Trade) validateMarketAndParticipation(ctx context.Context, userID int64, symbol string) (decimal.Decimal, int64, error) {
+func (s *Trade) validateMarketAndParticipation(ctx context.Context, userID int64, symbol string) (*domain.Quote, *domain.Ladder, error) {
 	quote, err := s.marketRepo.GetQuote(ctx, symbol)
 	if err != nil {
-		return decimal.Zero, 0, err
+		return nil, nil, err
 	}
 
 	if quote.IsClosed {
-		return decimal.Zero, 0, apperrors.ErrMarketClosed
+		return nil, nil, apperrors.ErrMarketClosed
 	}
 
 	ladderID, err := s.ladderRepo.GetActiveLadder(ctx)
 	if err != nil {
-		return decimal.Zero, 0, err
+		return nil, nil, err
 	}
 
 	l, err := s.ladderRepo.GetLadder(ctx, ladderID)
 	if err != nil {
-		return decimal.Zero, 0, err
+		return nil, nil, err
 	}
 
 	now := time.Now()
 	if now.Before(l.StartTime) || now.After(l.EndTime) || !l.IsActive {
-		return decimal.Zero, 0, apperrors.ErrLadderNotActive
+		return nil, nil, apperrors.ErrLadderNotActive
+	}
+
+	// The cached premium is only marked closed once the pricing worker next runs, so options
+	// are checked against their contract to keep holdings from being opened after settlement.
+	if strings.HasPrefix(symbol, optionPrefix) {
+		contract, errOption := s.optionRepo.GetOptionContract(ctx, symbol)
+		if errOption != nil {
+			return nil, nil, errOption
+		}
+		if contract.SettledAt != nil || !now.Before(contract.ExpiresAt) {
+			return nil, nil, apperrors.ErrOptionExpired
+		}
 	}
 
 	joined, err := s.ladderRepo.IsUserInLadder(ctx, ladderID, userID)
 	if err != nil {
-		return decimal.Zero, 0, err
+		return nil, nil, err
 	}
 	if !joined {
-		return decimal.Zero, 0, apperrors.ErrNotJoinedLadder
+		return nil, nil, apperrors.ErrNotJoinedLadder
+	}
+
+	return quote, l, nil
+}
+
+// newFill prices a fill of the symbol in the ladder's base currency at the current FX rate.
+func (s *Trade) newFill(
+	ctx context.Context,
+	l *domain.Ladder,
+	userID int64,
+	symbol, side string,
+	quantity, price decimal.Decimal,
+) (*domain.Fill, error) {
+	currency := domain.CurrencyUSD
+	if ticker, ok := l.Ticker(symbol); ok {
+		currency = ticker.PriceCurrency()
+	}
+
+	rate, err := s.fxRates.Rate(ctx, currency, l.Currency())
+	if err != nil {
+		return nil, err
 	}
 
-	return quote.Price, ladderID, nil
+	return &domain.Fill{
+		LadderID:   l.ID,
+		UserID:     userID,
+		Symbol:     symbol,
+		Side:       side,
+		Quantity:   quantity,
+		Price:      price,
+		Currency:   currency,
+		FXRate:     rate,
+		Amount:     ConvertAmount(price.Mul(quantity), rate),
+		ExecutedAt: time.Now(),
+	}, nil
 }
 
 func (s *Trade) updatePortfolioPersistence(
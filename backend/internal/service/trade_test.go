@@ -21,6 +21,15 @@ import (
 	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
 )
 
+// newMockFillRepo returns a fill repository that accepts every fill.
+func newMockFillRepo() *mocks.MockFillRepository {
+	fillRepo := new(mocks.MockFillRepository)
+	fillRepo.On("WithTx", mock.Anything).Return(fillRepo)
+	fillRepo.On("RecordFill", mock.Anything, mock.Anything).Return(nil)
+
+	return fillRepo
+}
+
 func TestTradeService_BuyStock_Success(t *testing.T) {
 	const (
 		symbol          string  = "AAPL"
@@ -91,7 +100,7 @@ func TestTradeService_BuyStock_Success(t *testing.T) {
 	mockTx.On("Rollback", mock.Anything).Return(nil)
 
 	// 4. Execute
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	user, err := tradeService.BuyStock(ctx, userID, symbol, quantity)
 
 	// 5. Verify
@@ -153,7 +162,7 @@ func TestTradeService_BuyStock_InsufficientFunds(t *testing.T) {
 	mockUserRepo.On("GetUserForUpdate", mock.Anything, userID).Return(initialUser, nil)
 	mockUserRepo.On("GetUserBalance", mock.Anything, userID, int64(1)).Return(decimal.NewFromFloat(startBalance), nil)
 
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	_, err := tradeService.BuyStock(ctx, userID, symbol, quantity)
 
 	assert.Error(t, err)
@@ -197,7 +206,7 @@ func TestTradeService_BuyStock_MarketClosed(t *testing.T) {
 	ctx := context.Background()
 
 	// 3. Execute
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	_, err := tradeService.BuyStock(ctx, userID, symbol, quantity)
 
 	// 4. Verify
@@ -208,6 +217,51 @@ func TestTradeService_BuyStock_MarketClosed(t *testing.T) {
 	mockTransactor.AssertNotCalled(t, "Begin", mock.Anything)
 }
 
+func TestTradeService_BuyStock_ExpiredOption(t *testing.T) {
+	const (
+		userID int64  = 3
+		symbol string = "OPT:AAPL-261120-C200"
+	)
+
+	// The cached premium is fresh because the pricing worker has not run since expiry.
+	mr, _ := miniredis.Run()
+	defer mr.Close()
+
+	rClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	marketRepo := app_redis.NewMarketRepository(rClient)
+	quote := map[string]any{
+		"symbol":    symbol,
+		"price":     12.5,
+		"timestamp": time.Now().Unix(),
+	}
+	bytes, _ := json.Marshal(quote)
+	rClient.Set(context.Background(), "market:"+symbol, bytes, 0)
+
+	mockLadderRepo := new(mocks.MockLadderRepository)
+	mockOptionRepo := new(mocks.MockOptionRepository)
+	mockTransactor := new(mocks.MockTransactor)
+
+	ctx := context.Background()
+
+	mockLadderRepo.On("GetActiveLadder", mock.Anything).Return(int64(1), nil)
+	mockLadderRepo.On("GetLadder", mock.Anything, int64(1)).Return(&domain.Ladder{
+		ID:        1,
+		IsActive:  true,
+		StartTime: time.Now().Add(-1 * time.Hour),
+		EndTime:   time.Now().Add(1 * time.Hour),
+	}, nil)
+	mockOptionRepo.On("GetOptionContract", mock.Anything, symbol).Return(&domain.OptionContract{
+		Symbol:    symbol,
+		ExpiresAt: time.Now().Add(-time.Second),
+	}, nil)
+
+	tradeService := service.NewTrade(new(mocks.MockUserRepository), new(mocks.MockPortfolioRepository), newMockFillRepo(), marketRepo, mockLadderRepo, mockOptionRepo, service.NewFXRates(nil, 0), mockTransactor)
+	_, err := tradeService.BuyStock(ctx, userID, symbol, 1)
+
+	assert.ErrorIs(t, err, apperrors.ErrOptionExpired)
+	mockTransactor.AssertNotCalled(t, "Begin", mock.Anything)
+}
+
 func TestTradeService_BuyStock_NotJoined(t *testing.T) {
 	const (
 		userID   int64   = 4
@@ -252,7 +306,7 @@ func TestTradeService_BuyStock_NotJoined(t *testing.T) {
 	}, nil)
 	mockLadderRepo.On("IsUserInLadder", mock.Anything, int64(1), userID).Return(false, nil)
 
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	_, err := tradeService.BuyStock(ctx, userID, symbol, quantity)
 
 	assert.Error(t, err)
@@ -302,7 +356,7 @@ func TestTradeService_SellStock_NotJoined(t *testing.T) {
 	}, nil)
 	mockLadderRepo.On("IsUserInLadder", mock.Anything, int64(1), userID).Return(false, nil)
 
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	_, err := tradeService.SellStock(ctx, userID, symbol, quantity)
 
 	assert.Error(t, err)
@@ -347,7 +401,7 @@ func TestTradeService_BuyStock_LadderNotActive(t *testing.T) {
 		InitialBalance: decimal.NewFromFloat(1000),
 	}, nil)
 
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	_, err := tradeService.BuyStock(ctx, userID, symbol, quantity)
 
 	assert.Error(t, err)
@@ -428,7 +482,7 @@ func TestTradeService_SellStock_Success(t *testing.T) {
 	mockTx.On("Commit", mock.Anything).Return(nil)
 	mockTx.On("Rollback", mock.Anything).Return(nil)
 
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	user, err := tradeService.SellStock(ctx, userID, symbol, quantity)
 
 	assert.NoError(t, err)
@@ -488,7 +542,7 @@ func TestTradeService_SellStock_InsufficientQuantity(t *testing.T) {
 	mockPortRepo.On("GetPortfolioItemForUpdate", mock.Anything, userID, int64(1), symbol).
 		Return(&domain.PortfolioItem{StockSymbol: symbol, Quantity: decimal.NewFromFloat(5.0), AveragePrice: decimal.NewFromFloat(100.0)}, nil)
 
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	_, err := tradeService.SellStock(ctx, userID, symbol, quantity)
 
 	assert.Error(t, err)
@@ -544,7 +598,7 @@ func TestTradeService_SellStock_ZeroHoldings(t *testing.T) {
 	mockPortRepo.On("GetPortfolioItemForUpdate", mock.Anything, userID, int64(1), symbol).
 		Return(nil, pgx.ErrNoRows)
 
-	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, marketRepo, mockLadderRepo, mockTransactor)
+	tradeService := service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor)
 	_, err := tradeService.SellStock(ctx, userID, symbol, quantity)
 
 	assert.Error(t, err)
@@ -554,7 +608,7 @@ func TestTradeService_SellStock_ZeroHoldings(t *testing.T) {
 }
 
 func TestTradeService_BuyStock_InvalidQuantity(t *testing.T) {
-	tradeService := service.NewTrade(nil, nil, nil, nil, nil)
+	tradeService := service.NewTrade(nil, nil, nil, nil, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	testCases := []struct {
@@ -580,7 +634,7 @@ func TestTradeService_BuyStock_InvalidQuantity(t *testing.T) {
 }
 
 func TestTradeService_SellStock_InvalidQuantity(t *testing.T) {
-	tradeService := service.NewTrade(nil, nil, nil, nil, nil)
+	tradeService := service.NewTrade(nil, nil, nil, nil, nil, nil, nil, nil)
 	ctx := context.Background()
 
 	testCases := []struct {
@@ -604,3 +658,175 @@ func TestTradeService_SellStock_InvalidQuantity(t *testing.T) {
 		})
 	}
 }
+
+func TestTradeService_TwoSidedQuote_FillsAtAskAndBid(t *testing.T) {
+	const (
+		symbol       = "OA:EUR_USD"
+		startBalance = 10000.0
+		userID       = int64(1)
+	)
+
+	mr, _ := miniredis.Run()
+	defer mr.Close()
+
+	rClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	marketRepo := app_redis.NewMarketRepository(rClient)
+	err := marketRepo.SaveQuote(context.Background(), &domain.Quote{
+		Symbol:    symbol,
+		Price:     decimal.RequireFromString("1.1"),
+		Bid:       decimal.RequireFromString("1.0999"),
+		Ask:       decimal.RequireFromString("1.1001"),
+		Timestamp: time.Now(),
+	})
+	assert.NoError(t, err)
+
+	newService := func(item *domain.PortfolioItem, expectedBalance, expectedAverage string) (*service.Trade, *mocks.MockUserRepository) {
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockPortRepo := new(mocks.MockPortfolioRepository)
+		mockLadderRepo := new(mocks.MockLadderRepository)
+		mockTransactor := new(mocks.MockTransactor)
+		mockTx := new(mocks.MockTransaction)
+
+		mockTransactor.On("Begin", mock.Anything).Return(mockTx, nil)
+		mockUserRepo.On("WithTx", mockTx).Return(mockUserRepo)
+		mockPortRepo.On("WithTx", mockTx).Return(mockPortRepo)
+		mockLadderRepo.On("GetActiveLadder", mock.Anything).Return(int64(1), nil)
+		mockLadderRepo.On("GetLadder", mock.Anything, int64(1)).Return(&domain.Ladder{
+			ID:        1,
+			IsActive:  true,
+			StartTime: time.Now().Add(-1 * time.Hour),
+			EndTime:   time.Now().Add(1 * time.Hour),
+		}, nil)
+		mockLadderRepo.On("IsUserInLadder", mock.Anything, int64(1), userID).Return(true, nil)
+		mockUserRepo.On("GetUserForUpdate", mock.Anything, userID).Return(&domain.User{ID: userID}, nil)
+		mockUserRepo.On("GetUserBalance", mock.Anything, userID, int64(1)).Return(decimal.NewFromFloat(startBalance), nil)
+		mockPortRepo.On("GetPortfolioItemForUpdate", mock.Anything, userID, int64(1), symbol).Return(item, nil)
+		mockUserRepo.On("UpdateUserBalance", mock.Anything, userID, int64(1), mock.MatchedBy(func(d decimal.Decimal) bool {
+			return d.Equal(decimal.RequireFromString(expectedBalance))
+		})).Return(nil)
+		mockPortRepo.On("SetPortfolioItem", mock.Anything, userID, int64(1), symbol, mock.Anything, mock.MatchedBy(func(p decimal.Decimal) bool {
+			return p.Equal(decimal.RequireFromString(expectedAverage))
+		})).Return(nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+
+		return service.NewTrade(mockUserRepo, mockPortRepo, newMockFillRepo(), marketRepo, mockLadderRepo, nil, service.NewFXRates(nil, 0), mockTransactor), mockUserRepo
+	}
+
+	t.Run("BuyAtAsk", func(t *testing.T) {
+		tradeService, userRepo := newService(&domain.PortfolioItem{StockSymbol: symbol}, "8899.9", "1.1001")
+
+		_, err := tradeService.BuyStock(context.Background(), userID, symbol, 1000)
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+	})
+
+	t.Run("SellAtBid", func(t *testing.T) {
+		held := &domain.PortfolioItem{
+			StockSymbol:  symbol,
+			Quantity:     decimal.NewFromInt(2000),
+			AveragePrice: decimal.RequireFromString("1.1001"),
+		}
+		tradeService, userRepo := newService(held, "11099.9", "1.1001")
+
+		_, err := tradeService.SellStock(context.Background(), userID, symbol, 1000)
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+	})
+}
+
+func TestTradeService_BuyStock_ConvertsIntoBaseCurrency(t *testing.T) {
+	const (
+		symbol = "FH:SAP"
+		userID = int64(1)
+	)
+
+	mr, _ := miniredis.Run()
+	defer mr.Close()
+
+	rClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	marketRepo := app_redis.NewMarketRepository(rClient)
+	err := marketRepo.SaveQuote(context.Background(), &domain.Quote{
+		Symbol:    symbol,
+		Price:     decimal.NewFromInt(200),
+		Timestamp: time.Now(),
+	})
+	assert.NoError(t, err)
+
+	newService := func(fxRepo service.FXRateRepository) (*service.Trade, *mocks.MockUserRepository, *mocks.MockFillRepository) {
+		mockUserRepo := new(mocks.MockUserRepository)
+		mockPortRepo := new(mocks.MockPortfolioRepository)
+		mockFillRepo := new(mocks.MockFillRepository)
+		mockLadderRepo := new(mocks.MockLadderRepository)
+		mockTransactor := new(mocks.MockTransactor)
+		mockTx := new(mocks.MockTransaction)
+
+		mockTransactor.On("Begin", mock.Anything).Return(mockTx, nil)
+		mockUserRepo.On("WithTx", mockTx).Return(mockUserRepo)
+		mockPortRepo.On("WithTx", mockTx).Return(mockPortRepo)
+		mockFillRepo.On("WithTx", mockTx).Return(mockFillRepo)
+		mockLadderRepo.On("GetActiveLadder", mock.Anything).Return(int64(1), nil)
+		mockLadderRepo.On("GetLadder", mock.Anything, int64(1)).Return(&domain.Ladder{
+			ID:             1,
+			IsActive:       true,
+			StartTime:      time.Now().Add(-1 * time.Hour),
+			EndTime:        time.Now().Add(1 * time.Hour),
+			BaseCurrency:   domain.CurrencyUSD,
+			AllowedTickers: []domain.TickerInfo{{Symbol: symbol, Currency: "EUR"}},
+		}, nil)
+		mockLadderRepo.On("IsUserInLadder", mock.Anything, int64(1), userID).Return(true, nil)
+		mockUserRepo.On("GetUserForUpdate", mock.Anything, userID).Return(&domain.User{ID: userID}, nil)
+		mockUserRepo.On("GetUserBalance", mock.Anything, userID, int64(1)).Return(decimal.NewFromInt(10000), nil)
+		mockPortRepo.On("GetPortfolioItemForUpdate", mock.Anything, userID, int64(1), symbol).
+			Return(&domain.PortfolioItem{StockSymbol: symbol}, nil)
+		mockPortRepo.On("SetPortfolioItem", mock.Anything, userID, int64(1), symbol, mock.Anything, mock.MatchedBy(func(p decimal.Decimal) bool {
+			// The average price stays in the instrument's currency.
+			return p.Equal(decimal.NewFromInt(200))
+		})).Return(nil)
+		mockTx.On("Commit", mock.Anything).Return(nil)
+		mockTx.On("Rollback", mock.Anything).Return(nil)
+
+		return service.NewTrade(mockUserRepo, mockPortRepo, mockFillRepo, marketRepo, mockLadderRepo, nil, service.NewFXRates(fxRepo, time.Hour), mockTransactor),
+			mockUserRepo, mockFillRepo
+	}
+
+	t.Run("RecordsFillWithRate", func(t *testing.T) {
+		fxRepo := app_redis.NewFXRateRepository(rClient)
+		err := fxRepo.SaveRates(context.Background(), []*domain.FXRate{
+			{Currency: "EUR", Rate: decimal.RequireFromString("1.1"), UpdatedAt: time.Now()},
+		})
+		assert.NoError(t, err)
+
+		tradeService, userRepo, fillRepo := newService(fxRepo)
+		userRepo.On("UpdateUserBalance", mock.Anything, userID, int64(1), mock.MatchedBy(func(d decimal.Decimal) bool {
+			// 5 * 200 EUR at 1.1 USD/EUR = 1100 USD
+			return d.Equal(decimal.NewFromInt(8900))
+		})).Return(nil)
+		fillRepo.On("RecordFill", mock.Anything, mock.MatchedBy(func(f *domain.Fill) bool {
+			return f.Side == domain.FillSideBuy && f.Currency == "EUR" &&
+				f.FXRate.Equal(decimal.RequireFromString("1.1")) && f.Amount.Equal(decimal.NewFromInt(1100))
+		})).Return(nil)
+
+		_, err = tradeService.BuyStock(context.Background(), userID, symbol, 5)
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+		fillRepo.AssertExpectations(t)
+	})
+
+	t.Run("RejectsWithoutRate", func(t *testing.T) {
+		mr.FlushAll()
+		err := marketRepo.SaveQuote(context.Background(), &domain.Quote{
+			Symbol:    symbol,
+			Price:     decimal.NewFromInt(200),
+			Timestamp: time.Now(),
+		})
+		assert.NoError(t, err)
+
+		tradeService, userRepo, fillRepo := newService(app_redis.NewFXRateRepository(rClient))
+
+		_, err = tradeService.BuyStock(context.Background(), userID, symbol, 5)
+		assert.ErrorIs(t, err, apperrors.ErrFXRateUnavailable)
+		userRepo.AssertNotCalled(t, "UpdateUserBalance", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		fillRepo.AssertNotCalled(t, "RecordFill", mock.Anything, mock.Anything)
+	})
+}
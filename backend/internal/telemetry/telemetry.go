@@ -9,8 +9,10 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
@@ -18,7 +20,7 @@ import (
 	"github.com/tmythicator/ticker-rush/backend/internal/config"
 )
 
-// InitTelemetry initializes OpenTelemetry tracing and returns a shutdown function.
+// InitTelemetry initializes OpenTelemetry tracing and metrics and returns a shutdown function.
 func InitTelemetry(ctx context.Context, cfg *config.Config) (func(), error) {
 	if cfg.OtelEndpoint == "" {
 		log.Println("OTel tracing is disabled (OTEL_EXPORTER_OTLP_ENDPOINT is empty)")
@@ -74,6 +76,27 @@ func InitTelemetry(ctx context.Context, cfg *config.Config) (func(), error) {
 		propagation.Baggage{},
 	))
 
+	metricOpts := []otlpmetrichttp.Option{
+		otlpmetrichttp.WithEndpoint(endpoint),
+		otlpmetrichttp.WithHeaders(headers),
+	}
+
+	if isInsecure {
+		metricOpts = append(metricOpts, otlpmetrichttp.WithInsecure())
+	}
+
+	metricExporter, err := otlpmetrichttp.New(ctx, metricOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP metric exporter: %w", err)
+	}
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter)),
+		sdkmetric.WithResource(res),
+	)
+
+	otel.SetMeterProvider(mp)
+
 	log.Printf("OTel tracing successfully initialized. Exporting to %s (Insecure: %v)", endpoint, isInsecure)
 
 	shutdown := func() {
@@ -82,6 +105,9 @@ func InitTelemetry(ctx context.Context, cfg *config.Config) (func(), error) {
 		if err := tp.Shutdown(shutdownCtx); err != nil {
 			log.Printf("Error shutting down tracer provider: %v", err)
 		}
+		if err := mp.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Error shutting down meter provider: %v", err)
+		}
 	}
 
 	return shutdown, nil
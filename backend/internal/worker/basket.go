@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// BasketWorker prices baskets from the quotes of their components and publishes them like
+// the quotes of any other symbol.
+type BasketWorker struct {
+	baskets   *service.Basket
+	publisher *quotePublisher
+	interval  time.Duration
+}
+
+// NewBasketWorker creates a new instance of BasketWorker.
+func NewBasketWorker(
+	baskets *service.Basket,
+	marketRepo service.MarketRepository,
+	historyRepo service.HistoryRepository,
+	interval time.Duration,
+) *BasketWorker {
+	return &BasketWorker{
+		baskets:   baskets,
+		publisher: newQuotePublisher("BasketWorker", marketRepo, historyRepo),
+		interval:  interval,
+	}
+}
+
+// EnableLeaderElection makes the worker rebalance and publish only while it holds its lease,
+// so that several fetcher replicas can run side by side. Leases last ttl and are renewed
+// three times per ttl.
+func (w *BasketWorker) EnableLeaderElection(leases LeaseStore, holder string, ttl time.Duration) {
+	w.publisher.enableLeaderElection(leases, holder, ttl)
+}
+
+// Start begins the pricing loop. Baskets are reloaded on every run, so that new baskets
+// are priced without a restart.
+func (w *BasketWorker) Start(ctx context.Context) error {
+	leaseTick, releaseLease := w.publisher.holdLease(ctx)
+	defer releaseLease()
+
+	w.RunOnce(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.RunOnce(ctx)
+		case <-leaseTick:
+			w.publisher.renewLease(ctx)
+		case <-ctx.Done():
+			log.Println("[BasketWorker] Stopping...")
+
+			return ctx.Err()
+		}
+	}
+}
+
+// RunOnce rebalances the baskets that are due and prices and publishes every basket whose
+// price or timestamp changed. A standby replica does nothing.
+func (w *BasketWorker) RunOnce(ctx context.Context) {
+	if !w.publisher.leading() {
+		return
+	}
+
+	baskets, err := w.baskets.List(ctx)
+	if err != nil {
+		log.Printf("[BasketWorker] Failed to list baskets: %v", err)
+
+		return
+	}
+
+	now := time.Now()
+	for _, b := range baskets {
+		if next := b.NextRebalance(); !next.IsZero() && !now.Before(next) {
+			if errRebalance := w.baskets.Rebalance(ctx, b, now); errRebalance != nil {
+				log.Printf("[%s] Basket not rebalanced: %v", b.Symbol, errRebalance)
+			}
+		}
+
+		quote, errQuote := w.baskets.Quote(ctx, b)
+		if errQuote != nil {
+			log.Printf("[%s] Basket not priced: %v", b.Symbol, errQuote)
+
+			continue
+		}
+
+		w.publisher.publish(ctx, quote)
+	}
+}
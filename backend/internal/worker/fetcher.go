@@ -3,40 +3,78 @@ package worker
 
 import (
 	"context"
+	"errors"
 	"log"
-	"math"
+	"slices"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
-	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/service"
 )
 
 // QuoteProvider defines the interface for fetching quotes.
 type QuoteProvider interface {
-	GetQuote(ctx context.Context, symbol string) (*exchange.Quote, error)
+	GetQuote(ctx context.Context, symbol string) (*domain.Quote, error)
+}
+
+// BatchQuoteProvider is implemented by providers that can fetch quotes for
+// several symbols in a single request.
+type BatchQuoteProvider interface {
+	QuoteProvider
+	GetQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error)
 }
 
 // FetcherConfig holds configuration for the MarketFetcher.
 type FetcherConfig struct {
-	FetchInterval   time.Duration
+	// FetchInterval is the provider quota: on average one request per interval.
+	FetchInterval time.Duration
+	// Burst is how many requests may be made back to back after an idle period.
+	Burst           int
+	MaxBackoff      time.Duration
 	RefreshInterval time.Duration
 	RequestTimeout  time.Duration
+	// MaxDeviation is the largest accepted relative distance from the rolling median, e.g. 0.15.
+	// Zero disables the check.
+	MaxDeviation float64
+	// MaxQuoteAge is how old a provider timestamp may be when the price has changed.
+	// Zero disables the check.
+	MaxQuoteAge time.Duration
+	// ValidationWindow is how many recent prices the rolling median is taken over.
+	ValidationWindow int
+	// AcceptLevelShifts publishes a new price level once several consecutive outliers agree
+	// on it. Otherwise they stay quarantined until an admin releases one of them.
+	AcceptLevelShifts bool
+	// LeaseTTL is how long a replica stays leader without renewing, see EnableLeaderElection.
+	LeaseTTL time.Duration
 }
 
 // MarketFetcher is a worker that fetches market data.
 type MarketFetcher struct {
-	client      QuoteProvider
-	currentRepo service.MarketRepository
-	historyRepo service.HistoryRepository
-	ladderRepo  service.LadderRepository
-	source      string // "Finnhub" or "CoinGecko"
-	cfg         *FetcherConfig
+	client         QuoteProvider
+	currentRepo    service.MarketRepository
+	historyRepo    service.HistoryRepository
+	ladderRepo     service.LadderRepository
+	quarantineRepo service.QuarantineRepository
+	source         string // domain.SourceFinnhub, domain.SourceCoinGecko, domain.SourceOANDA or domain.SourceSynthetic
+	tickSizes      map[string]decimal.Decimal
+	pipSizes       map[string]decimal.Decimal
+	cfg            *FetcherConfig
+	validator      *quoteValidator
+	refreshLatency metric.Float64Histogram
+	quarantined    metric.Int64Counter
+	dropped        metric.Int64Counter
+
+	leases LeaseStore
+	holder string
+	fence  *domain.Fence // set while this replica holds the lease
 }
 
 // NewMarketFetcher creates a new instance of MarketFetcher.
@@ -46,15 +84,50 @@ func NewMarketFetcher(
 	currentRepo service.MarketRepository,
 	historyRepo service.HistoryRepository,
 	ladderRepo service.LadderRepository,
+	quarantineRepo service.QuarantineRepository,
 	cfg *FetcherConfig,
 ) *MarketFetcher {
+	meter := otel.Meter("market-fetcher")
+
+	refreshLatency, err := meter.Float64Histogram(
+		"fetcher.refresh_latency",
+		metric.WithDescription("Time between two successful refreshes of the same symbol"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		log.Printf("[Fetcher:%s] Failed to create refresh latency histogram: %v", source, err)
+	}
+
+	quarantined, err := meter.Int64Counter(
+		"fetcher.quotes_quarantined",
+		metric.WithDescription("Quotes held back because they failed validation"),
+	)
+	if err != nil {
+		log.Printf("[Fetcher:%s] Failed to create quarantine counter: %v", source, err)
+	}
+
+	dropped, err := meter.Int64Counter(
+		"fetcher.quotes_dropped",
+		metric.WithDescription("Quotes that failed validation but could not be quarantined because it is full"),
+	)
+	if err != nil {
+		log.Printf("[Fetcher:%s] Failed to create dropped quote counter: %v", source, err)
+	}
+
 	return &MarketFetcher{
-		source:      source,
-		client:      client,
-		currentRepo: currentRepo,
-		historyRepo: historyRepo,
-		ladderRepo:  ladderRepo,
-		cfg:         cfg,
+		source:         source,
+		tickSizes:      make(map[string]decimal.Decimal),
+		pipSizes:       make(map[string]decimal.Decimal),
+		client:         client,
+		currentRepo:    currentRepo,
+		historyRepo:    historyRepo,
+		ladderRepo:     ladderRepo,
+		quarantineRepo: quarantineRepo,
+		cfg:            cfg,
+		validator:      newQuoteValidator(cfg),
+		refreshLatency: refreshLatency,
+		quarantined:    quarantined,
+		dropped:        dropped,
 	}
 }
 
@@ -63,14 +136,25 @@ func (w *MarketFetcher) Start(ctx context.Context) error {
 	refreshTicker := time.NewTicker(w.cfg.RefreshInterval)
 	defer refreshTicker.Stop()
 
-	fetchTicker := time.NewTicker(w.cfg.FetchInterval)
-	defer fetchTicker.Stop()
+	fetchTimer := time.NewTimer(0)
+	defer fetchTimer.Stop()
 
-	lastQuotes := make(map[string]*exchange.Quote)
+	sched := newFetchScheduler(w.cfg, time.Now())
+	lastQuotes := make(map[string]*domain.Quote)
 	lastHistorySave := make(map[string]time.Time)
 
 	symbols := w.refreshTickers(ctx)
-	idx := 0
+	sched.setHot(w.refreshDemand(ctx, symbols))
+
+	var leaseTick <-chan time.Time
+	if w.leases != nil {
+		leaseTicker := time.NewTicker(w.cfg.LeaseTTL / 3)
+		defer leaseTicker.Stop()
+		defer w.releaseLease()
+
+		leaseTick = leaseTicker.C
+		w.renewLease(ctx)
+	}
 
 	for {
 		select {
@@ -81,24 +165,124 @@ func (w *MarketFetcher) Start(ctx context.Context) error {
 
 		case <-refreshTicker.C:
 			symbols = w.refreshTickers(ctx)
+			sched.forget(symbols)
+			sched.setHot(w.refreshDemand(ctx, symbols))
+
+		case <-leaseTick:
+			w.renewLease(ctx)
+
+		case <-fetchTimer.C:
+			if len(symbols) == 0 || !w.leading() {
+				fetchTimer.Reset(w.cfg.FetchInterval)
 
-		case <-fetchTicker.C:
-			if len(symbols) == 0 {
 				continue
 			}
 
-			idx = idx % len(symbols)
-			symbol := symbols[idx]
-			idx++
-
-			q, err := w.processTicker(ctx, symbol, lastQuotes[symbol], lastHistorySave)
-			if err != nil {
-				log.Printf("[%s] Fetch failed: %v", symbol, err)
-			} else if q != nil {
-				lastQuotes[symbol] = q
+			if sched.take(time.Now()) {
+				w.fetch(ctx, sched, symbols, lastQuotes, lastHistorySave)
 			}
+
+			fetchTimer.Reset(sched.delay(time.Now()))
+		}
+	}
+}
+
+// fetch makes a single provider request: one batch for all symbols if the provider
+// supports it, otherwise one quote for the most overdue symbol.
+func (w *MarketFetcher) fetch(
+	ctx context.Context,
+	sched *fetchScheduler,
+	symbols []string,
+	lastQuotes map[string]*domain.Quote,
+	lastHistorySave map[string]time.Time,
+) {
+	now := time.Now()
+
+	var (
+		refreshed []string
+		err       error
+	)
+
+	if batchClient, ok := w.client.(BatchQuoteProvider); ok {
+		for _, symbol := range symbols {
+			sched.attempted(symbol, now)
+		}
+
+		refreshed, err = w.processBatch(ctx, batchClient, symbols, lastQuotes, lastHistorySave)
+	} else {
+		symbol := sched.next(symbols, now)
+		sched.attempted(symbol, now)
+
+		var q *domain.Quote
+		q, err = w.processTicker(ctx, symbol, lastQuotes[symbol], lastHistorySave)
+		if err == nil && q != nil {
+			lastQuotes[symbol] = q
+			refreshed = []string{symbol}
+		}
+	}
+
+	if err != nil {
+		if backoff := sched.failed(now, err); backoff > 0 {
+			log.Printf("[Fetcher:%s] Rate limited, backing off for %s", w.source, backoff)
+		} else {
+			log.Printf("[Fetcher:%s] Fetch failed: %v", w.source, err)
+		}
+
+		return
+	}
+
+	sched.succeeded()
+
+	for _, symbol := range refreshed {
+		if latency, ok := sched.refreshed(symbol, now); ok && w.refreshLatency != nil {
+			w.refreshLatency.Record(ctx, latency.Seconds(), metric.WithAttributes(
+				attribute.String("fetcher.source", w.source),
+				attribute.String("fetcher.symbol", symbol),
+			))
+		}
+	}
+}
+
+// refreshDemand returns the tracked symbols that are held in a portfolio of the
+// active ladder or watched by at least one live quote stream.
+func (w *MarketFetcher) refreshDemand(ctx context.Context, symbols []string) []string {
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	hot := make(map[string]bool)
+
+	activeLadderID, err := w.ladderRepo.GetActiveLadder(ctx)
+	if err == nil {
+		held, heldErr := w.ladderRepo.GetHeldSymbols(ctx, activeLadderID)
+		if heldErr != nil {
+			log.Printf("[Fetcher:%s] Failed to get held symbols: %v", w.source, heldErr)
+		}
+
+		for _, symbol := range held {
+			hot[symbol] = true
+		}
+	}
+
+	watchers, err := w.currentRepo.CountSubscribers(ctx, symbols)
+	if err != nil {
+		log.Printf("[Fetcher:%s] Failed to count quote subscribers: %v", w.source, err)
+	}
+
+	for symbol, count := range watchers {
+		if count > 0 {
+			hot[symbol] = true
+		}
+	}
+
+	var prioritized []string
+	for _, symbol := range symbols {
+		if hot[symbol] {
+			prioritized = append(prioritized, symbol)
 		}
 	}
+
+	return prioritized
 }
 
 func (w *MarketFetcher) refreshTickers(ctx context.Context) []string {
@@ -116,10 +300,21 @@ func (w *MarketFetcher) refreshTickers(ctx context.Context) []string {
 		return nil
 	}
 
+	// Baskets are priced from their components' quotes, so those are tracked as well.
+	components, err := w.ladderRepo.GetBasketComponents(ctx, activeLadderID)
+	if err != nil {
+		log.Printf("[Fetcher:%s] Failed to get basket components: %v", w.source, err)
+	}
+	tickers = append(tickers, components...)
+
 	var filtered []string
+	clear(w.tickSizes)
+	clear(w.pipSizes)
 	for _, t := range tickers {
-		if t.Source == w.source {
+		if t.Source == w.source && !slices.Contains(filtered, t.Symbol) {
 			filtered = append(filtered, t.Symbol)
+			w.tickSizes[t.Symbol] = t.TickSize
+			w.pipSizes[t.Symbol] = t.PipSize
 		}
 	}
 
@@ -133,9 +328,9 @@ func (w *MarketFetcher) refreshTickers(ctx context.Context) []string {
 func (w *MarketFetcher) processTicker(
 	ctx context.Context,
 	symbol string,
-	lastQuote *exchange.Quote,
+	lastQuote *domain.Quote,
 	lastHistorySave map[string]time.Time,
-) (*exchange.Quote, error) {
+) (*domain.Quote, error) {
 	tracer := otel.Tracer("market-fetcher")
 	ctx, span := tracer.Start(ctx, "MarketFetcher.processTicker")
 	defer span.End()
@@ -156,33 +351,106 @@ func (w *MarketFetcher) processTicker(
 		return nil, err
 	}
 
-	quote.Price = math.Round(quote.GetPrice()*100) / 100
+	return w.storeQuote(ctx, symbol, quote, lastQuote, lastHistorySave)
+}
+
+func (w *MarketFetcher) processBatch(
+	ctx context.Context,
+	client BatchQuoteProvider,
+	symbols []string,
+	lastQuotes map[string]*domain.Quote,
+	lastHistorySave map[string]time.Time,
+) ([]string, error) {
+	tracer := otel.Tracer("market-fetcher")
+	ctx, span := tracer.Start(ctx, "MarketFetcher.processBatch")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("fetcher.source", w.source),
+		attribute.Int("fetcher.batch_size", len(symbols)),
+	)
+
+	fetchCtx, cancel := context.WithTimeout(ctx, w.cfg.RequestTimeout)
+	defer cancel()
+
+	quotes, err := client.GetQuotes(fetchCtx, symbols)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("fetcher.batch_received", len(quotes)))
+
+	refreshed := make([]string, 0, len(quotes))
+	for _, quote := range quotes {
+		symbol := quote.Symbol
+
+		q, storeErr := w.storeQuote(ctx, symbol, quote, lastQuotes[symbol], lastHistorySave)
+		if storeErr != nil {
+			log.Printf("[%s] Store failed: %v", symbol, storeErr)
+
+			continue
+		}
+		if q == nil {
+			continue
+		}
+
+		lastQuotes[symbol] = q
+		refreshed = append(refreshed, symbol)
+	}
+
+	return refreshed, nil
+}
 
-	isClosed := domain.CalculateIsClosed(quote.IsClosed, quote.GetTimestamp().AsTime())
+// storeQuote normalizes a fetched quote and persists it unless it is identical to the last one.
+// Quotes that fail validation are quarantined instead, in which case a nil quote is returned.
+func (w *MarketFetcher) storeQuote(
+	ctx context.Context,
+	symbol string,
+	quote *domain.Quote,
+	lastQuote *domain.Quote,
+	lastHistorySave map[string]time.Time,
+) (*domain.Quote, error) {
+	span := trace.SpanFromContext(ctx)
+
+	// Prices keep the precision of the instrument's tick size rather than a fixed number of decimals.
+	tickSize := w.tickSizes[symbol]
+	quote.Precision = domain.PricePrecision(tickSize)
+	quote.Price = domain.RoundToTick(quote.Price, tickSize)
+	if quote.IsTwoSided() {
+		quote.Bid = domain.RoundToTick(quote.Bid, tickSize)
+		quote.Ask = domain.RoundToTick(quote.Ask, tickSize)
+		quote.PipSize = w.pipSizes[symbol]
+	}
+	quote.Change = quote.Change.Round(quote.Precision)
+	quote.ChangePercent = quote.ChangePercent.Round(2)
+	quote.IsClosed = domain.CalculateIsClosed(quote.IsClosed, quote.Timestamp)
 
 	span.SetAttributes(
-		attribute.Float64("fetcher.price", quote.Price),
-		attribute.Int64("fetcher.timestamp", quote.GetTimestamp().GetSeconds()),
-		attribute.Bool("fetcher.is_closed", isClosed),
+		attribute.String("fetcher.price", quote.Price.String()),
+		attribute.Int64("fetcher.timestamp", quote.Timestamp.Unix()),
+		attribute.Bool("fetcher.is_closed", quote.IsClosed),
 	)
 
-	if lastQuote != nil && quote.GetPrice() == lastQuote.GetPrice() &&
-		quote.GetTimestamp().GetSeconds() == lastQuote.GetTimestamp().GetSeconds() &&
-		quote.GetTimestamp().GetNanos() == lastQuote.GetTimestamp().GetNanos() {
+	if lastQuote != nil && quote.Price.Equal(lastQuote.Price) && quote.Timestamp.Equal(lastQuote.Timestamp) {
 		span.SetAttributes(attribute.Bool("fetcher.skipped_save", true))
 
 		return lastQuote, nil
 	}
 
-	domainQuote := &domain.Quote{
-		Symbol:    quote.Symbol,
-		Price:     decimal.NewFromFloat(quote.Price),
-		Timestamp: quote.GetTimestamp().AsTime(),
-		Source:    quote.Source,
-		IsClosed:  isClosed,
+	reason, detail := w.validator.check(quote, time.Now())
+	if reason == domain.QuarantineReasonLevelShift && w.levelShiftReleased(ctx, quote) {
+		reason = ""
+	}
+	if reason != "" {
+		span.SetAttributes(attribute.String("fetcher.quarantined", reason))
+
+		return nil, w.quarantine(ctx, quote, reason, detail)
 	}
 
-	if err := w.currentRepo.SaveQuote(ctx, domainQuote); err != nil {
+	if err := w.saveCurrent(ctx, quote); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		log.Printf("[%s] Current Save Error: %v", quote.Symbol, err)
@@ -194,7 +462,7 @@ func (w *MarketFetcher) processTicker(
 	if time.Since(lastHistorySave[symbol]) >= time.Minute {
 		span.SetAttributes(attribute.Bool("fetcher.saved_history", true))
 		saveCtx, historyCancel := context.WithTimeout(context.Background(), 5*time.Second)
-		if err := w.historyRepo.SaveQuote(saveCtx, domainQuote); err != nil {
+		if err := w.historyRepo.SaveQuote(saveCtx, quote); err != nil {
 			log.Printf("[%s] History Save Error: %v", quote.Symbol, err)
 		} else {
 			lastHistorySave[symbol] = time.Now()
@@ -202,12 +470,64 @@ func (w *MarketFetcher) processTicker(
 		historyCancel()
 	}
 
+	w.validator.accepted(quote)
+
 	log.Printf(
-		"[%s] Updated: $%.2f (ts: %d)",
-		quote.GetSymbol(),
-		quote.GetPrice(),
-		quote.GetTimestamp().GetSeconds(),
+		"[%s] Updated: $%s (ts: %d)",
+		quote.Symbol,
+		quote.Price.StringFixed(quote.Precision),
+		quote.Timestamp.Unix(),
 	)
 
 	return quote, nil
 }
+
+// levelShiftReleased reports whether an admin released a quote at the quote's new price
+// level. The live quote is then newer than anything the validator accepted, and validation
+// continues from it.
+func (w *MarketFetcher) levelShiftReleased(ctx context.Context, quote *domain.Quote) bool {
+	current, err := w.currentRepo.GetQuote(ctx, quote.Symbol)
+	if err != nil || !w.validator.newerThanAccepted(current) {
+		return false
+	}
+
+	log.Printf("[%s] Level shift to $%s released, validating from it", quote.Symbol, current.Price)
+	w.validator.confirmed(current)
+	reason, _ := w.validator.check(quote, time.Now())
+
+	return reason == ""
+}
+
+// quarantine holds back a quote that failed validation so an admin can review it. When the
+// quarantine is full, the quote is dropped and counted.
+func (w *MarketFetcher) quarantine(ctx context.Context, quote *domain.Quote, reason, detail string) error {
+	attrs := metric.WithAttributes(
+		attribute.String("fetcher.source", w.source),
+		attribute.String("fetcher.reason", reason),
+	)
+
+	err := w.quarantineRepo.Quarantine(ctx, &domain.QuarantinedQuote{
+		Quote:         *quote,
+		Reason:        reason,
+		Detail:        detail,
+		QuarantinedAt: time.Now(),
+	})
+	if errors.Is(err, apperrors.ErrQuarantineFull) {
+		log.Printf("[%s] Dropped %s quote $%s, quarantine is full: %s", quote.Symbol, reason, quote.Price, detail)
+		if w.dropped != nil {
+			w.dropped.Add(ctx, 1, attrs)
+		}
+
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[%s] Quarantined %s quote $%s: %s", quote.Symbol, reason, quote.Price, detail)
+	if w.quarantined != nil {
+		w.quarantined.Add(ctx, 1, attrs)
+	}
+
+	return nil
+}
@@ -6,12 +6,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
-	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
-	"github.com/tmythicator/ticker-rush/backend/internal/proto/exchange/v1"
 	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
 )
 
@@ -20,13 +20,27 @@ type MockQuoteProvider struct {
 	mock.Mock
 }
 
-func (m *MockQuoteProvider) GetQuote(ctx context.Context, symbol string) (*exchange.Quote, error) {
+func (m *MockQuoteProvider) GetQuote(ctx context.Context, symbol string) (*domain.Quote, error) {
 	args := m.Called(ctx, symbol)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 
-	return args.Get(0).(*exchange.Quote), args.Error(1)
+	return args.Get(0).(*domain.Quote), args.Error(1)
+}
+
+// MockBatchQuoteProvider mocks the BatchQuoteProvider interface.
+type MockBatchQuoteProvider struct {
+	MockQuoteProvider
+}
+
+func (m *MockBatchQuoteProvider) GetQuotes(ctx context.Context, symbols []string) ([]*domain.Quote, error) {
+	args := m.Called(ctx, symbols)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	return args.Get(0).([]*domain.Quote), args.Error(1)
 }
 
 func TestMarketFetcher_ProcessTicker(t *testing.T) {
@@ -46,10 +60,10 @@ func TestMarketFetcher_ProcessTicker(t *testing.T) {
 		mockHistoryRepo := new(mocks.MockHistoryRepository)
 		mockLadderRepo := new(mocks.MockLadderRepository)
 
-		fetchedQuote := &exchange.Quote{
+		fetchedQuote := &domain.Quote{
 			Symbol:    symbol,
-			Price:     150.256, // Will be rounded to 150.26
-			Timestamp: timestamppb.New(time.Now()),
+			Price:     decimal.NewFromFloat(150.256), // Will be rounded to 150.26
+			Timestamp: time.Now(),
 			Source:    source,
 			IsClosed:  false,
 		}
@@ -62,19 +76,45 @@ func TestMarketFetcher_ProcessTicker(t *testing.T) {
 			return q.Symbol == symbol && q.Price.InexactFloat64() == 150.26 && q.Source == source
 		})).Return(nil)
 
-		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, cfg)
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, new(mocks.MockQuarantineRepository), cfg)
+		w.tickSizes[symbol] = decimal.RequireFromString("0.01")
 		lastHistorySave := make(map[string]time.Time) // Empty so time.Since is > 1 min
 
 		res, err := w.processTicker(ctx, symbol, nil, lastHistorySave)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
-		assert.Equal(t, 150.26, res.Price)
+		assert.Equal(t, "150.26", res.Price.String())
+		assert.Equal(t, int32(2), res.Precision)
 		mockClient.AssertExpectations(t)
 		mockMarketRepo.AssertExpectations(t)
 		mockHistoryRepo.AssertExpectations(t)
 	})
 
+	t.Run("Success - Sub-Cent Price Keeps Its Precision", func(t *testing.T) {
+		mockClient := new(MockQuoteProvider)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+
+		mockClient.On("GetQuote", mock.Anything, "shiba-inu").Return(&domain.Quote{
+			Symbol:    "shiba-inu",
+			Price:     decimal.RequireFromString("0.000012345678912"),
+			Timestamp: time.Now(),
+			Source:    source,
+		}, nil)
+		mockMarketRepo.On("SaveQuote", mock.Anything, mock.Anything).Return(nil)
+		mockHistoryRepo.On("SaveQuote", mock.Anything, mock.Anything).Return(nil)
+
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, new(mocks.MockLadderRepository), new(mocks.MockQuarantineRepository), cfg)
+		w.tickSizes["shiba-inu"] = decimal.RequireFromString("0.00000001")
+
+		res, err := w.processTicker(ctx, "shiba-inu", nil, make(map[string]time.Time))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "0.00001235", res.Price.String())
+		assert.Equal(t, int32(8), res.Precision)
+	})
+
 	t.Run("Success - Duplicate Quote (Skip Save)", func(t *testing.T) {
 		mockClient := new(MockQuoteProvider)
 		mockMarketRepo := new(mocks.MockMarketRepository)
@@ -82,22 +122,22 @@ func TestMarketFetcher_ProcessTicker(t *testing.T) {
 		mockLadderRepo := new(mocks.MockLadderRepository)
 
 		timestamp := time.Now()
-		fetchedQuote := &exchange.Quote{
+		fetchedQuote := &domain.Quote{
 			Symbol:    symbol,
-			Price:     150.26,
-			Timestamp: timestamppb.New(timestamp),
+			Price:     decimal.NewFromFloat(150.26),
+			Timestamp: timestamp,
 			Source:    source,
 		}
-		lastQuote := &exchange.Quote{
+		lastQuote := &domain.Quote{
 			Symbol:    symbol,
-			Price:     150.26,
-			Timestamp: timestamppb.New(timestamp),
+			Price:     decimal.NewFromFloat(150.26),
+			Timestamp: timestamp,
 			Source:    source,
 		}
 
 		mockClient.On("GetQuote", mock.Anything, symbol).Return(fetchedQuote, nil)
 
-		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, cfg)
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, new(mocks.MockQuarantineRepository), cfg)
 		lastHistorySave := make(map[string]time.Time)
 
 		res, err := w.processTicker(ctx, symbol, lastQuote, lastHistorySave)
@@ -115,23 +155,23 @@ func TestMarketFetcher_ProcessTicker(t *testing.T) {
 		mockHistoryRepo := new(mocks.MockHistoryRepository)
 		mockLadderRepo := new(mocks.MockLadderRepository)
 
-		fetchedQuote := &exchange.Quote{
+		fetchedQuote := &domain.Quote{
 			Symbol:    symbol,
-			Price:     151.0,
-			Timestamp: timestamppb.New(time.Now()),
+			Price:     decimal.NewFromFloat(151.0),
+			Timestamp: time.Now(),
 			Source:    source,
 		}
-		lastQuote := &exchange.Quote{
+		lastQuote := &domain.Quote{
 			Symbol:    symbol,
-			Price:     150.0,
-			Timestamp: timestamppb.New(time.Now().Add(-10 * time.Second)),
+			Price:     decimal.NewFromFloat(150.0),
+			Timestamp: time.Now().Add(-10 * time.Second),
 			Source:    source,
 		}
 
 		mockClient.On("GetQuote", mock.Anything, symbol).Return(fetchedQuote, nil)
 		mockMarketRepo.On("SaveQuote", mock.Anything, mock.Anything).Return(nil)
 
-		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, cfg)
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, new(mocks.MockQuarantineRepository), cfg)
 		lastHistorySave := map[string]time.Time{
 			symbol: time.Now(), // Less than a minute ago
 		}
@@ -154,7 +194,7 @@ func TestMarketFetcher_ProcessTicker(t *testing.T) {
 		expectedErr := errors.New("client timeout")
 		mockClient.On("GetQuote", mock.Anything, symbol).Return(nil, expectedErr)
 
-		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, cfg)
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, new(mocks.MockQuarantineRepository), cfg)
 		lastHistorySave := make(map[string]time.Time)
 
 		res, err := w.processTicker(ctx, symbol, nil, lastHistorySave)
@@ -163,6 +203,95 @@ func TestMarketFetcher_ProcessTicker(t *testing.T) {
 		assert.Nil(t, res)
 		mockClient.AssertExpectations(t)
 	})
+
+	t.Run("Quarantine - Outlier Is Not Published", func(t *testing.T) {
+		mockClient := new(MockQuoteProvider)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		mockLadderRepo := new(mocks.MockLadderRepository)
+		mockQuarantineRepo := new(mocks.MockQuarantineRepository)
+
+		now := time.Now()
+		mockClient.On("GetQuote", mock.Anything, symbol).Return(&domain.Quote{
+			Symbol: symbol, Price: decimal.NewFromFloat(150), Timestamp: now, Source: source,
+		}, nil).Once()
+		mockClient.On("GetQuote", mock.Anything, symbol).Return(&domain.Quote{
+			Symbol: symbol, Price: decimal.NewFromFloat(1500), Timestamp: now.Add(time.Second), Source: source,
+		}, nil).Once()
+		mockMarketRepo.On("SaveQuote", mock.Anything, mock.Anything).Return(nil).Once()
+		mockHistoryRepo.On("SaveQuote", mock.Anything, mock.Anything).Return(nil).Once()
+		mockQuarantineRepo.On("Quarantine", mock.Anything, mock.MatchedBy(func(e *domain.QuarantinedQuote) bool {
+			return e.Quote.Price.InexactFloat64() == 1500 && e.Reason == domain.QuarantineReasonDeviation
+		})).Return(nil)
+
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, mockQuarantineRepo, &FetcherConfig{
+			RequestTimeout: 2 * time.Second,
+			MaxDeviation:   0.15,
+		})
+		lastHistorySave := make(map[string]time.Time)
+
+		first, err := w.processTicker(ctx, symbol, nil, lastHistorySave)
+		assert.NoError(t, err)
+
+		res, err := w.processTicker(ctx, symbol, first, lastHistorySave)
+
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+		mockMarketRepo.AssertNumberOfCalls(t, "SaveQuote", 1)
+		mockQuarantineRepo.AssertExpectations(t)
+	})
+
+	t.Run("Quarantine - Full Quarantine Drops Quote", func(t *testing.T) {
+		mockQuarantineRepo := new(mocks.MockQuarantineRepository)
+		mockQuarantineRepo.On("Quarantine", mock.Anything, mock.Anything).Return(apperrors.ErrQuarantineFull)
+
+		w := NewMarketFetcher(source, new(MockQuoteProvider), new(mocks.MockMarketRepository), new(mocks.MockHistoryRepository),
+			new(mocks.MockLadderRepository), mockQuarantineRepo, &FetcherConfig{RequestTimeout: 2 * time.Second})
+
+		err := w.quarantine(ctx, &domain.Quote{Symbol: symbol, Price: decimal.NewFromFloat(1500)}, domain.QuarantineReasonDeviation, "")
+
+		assert.NoError(t, err)
+		mockQuarantineRepo.AssertExpectations(t)
+	})
+
+	t.Run("Quarantine - Released Level Shift Is Accepted", func(t *testing.T) {
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		mockQuarantineRepo := new(mocks.MockQuarantineRepository)
+
+		now := time.Now()
+		quoteAt := func(price float64, offset int) *domain.Quote {
+			return &domain.Quote{Symbol: symbol, Price: decimal.NewFromFloat(price), Timestamp: now.Add(time.Duration(offset) * time.Second)}
+		}
+
+		mockMarketRepo.On("SaveQuote", mock.Anything, mock.Anything).Return(nil)
+		mockHistoryRepo.On("SaveQuote", mock.Anything, mock.Anything).Return(nil)
+		mockQuarantineRepo.On("Quarantine", mock.Anything, mock.Anything).Return(nil)
+
+		w := NewMarketFetcher(source, new(MockQuoteProvider), mockMarketRepo, mockHistoryRepo,
+			new(mocks.MockLadderRepository), mockQuarantineRepo, &FetcherConfig{RequestTimeout: 2 * time.Second, MaxDeviation: 0.15})
+		lastHistorySave := make(map[string]time.Time)
+
+		_, err := w.storeQuote(ctx, symbol, quoteAt(150, 0), nil, lastHistorySave)
+		assert.NoError(t, err)
+
+		// Without a release, consistent outliers stay quarantined.
+		mockMarketRepo.On("GetQuote", mock.Anything, symbol).Return(quoteAt(150, 0), nil).Once()
+		for i := 1; i <= levelShiftQuotes; i++ {
+			res, errStore := w.storeQuote(ctx, symbol, quoteAt(15, i), nil, lastHistorySave)
+			assert.NoError(t, errStore)
+			assert.Nil(t, res)
+		}
+
+		// An admin released one of them, so the next quote at the new level is published.
+		mockMarketRepo.On("GetQuote", mock.Anything, symbol).Return(quoteAt(15, 1), nil).Once()
+		res, err := w.storeQuote(ctx, symbol, quoteAt(15.1, levelShiftQuotes+1), nil, lastHistorySave)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		mockMarketRepo.AssertNumberOfCalls(t, "SaveQuote", 2)
+		mockQuarantineRepo.AssertNumberOfCalls(t, "Quarantine", levelShiftQuotes)
+	})
 }
 
 func TestMarketFetcher_RefreshTickers(t *testing.T) {
@@ -187,11 +316,16 @@ func TestMarketFetcher_RefreshTickers(t *testing.T) {
 			{Symbol: "BTCUSDT", Source: "CoinGecko"},
 			{Symbol: "MSFT", Source: "Finnhub"},
 		}, nil)
+		mockLadderRepo.On("GetBasketComponents", ctx, int64(1)).Return([]*domain.TickerInfo{
+			{Symbol: "AAPL", Source: "Finnhub"},
+			{Symbol: "NVDA", Source: "Finnhub"},
+			{Symbol: "ethereum", Source: "CoinGecko"},
+		}, nil)
 
-		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, cfg)
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, new(mocks.MockQuarantineRepository), cfg)
 		res := w.refreshTickers(ctx)
 
-		assert.Equal(t, []string{"AAPL", "MSFT"}, res)
+		assert.Equal(t, []string{"AAPL", "MSFT", "NVDA"}, res)
 		mockLadderRepo.AssertExpectations(t)
 	})
 
@@ -203,10 +337,75 @@ func TestMarketFetcher_RefreshTickers(t *testing.T) {
 
 		mockLadderRepo.On("GetActiveLadder", ctx).Return(int64(0), errors.New("db error"))
 
-		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, cfg)
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, new(mocks.MockQuarantineRepository), cfg)
 		res := w.refreshTickers(ctx)
 
 		assert.Nil(t, res)
 		mockLadderRepo.AssertExpectations(t)
 	})
 }
+
+func TestMarketFetcher_ProcessBatch(t *testing.T) {
+	ctx := context.Background()
+	source := "CoinGecko"
+
+	cfg := &FetcherConfig{
+		FetchInterval:   10 * time.Second,
+		RefreshInterval: 1 * time.Minute,
+		RequestTimeout:  2 * time.Second,
+	}
+
+	t.Run("Success - Stores Every Quote", func(t *testing.T) {
+		mockClient := new(MockBatchQuoteProvider)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		mockLadderRepo := new(mocks.MockLadderRepository)
+
+		symbols := []string{"bitcoin", "ethereum"}
+		mockClient.On("GetQuotes", mock.Anything, symbols).Return([]*domain.Quote{
+			{Symbol: "bitcoin", Price: decimal.NewFromFloat(65000.123), ChangePercent: decimal.NewFromFloat(2.5), Timestamp: time.Now(), Source: "CG"},
+			{Symbol: "ethereum", Price: decimal.NewFromFloat(3200.5), ChangePercent: decimal.NewFromFloat(-1.2), Timestamp: time.Now(), Source: "CG"},
+		}, nil)
+		mockMarketRepo.On("SaveQuote", mock.Anything, mock.MatchedBy(func(q *domain.Quote) bool {
+			return q.Symbol == "bitcoin" && q.Price.InexactFloat64() == 65000.12 && q.ChangePercent.InexactFloat64() == 2.5
+		})).Return(nil)
+		mockMarketRepo.On("SaveQuote", mock.Anything, mock.MatchedBy(func(q *domain.Quote) bool {
+			return q.Symbol == "ethereum" && q.ChangePercent.InexactFloat64() == -1.2
+		})).Return(nil)
+		mockHistoryRepo.On("SaveQuote", mock.Anything, mock.Anything).Return(nil)
+
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, new(mocks.MockQuarantineRepository), cfg)
+		w.tickSizes["bitcoin"] = decimal.RequireFromString("0.01")
+		lastQuotes := make(map[string]*domain.Quote)
+		lastHistorySave := make(map[string]time.Time)
+
+		refreshed, err := w.processBatch(ctx, mockClient, symbols, lastQuotes, lastHistorySave)
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, symbols, refreshed)
+		assert.Len(t, lastQuotes, 2)
+		assert.Contains(t, lastHistorySave, "bitcoin")
+		assert.Contains(t, lastHistorySave, "ethereum")
+		mockClient.AssertExpectations(t)
+		mockMarketRepo.AssertNumberOfCalls(t, "SaveQuote", 2)
+		mockClient.AssertNotCalled(t, "GetQuote", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error - Client Failure", func(t *testing.T) {
+		mockClient := new(MockBatchQuoteProvider)
+		mockMarketRepo := new(mocks.MockMarketRepository)
+		mockHistoryRepo := new(mocks.MockHistoryRepository)
+		mockLadderRepo := new(mocks.MockLadderRepository)
+
+		expectedErr := errors.New("rate limited (429)")
+		mockClient.On("GetQuotes", mock.Anything, []string{"bitcoin"}).Return(nil, expectedErr)
+
+		w := NewMarketFetcher(source, mockClient, mockMarketRepo, mockHistoryRepo, mockLadderRepo, new(mocks.MockQuarantineRepository), cfg)
+
+		refreshed, err := w.processBatch(ctx, mockClient, []string{"bitcoin"}, map[string]*domain.Quote{}, map[string]time.Time{})
+
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Empty(t, refreshed)
+		mockMarketRepo.AssertNotCalled(t, "SaveQuote")
+	})
+}
@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// FXRateWorker keeps the exchange rates of the currencies the active ladder trades in
+// cached, so that trades and valuations can convert them into the ladder's base currency.
+type FXRateWorker struct {
+	fxRates    *service.FXRates
+	provider   service.FXRateProvider
+	ladderRepo service.LadderRepository
+	interval   time.Duration
+}
+
+// NewFXRateWorker creates a new instance of FXRateWorker.
+func NewFXRateWorker(
+	fxRates *service.FXRates,
+	provider service.FXRateProvider,
+	ladderRepo service.LadderRepository,
+	interval time.Duration,
+) *FXRateWorker {
+	return &FXRateWorker{
+		fxRates:    fxRates,
+		provider:   provider,
+		ladderRepo: ladderRepo,
+		interval:   interval,
+	}
+}
+
+// Start begins the refresh loop.
+func (w *FXRateWorker) Start(ctx context.Context) error {
+	w.refresh(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.refresh(ctx)
+		case <-ctx.Done():
+			log.Println("[FXRateWorker] Stopping...")
+
+			return ctx.Err()
+		}
+	}
+}
+
+func (w *FXRateWorker) refresh(ctx context.Context) {
+	currencies, err := w.activeCurrencies(ctx)
+	if err != nil {
+		log.Printf("[FXRateWorker] Failed to get the active ladder's currencies: %v", err)
+
+		return
+	}
+
+	n, err := w.fxRates.Refresh(ctx, w.provider, currencies)
+	if err != nil {
+		log.Printf("[FXRateWorker] Refresh failed: %v", err)
+
+		return
+	}
+	if n < len(currencies) {
+		log.Printf("[FXRateWorker] Provider priced only %d of the currencies %v", n, currencies)
+	}
+}
+
+// activeCurrencies returns the base currency of the active ladder and the currencies its
+// tickers are quoted in, excluding USD which needs no rate.
+func (w *FXRateWorker) activeCurrencies(ctx context.Context) ([]string, error) {
+	ladderID, err := w.ladderRepo.GetActiveLadder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := w.ladderRepo.GetLadder(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	currencies := []string{l.Currency()}
+	for i := range l.AllowedTickers {
+		currencies = append(currencies, l.AllowedTickers[i].PriceCurrency())
+	}
+	slices.Sort(currencies)
+	currencies = slices.Compact(currencies)
+
+	return slices.DeleteFunc(currencies, func(c string) bool { return c == domain.CurrencyUSD }), nil
+}
@@ -16,6 +16,9 @@ type LadderLifecycleWorker struct {
 	ladderRepo    service.LadderRepository
 	portfolioRepo service.PortfolioRepository
 	marketRepo    service.MarketRepository
+	fxRates       *service.FXRates
+	predictions   *service.Predictions
+	market        *service.Market
 	interval      time.Duration
 }
 
@@ -24,12 +27,18 @@ func NewLadderLifecycleWorker(
 	ladderRepo service.LadderRepository,
 	portfolioRepo service.PortfolioRepository,
 	marketRepo service.MarketRepository,
+	fxRates *service.FXRates,
+	predictions *service.Predictions,
+	market *service.Market,
 	interval time.Duration,
 ) *LadderLifecycleWorker {
 	return &LadderLifecycleWorker{
 		ladderRepo:    ladderRepo,
 		portfolioRepo: portfolioRepo,
 		marketRepo:    marketRepo,
+		fxRates:       fxRates,
+		predictions:   predictions,
+		market:        market,
 		interval:      interval,
 	}
 }
@@ -54,7 +63,8 @@ func (w *LadderLifecycleWorker) Start(ctx context.Context) error {
 	}
 }
 
-// RunOnce executes one cycle of deactivation and activation checks.
+// RunOnce executes one cycle of deactivation and activation checks, then moves clients
+// streaming every ticker to the tickers of the ladder that is active now.
 func (w *LadderLifecycleWorker) RunOnce(ctx context.Context) {
 	now := time.Now()
 	if err := w.DeactivateExpiredLadders(ctx, now); err != nil {
@@ -63,6 +73,9 @@ func (w *LadderLifecycleWorker) RunOnce(ctx context.Context) {
 	if err := w.ActivatePendingLadders(ctx, now); err != nil {
 		log.Printf("[LadderLifecycleWorker] Error activating pending ladders: %v", err)
 	}
+	if err := w.market.RefreshStreamTickers(ctx); err != nil {
+		log.Printf("[LadderLifecycleWorker] Error refreshing streamed tickers: %v", err)
+	}
 }
 
 // DeactivateExpiredLadders finds and deactivates expired ladders, calculating rankings and pruning data.
@@ -75,7 +88,13 @@ func (w *LadderLifecycleWorker) DeactivateExpiredLadders(ctx context.Context, no
 	for _, l := range expired {
 		log.Printf("[LadderLifecycleWorker] Processing expiration of ladder %d (%s)...", l.ID, l.Name)
 
-		// 1. Fetch participants
+		// 1. Settle due prediction markets, so that their payouts count toward the final net worth.
+		// Markets that cannot be resolved are valued at their current share prices instead.
+		if _, err := w.predictions.ResolveDueInLadder(ctx, l.ID, now); err != nil {
+			log.Printf("[LadderLifecycleWorker] Failed to resolve prediction markets of ladder %d: %v", l.ID, err)
+		}
+
+		// 2. Fetch participants and open prediction positions
 		participants, err := w.ladderRepo.GetLadderParticipants(ctx, l.ID)
 		if err != nil {
 			log.Printf("[LadderLifecycleWorker] Failed to get participants for ladder %d: %v", l.ID, err)
@@ -83,6 +102,13 @@ func (w *LadderLifecycleWorker) DeactivateExpiredLadders(ctx context.Context, no
 			continue
 		}
 
+		predictionValues, err := w.predictions.OpenPositionValues(ctx, l.ID)
+		if err != nil {
+			log.Printf("[LadderLifecycleWorker] Failed to value prediction positions in ladder %d: %v", l.ID, err)
+
+			continue
+		}
+
 		type participantScore struct {
 			userID   int64
 			netWorth decimal.Decimal
@@ -90,8 +116,16 @@ func (w *LadderLifecycleWorker) DeactivateExpiredLadders(ctx context.Context, no
 		var scores []participantScore
 		quoteCache := make(map[string]decimal.Decimal)
 
+		// Net worth is ranked in the ladder's base currency, which needs the currencies of its tickers.
+		if full, errLadder := w.ladderRepo.GetLadder(ctx, l.ID); errLadder == nil {
+			l = full
+		} else {
+			log.Printf("[LadderLifecycleWorker] Failed to load tickers of ladder %d, assuming USD prices: %v", l.ID, errLadder)
+		}
+		valuation := w.fxRates.NewValuation(l)
+
 		for _, p := range participants {
-			netWorth := p.Balance // Starts with liquid cash balance
+			netWorth := p.Balance.Add(predictionValues[p.User.ID]) // Starts with liquid cash and open predictions
 
 			// Fetch portfolio items
 			items, err := w.portfolioRepo.GetPortfolio(ctx, p.User.ID, l.ID)
@@ -120,7 +154,11 @@ func (w *LadderLifecycleWorker) DeactivateExpiredLadders(ctx context.Context, no
 					quoteCache[symbol] = price
 				}
 
-				netWorth = netWorth.Add(qty.Mul(price))
+				value, errValue := valuation.Value(ctx, symbol, price, qty)
+				if errValue != nil {
+					log.Printf("[LadderLifecycleWorker] Failed to convert %s into %s: %v", symbol, l.Currency(), errValue)
+				}
+				netWorth = netWorth.Add(value)
 			}
 
 			scores = append(scores, participantScore{
@@ -129,12 +167,12 @@ func (w *LadderLifecycleWorker) DeactivateExpiredLadders(ctx context.Context, no
 			})
 		}
 
-		// 2. Sort scores in descending order of net worth
+		// 3. Sort scores in descending order of net worth
 		sort.Slice(scores, func(i, j int) bool {
 			return scores[i].netWorth.GreaterThan(scores[j].netWorth)
 		})
 
-		// 3. Save final scores and ranks
+		// 4. Save final scores and ranks
 		for rankIdx, score := range scores {
 			rank := int32(rankIdx + 1)
 			err := w.ladderRepo.InsertLadderParticipant(ctx, l.ID, score.userID, score.netWorth, rank)
@@ -143,17 +181,17 @@ func (w *LadderLifecycleWorker) DeactivateExpiredLadders(ctx context.Context, no
 			}
 		}
 
-		// 4. Prune portfolio items for this ladder (space saving)
+		// 5. Prune portfolio items for this ladder (space saving)
 		if err := w.ladderRepo.DeleteLadderPortfolioItemsByLadder(ctx, l.ID); err != nil {
 			log.Printf("[LadderLifecycleWorker] Failed to prune portfolio items for ladder %d: %v", l.ID, err)
 		}
 
-		// 5. Prune participants outside the top 20
+		// 6. Prune participants outside the top 20
 		if err := w.ladderRepo.PruneLadderParticipants(ctx, l.ID, 20); err != nil {
 			log.Printf("[LadderLifecycleWorker] Failed to prune participants for ladder %d: %v", l.ID, err)
 		}
 
-		// 6. Deactivate ladder
+		// 7. Deactivate ladder
 		if err := w.ladderRepo.UpdateLadderStatus(ctx, l.ID, false); err != nil {
 			log.Printf("[LadderLifecycleWorker] Failed to deactivate ladder %d: %v", l.ID, err)
 		}
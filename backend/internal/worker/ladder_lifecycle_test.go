@@ -6,9 +6,11 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
 	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
 	"github.com/tmythicator/ticker-rush/backend/internal/worker"
 )
@@ -17,6 +19,11 @@ func TestLadderLifecycleWorker_RunOnce_ExpiredLadders(t *testing.T) {
 	mockLadderRepo := new(mocks.MockLadderRepository)
 	mockPortRepo := new(mocks.MockPortfolioRepository)
 	mockMarketRepo := new(mocks.MockMarketRepository)
+	mockPredictionRepo := new(mocks.MockPredictionRepository)
+	mockHistoryRepo := new(mocks.MockHistoryRepository)
+	mockTransactor := new(mocks.MockTransactor)
+	mockTx := new(mocks.MockTransaction)
+	predictions := service.NewPredictions(mockPredictionRepo, nil, mockHistoryRepo, mockLadderRepo, mockTransactor, 100, time.Minute)
 
 	ctx := context.Background()
 	now := time.Now()
@@ -32,6 +39,7 @@ func TestLadderLifecycleWorker_RunOnce_ExpiredLadders(t *testing.T) {
 	}
 
 	mockLadderRepo.On("GetExpiredActiveLadders", mock.Anything, mock.Anything).Return([]*domain.Ladder{expiredLadder}, nil)
+	mockLadderRepo.On("GetLadder", mock.Anything, int64(10)).Return(expiredLadder, nil)
 
 	// 2. Setup participants: User 101 and User 102
 	participants := []domain.LadderParticipant{
@@ -69,21 +77,42 @@ func TestLadderLifecycleWorker_RunOnce_ExpiredLadders(t *testing.T) {
 		Price:  decimal.NewFromFloat(150.0),
 	}, nil)
 
+	// A prediction market of the ladder is due and resolves before ranking. A market of another
+	// ladder is left to the resolution worker.
+	due := &domain.PredictionMarket{ID: 1, LadderID: 10, Symbol: "AAPL", Threshold: decimal.NewFromInt(100), ResolvesAt: now.Add(-2 * time.Hour)}
+	otherLadder := &domain.PredictionMarket{ID: 2, LadderID: 11, Symbol: "AAPL", ResolvesAt: now.Add(-time.Hour)}
+	mockPredictionRepo.On("ListDuePredictionMarkets", mock.Anything, mock.Anything).Return([]*domain.PredictionMarket{due, otherLadder}, nil)
+	mockHistoryRepo.On("GetQuoteAt", mock.Anything, "AAPL", due.ResolvesAt).Return(&domain.Quote{Price: decimal.NewFromInt(120)}, nil)
+	mockTransactor.On("Begin", mock.Anything).Return(mockTx, nil)
+	mockTx.On("Commit", mock.Anything).Return(nil)
+	mockTx.On("Rollback", mock.Anything).Return(nil)
+	mockPredictionRepo.On("WithTx", mockTx).Return(mockPredictionRepo)
+	mockPredictionRepo.On("MarkPredictionMarketResolved", mock.Anything, due).Return(true, nil)
+	mockPredictionRepo.On("CreditPredictionPayouts", mock.Anything, due).Return(nil)
+
+	// User 101 also holds 1000 YES shares of a balanced, still unresolved market, worth 0.5 each.
+	mockPredictionRepo.On("ListOpenPredictionPositions", mock.Anything, int64(10)).Return([]*domain.PredictionPosition{
+		{MarketID: 3, UserID: 101, YesShares: decimal.NewFromInt(1000)},
+	}, nil)
+	mockPredictionRepo.On("ListPredictionMarkets", mock.Anything, int64(10)).Return([]*domain.PredictionMarket{
+		{ID: 3, LadderID: 10, Liquidity: decimal.NewFromInt(100)},
+	}, nil)
+
 	// Calculations:
-	// User 101: Cash (1000) + 10 * 150 = 2500 -> Rank 1
-	// User 102: Cash (2000) + 5 * 150 = 2750 -> Rank 1 (Wait: 2750 > 2500, so User 102 is Rank 1, User 101 is Rank 2)
+	// User 101: Cash (1000) + 10 * 150 + 1000 * 0.5 = 3000 -> Rank 1
+	// User 102: Cash (2000) + 5 * 150 = 2750 -> Rank 2
 
 	// We expect updates to database
-	mockLadderRepo.On("InsertLadderParticipant", mock.Anything, int64(10), int64(102), mock.MatchedBy(func(d decimal.Decimal) bool {
+	mockLadderRepo.On("InsertLadderParticipant", mock.Anything, int64(10), int64(101), mock.MatchedBy(func(d decimal.Decimal) bool {
 		val, _ := d.Float64()
 
-		return val == 2750.0
+		return val == 3000.0
 	}), int32(1)).Return(nil)
 
-	mockLadderRepo.On("InsertLadderParticipant", mock.Anything, int64(10), int64(101), mock.MatchedBy(func(d decimal.Decimal) bool {
+	mockLadderRepo.On("InsertLadderParticipant", mock.Anything, int64(10), int64(102), mock.MatchedBy(func(d decimal.Decimal) bool {
 		val, _ := d.Float64()
 
-		return val == 2500.0
+		return val == 2750.0
 	}), int32(2)).Return(nil)
 
 	mockLadderRepo.On("DeleteLadderPortfolioItemsByLadder", mock.Anything, int64(10)).Return(nil)
@@ -94,12 +123,18 @@ func TestLadderLifecycleWorker_RunOnce_ExpiredLadders(t *testing.T) {
 	mockLadderRepo.On("GetPendingLaddersToActivate", mock.Anything, mock.Anything).Return([]*domain.Ladder{}, nil)
 
 	// Create and run worker
-	w := worker.NewLadderLifecycleWorker(mockLadderRepo, mockPortRepo, mockMarketRepo, 10*time.Millisecond)
+	w := worker.NewLadderLifecycleWorker(
+		mockLadderRepo, mockPortRepo, mockMarketRepo, service.NewFXRates(nil, 0), predictions,
+		service.NewMarket(mockMarketRepo, nil, mockLadderRepo, service.NewQuoteHub(nil, 0)), 10*time.Millisecond,
+	)
 	w.RunOnce(ctx)
 
 	mockLadderRepo.AssertExpectations(t)
 	mockPortRepo.AssertExpectations(t)
 	mockMarketRepo.AssertExpectations(t)
+	mockPredictionRepo.AssertExpectations(t)
+	mockPredictionRepo.AssertNotCalled(t, "MarkPredictionMarketResolved", mock.Anything, otherLadder)
+	assert.Equal(t, domain.PredictionYes, due.Outcome)
 }
 
 func TestLadderLifecycleWorker_RunOnce_ActivatePendingLadders(t *testing.T) {
@@ -126,7 +161,10 @@ func TestLadderLifecycleWorker_RunOnce_ActivatePendingLadders(t *testing.T) {
 	mockLadderRepo.On("UpdateLadderStatus", mock.Anything, int64(20), true).Return(nil)
 
 	// Create and run worker
-	w := worker.NewLadderLifecycleWorker(mockLadderRepo, mockPortRepo, mockMarketRepo, 10*time.Millisecond)
+	w := worker.NewLadderLifecycleWorker(
+		mockLadderRepo, mockPortRepo, mockMarketRepo, service.NewFXRates(nil, 0), service.NewPredictions(nil, nil, nil, nil, nil, 100, time.Minute),
+		service.NewMarket(mockMarketRepo, nil, mockLadderRepo, service.NewQuoteHub(nil, 0)), 10*time.Millisecond,
+	)
 	w.RunOnce(ctx)
 
 	mockLadderRepo.AssertExpectations(t)
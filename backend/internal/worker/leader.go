@@ -0,0 +1,92 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+// LeaseStore grants expiring leases with monotonically increasing fencing tokens.
+type LeaseStore interface {
+	// AcquireLease takes or renews a lease and returns its fencing token, or 0 if it is held by someone else.
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (int64, error)
+	ReleaseLease(ctx context.Context, name, holder string) error
+}
+
+// EnableLeaderElection makes the fetcher fetch only while it holds the lease for its source,
+// so that several replicas can run side by side without spending the provider quota twice.
+// Leases last cfg.LeaseTTL and are renewed three times per TTL.
+func (w *MarketFetcher) EnableLeaderElection(leases LeaseStore, holder string) {
+	w.leases = leases
+	w.holder = holder
+}
+
+func (w *MarketFetcher) leaseName() string {
+	return "fetcher:" + w.source
+}
+
+// leading reports whether this replica may fetch.
+func (w *MarketFetcher) leading() bool {
+	return w.leases == nil || w.fence != nil
+}
+
+// renewLease takes or renews the lease. A replica that cannot confirm its lease stands by.
+func (w *MarketFetcher) renewLease(ctx context.Context) {
+	token, err := w.leases.AcquireLease(ctx, w.leaseName(), w.holder, w.cfg.LeaseTTL)
+	if err != nil {
+		log.Printf("[Fetcher:%s] Failed to renew lease: %v", w.source, err)
+	}
+
+	switch {
+	case token > 0 && w.fence == nil:
+		log.Printf("[Fetcher:%s] Became leader as %s (fencing token %d)", w.source, w.holder, token)
+	case token > 0 && w.fence.Token != token:
+		log.Printf("[Fetcher:%s] Lease was taken over in between, now fencing token %d", w.source, token)
+	case token == 0 && w.fence != nil:
+		log.Printf("[Fetcher:%s] Lost leadership, standing by", w.source)
+	}
+
+	if token > 0 {
+		w.fence = &domain.Fence{Lease: w.leaseName(), Token: token}
+	} else {
+		w.fence = nil
+	}
+}
+
+// releaseLease hands the lease over on shutdown so a standby does not have to wait for it to expire.
+func (w *MarketFetcher) releaseLease() {
+	if w.fence == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := w.leases.ReleaseLease(ctx, w.leaseName(), w.holder); err != nil {
+		log.Printf("[Fetcher:%s] Failed to release lease: %v", w.source, err)
+	}
+	w.fence = nil
+}
+
+// saveCurrent writes the live quote, fenced by the lease token when leader election is enabled.
+func (w *MarketFetcher) saveCurrent(ctx context.Context, quote *domain.Quote) error {
+	if w.leases == nil {
+		return w.currentRepo.SaveQuote(ctx, quote)
+	}
+
+	if w.fence == nil {
+		return apperrors.ErrStaleFencingToken
+	}
+
+	err := w.currentRepo.SaveQuoteFenced(ctx, quote, *w.fence)
+	if errors.Is(err, apperrors.ErrStaleFencingToken) {
+		log.Printf("[Fetcher:%s] Write rejected, another replica took over", w.source)
+		w.fence = nil
+	}
+
+	return err
+}
@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service/mocks"
+)
+
+// fakeLeaseStore grants a single lease to whoever asks first.
+type fakeLeaseStore struct {
+	holder string
+	token  int64
+}
+
+func (f *fakeLeaseStore) AcquireLease(_ context.Context, _, holder string, _ time.Duration) (int64, error) {
+	if f.holder == "" {
+		f.holder = holder
+		f.token++
+	}
+	if f.holder != holder {
+		return 0, nil
+	}
+
+	return f.token, nil
+}
+
+func (f *fakeLeaseStore) ReleaseLease(_ context.Context, _, holder string) error {
+	if f.holder == holder {
+		f.holder = ""
+	}
+
+	return nil
+}
+
+func TestMarketFetcher_LeaderElection(t *testing.T) {
+	ctx := context.Background()
+	cfg := &FetcherConfig{RequestTimeout: time.Second, LeaseTTL: 3 * time.Second}
+	leases := &fakeLeaseStore{}
+
+	newFetcher := func(repo *mocks.MockMarketRepository, holder string) *MarketFetcher {
+		w := NewMarketFetcher("Finnhub", new(MockQuoteProvider), repo, new(mocks.MockHistoryRepository), new(mocks.MockLadderRepository), new(mocks.MockQuarantineRepository), cfg)
+		w.EnableLeaderElection(leases, holder)
+
+		return w
+	}
+
+	leaderRepo := new(mocks.MockMarketRepository)
+	standbyRepo := new(mocks.MockMarketRepository)
+	leader := newFetcher(leaderRepo, "a")
+	standby := newFetcher(standbyRepo, "b")
+
+	leader.renewLease(ctx)
+	standby.renewLease(ctx)
+	assert.True(t, leader.leading())
+	assert.False(t, standby.leading())
+
+	quote := &domain.Quote{Symbol: "AAPL"}
+	leaderRepo.On("SaveQuoteFenced", ctx, quote, domain.Fence{Lease: "fetcher:Finnhub", Token: 1}).Return(apperrors.ErrStaleFencingToken)
+
+	err := leader.saveCurrent(ctx, quote)
+	assert.ErrorIs(t, err, apperrors.ErrStaleFencingToken)
+	assert.False(t, leader.leading(), "a rejected write demotes the replica")
+
+	err = standby.saveCurrent(ctx, quote)
+	assert.ErrorIs(t, err, apperrors.ErrStaleFencingToken, "a standby never writes")
+	standbyRepo.AssertNotCalled(t, "SaveQuote", mock.Anything, mock.Anything)
+
+	leader.fence = &domain.Fence{Lease: "fetcher:Finnhub", Token: 1}
+	leader.releaseLease()
+	standby.renewLease(ctx)
+	assert.True(t, standby.leading())
+}
+
+func TestMarketFetcher_StandbyDoesNotFetch(t *testing.T) {
+	mockClient := new(MockQuoteProvider)
+	mockLadderRepo := new(mocks.MockLadderRepository)
+	mockMarketRepo := new(mocks.MockMarketRepository)
+
+	mockLadderRepo.On("GetActiveLadder", mock.Anything).Return(int64(1), nil)
+	mockLadderRepo.On("GetAllowedTickers", mock.Anything, int64(1)).Return([]*domain.TickerInfo{{Symbol: "AAPL", Source: "Finnhub"}}, nil)
+	mockLadderRepo.On("GetHeldSymbols", mock.Anything, int64(1)).Return([]string{}, nil)
+	mockLadderRepo.On("GetBasketComponents", mock.Anything, int64(1)).Return([]*domain.TickerInfo{}, nil)
+	mockMarketRepo.On("CountSubscribers", mock.Anything, mock.Anything).Return(map[string]int64{}, nil)
+	mockClient.On("GetQuote", mock.Anything, "AAPL").Return(&domain.Quote{
+		Symbol: "AAPL", Price: decimal.NewFromFloat(190), Timestamp: time.Now(),
+	}, nil).Maybe()
+
+	w := NewMarketFetcher("Finnhub", mockClient, mockMarketRepo, new(mocks.MockHistoryRepository), mockLadderRepo, new(mocks.MockQuarantineRepository), &FetcherConfig{
+		FetchInterval:   10 * time.Millisecond,
+		RefreshInterval: time.Minute,
+		RequestTimeout:  time.Second,
+		LeaseTTL:        time.Minute,
+	})
+	w.EnableLeaderElection(&fakeLeaseStore{holder: "someone-else", token: 7}, "me")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_ = w.Start(ctx)
+
+	mockClient.AssertNotCalled(t, "GetQuote", mock.Anything, mock.Anything)
+}
+
+func TestQuotePublisher_LeaderElection(t *testing.T) {
+	ctx := context.Background()
+	leases := &fakeLeaseStore{}
+
+	leaderRepo := new(mocks.MockMarketRepository)
+	standbyRepo := new(mocks.MockMarketRepository)
+	leader := newQuotePublisher("BasketWorker", leaderRepo, new(mocks.MockHistoryRepository))
+	standby := newQuotePublisher("BasketWorker", standbyRepo, new(mocks.MockHistoryRepository))
+	leader.enableLeaderElection(leases, "a", 3*time.Second)
+	standby.enableLeaderElection(leases, "b", 3*time.Second)
+
+	_, releaseLeader := leader.holdLease(ctx)
+	_, releaseStandby := standby.holdLease(ctx)
+	defer releaseStandby()
+	assert.True(t, leader.leading())
+	assert.False(t, standby.leading())
+
+	quote := &domain.Quote{Symbol: "BK:TECH", Price: decimal.NewFromInt(100), Timestamp: time.Now()}
+	leaderRepo.On("SaveQuoteFenced", ctx, quote, domain.Fence{Lease: "publisher:BasketWorker", Token: 1}).Return(apperrors.ErrStaleFencingToken)
+
+	leader.publish(ctx, quote)
+	assert.False(t, leader.leading(), "a rejected write demotes the replica")
+
+	standby.publish(ctx, quote)
+	standbyRepo.AssertNotCalled(t, "SaveQuote", mock.Anything, mock.Anything)
+	standbyRepo.AssertNotCalled(t, "SaveQuoteFenced", mock.Anything, mock.Anything, mock.Anything)
+
+	leader.fence = &domain.Fence{Lease: "publisher:BasketWorker", Token: 1}
+	releaseLeader()
+	standby.renewLease(ctx)
+	assert.True(t, standby.leading())
+}
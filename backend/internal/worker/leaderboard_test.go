@@ -23,8 +23,11 @@ func TestLeaderboardWorker_Start(t *testing.T) {
 	// Mock minimal calls for UpdateLeaderboard
 	// Return active ladder ID 1 and empty users slice so UpdateLeaderboard completes quickly with no-op
 	mockLadderRepo.On("GetActiveLadder", mock.Anything).Return(int64(1), nil)
+	mockLadderRepo.On("GetLadder", mock.Anything, int64(1)).Return(&domain.Ladder{ID: 1}, nil)
 	mockUserRepo.On("GetUsers", mock.Anything).Return([]*domain.User{}, nil)
 	mockLeaderboardRepo.On("SetLastUpdate", mock.Anything, int64(1), mock.Anything).Return(nil)
+	mockPredictionRepo := new(mocks.MockPredictionRepository)
+	mockPredictionRepo.On("ListOpenPredictionPositions", mock.Anything, int64(1)).Return([]*domain.PredictionPosition{}, nil)
 
 	lbService := service.NewLeaderboard(
 		mockUserRepo,
@@ -32,6 +35,8 @@ func TestLeaderboardWorker_Start(t *testing.T) {
 		mockMarketRepo,
 		mockLadderRepo,
 		mockLeaderboardRepo,
+		service.NewFXRates(nil, 0),
+		service.NewPredictions(mockPredictionRepo, mockUserRepo, nil, mockLadderRepo, nil, 100, time.Minute),
 	)
 
 	// Run with 10ms interval, cancel context after 25ms to allow initial update + 2 ticks
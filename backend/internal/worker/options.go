@@ -0,0 +1,133 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// OptionPricingWorker prices the option contracts of the active ladder with Black-Scholes and
+// publishes their premiums like the quotes of any other symbol, so that they can be traded.
+type OptionPricingWorker struct {
+	options   *service.Options
+	publisher *quotePublisher
+	interval  time.Duration
+}
+
+// NewOptionPricingWorker creates a new instance of OptionPricingWorker.
+func NewOptionPricingWorker(
+	options *service.Options,
+	marketRepo service.MarketRepository,
+	historyRepo service.HistoryRepository,
+	interval time.Duration,
+) *OptionPricingWorker {
+	return &OptionPricingWorker{
+		options:   options,
+		publisher: newQuotePublisher("OptionPricingWorker", marketRepo, historyRepo),
+		interval:  interval,
+	}
+}
+
+// EnableLeaderElection makes the worker publish only while it holds its lease, so that
+// several fetcher replicas can run side by side. Leases last ttl and are renewed three
+// times per ttl.
+func (w *OptionPricingWorker) EnableLeaderElection(leases LeaseStore, holder string, ttl time.Duration) {
+	w.publisher.enableLeaderElection(leases, holder, ttl)
+}
+
+// Start begins the pricing loop.
+func (w *OptionPricingWorker) Start(ctx context.Context) error {
+	leaseTick, releaseLease := w.publisher.holdLease(ctx)
+	defer releaseLease()
+
+	w.RunOnce(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.RunOnce(ctx)
+		case <-leaseTick:
+			w.publisher.renewLease(ctx)
+		case <-ctx.Done():
+			log.Println("[OptionPricingWorker] Stopping...")
+
+			return ctx.Err()
+		}
+	}
+}
+
+// RunOnce prices and publishes every option whose premium or timestamp changed. A standby
+// replica does nothing.
+func (w *OptionPricingWorker) RunOnce(ctx context.Context) {
+	if !w.publisher.leading() {
+		return
+	}
+
+	contracts, err := w.options.List(ctx)
+	if err != nil {
+		log.Printf("[OptionPricingWorker] Failed to list options: %v", err)
+
+		return
+	}
+
+	now := time.Now()
+	for _, c := range contracts {
+		quote, errQuote := w.options.Quote(ctx, c, now)
+		if errQuote != nil {
+			log.Printf("[%s] Option not priced: %v", c.Symbol, errQuote)
+
+			continue
+		}
+
+		w.publisher.publish(ctx, quote)
+	}
+}
+
+// OptionExpiryWorker settles expired option contracts at their intrinsic value.
+type OptionExpiryWorker struct {
+	options  *service.Options
+	interval time.Duration
+}
+
+// NewOptionExpiryWorker creates a new instance of OptionExpiryWorker.
+func NewOptionExpiryWorker(options *service.Options, interval time.Duration) *OptionExpiryWorker {
+	return &OptionExpiryWorker{
+		options:  options,
+		interval: interval,
+	}
+}
+
+// Start begins the settlement loop.
+func (w *OptionExpiryWorker) Start(ctx context.Context) error {
+	w.RunOnce(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.RunOnce(ctx)
+		case <-ctx.Done():
+			log.Println("[OptionExpiryWorker] Stopping...")
+
+			return ctx.Err()
+		}
+	}
+}
+
+// RunOnce settles every option that has expired.
+func (w *OptionExpiryWorker) RunOnce(ctx context.Context) {
+	settled, err := w.options.SettleExpired(ctx, time.Now())
+	if err != nil {
+		log.Printf("[OptionExpiryWorker] Settlement failed: %v", err)
+	}
+	if settled > 0 {
+		log.Printf("[OptionExpiryWorker] Settled %d options", settled)
+	}
+}
@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// PredictionResolutionWorker resolves prediction markets past their resolution time and pays
+// out their winning shares.
+type PredictionResolutionWorker struct {
+	predictions *service.Predictions
+	interval    time.Duration
+}
+
+// NewPredictionResolutionWorker creates a new instance of PredictionResolutionWorker.
+func NewPredictionResolutionWorker(predictions *service.Predictions, interval time.Duration) *PredictionResolutionWorker {
+	return &PredictionResolutionWorker{
+		predictions: predictions,
+		interval:    interval,
+	}
+}
+
+// Start begins the resolution loop.
+func (w *PredictionResolutionWorker) Start(ctx context.Context) error {
+	w.RunOnce(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.RunOnce(ctx)
+		case <-ctx.Done():
+			log.Println("[PredictionResolutionWorker] Stopping...")
+
+			return ctx.Err()
+		}
+	}
+}
+
+// RunOnce resolves every prediction market that is due.
+func (w *PredictionResolutionWorker) RunOnce(ctx context.Context) {
+	resolved, err := w.predictions.ResolveDue(ctx, time.Now())
+	if err != nil {
+		log.Printf("[PredictionResolutionWorker] Resolution failed: %v", err)
+	}
+	if resolved > 0 {
+		log.Printf("[PredictionResolutionWorker] Resolved %d markets", resolved)
+	}
+}
@@ -0,0 +1,151 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/apperrors"
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// quotePublisher publishes computed quotes, such as those of baskets and options, like
+// fetched ones: only when their price or timestamp changed, with history saved at most once
+// a minute.
+//
+// With leader election enabled, only the replica holding the publisher's lease publishes,
+// and its writes are fenced like those of a MarketFetcher.
+type quotePublisher struct {
+	name        string
+	marketRepo  service.MarketRepository
+	historyRepo service.HistoryRepository
+
+	lastQuotes      map[string]*domain.Quote
+	lastHistorySave map[string]time.Time
+
+	leases LeaseStore
+	holder string
+	ttl    time.Duration
+	fence  *domain.Fence // set while this replica holds the lease
+}
+
+func newQuotePublisher(name string, marketRepo service.MarketRepository, historyRepo service.HistoryRepository) *quotePublisher {
+	return &quotePublisher{
+		name:            name,
+		marketRepo:      marketRepo,
+		historyRepo:     historyRepo,
+		lastQuotes:      make(map[string]*domain.Quote),
+		lastHistorySave: make(map[string]time.Time),
+	}
+}
+
+func (p *quotePublisher) enableLeaderElection(leases LeaseStore, holder string, ttl time.Duration) {
+	p.leases = leases
+	p.holder = holder
+	p.ttl = ttl
+}
+
+func (p *quotePublisher) leaseName() string {
+	return "publisher:" + p.name
+}
+
+// leading reports whether this replica may publish.
+func (p *quotePublisher) leading() bool {
+	return p.leases == nil || p.fence != nil
+}
+
+// holdLease takes the lease if leader election is enabled. It returns a channel that ticks
+// whenever the lease is due for renewal, which is nil without leader election, and a
+// function that releases the lease on shutdown.
+func (p *quotePublisher) holdLease(ctx context.Context) (<-chan time.Time, func()) {
+	if p.leases == nil {
+		return nil, func() {}
+	}
+
+	ticker := time.NewTicker(p.ttl / 3)
+	p.renewLease(ctx)
+
+	return ticker.C, func() {
+		ticker.Stop()
+		p.releaseLease()
+	}
+}
+
+// renewLease takes or renews the lease. A replica that cannot confirm its lease stands by.
+func (p *quotePublisher) renewLease(ctx context.Context) {
+	token, err := p.leases.AcquireLease(ctx, p.leaseName(), p.holder, p.ttl)
+	if err != nil {
+		log.Printf("[%s] Failed to renew lease: %v", p.name, err)
+	}
+
+	switch {
+	case token > 0 && p.fence == nil:
+		log.Printf("[%s] Became leader as %s (fencing token %d)", p.name, p.holder, token)
+	case token == 0 && p.fence != nil:
+		log.Printf("[%s] Lost leadership, standing by", p.name)
+	}
+
+	if token > 0 {
+		p.fence = &domain.Fence{Lease: p.leaseName(), Token: token}
+	} else {
+		p.fence = nil
+	}
+}
+
+// releaseLease hands the lease over so a standby does not have to wait for it to expire.
+func (p *quotePublisher) releaseLease() {
+	if p.fence == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := p.leases.ReleaseLease(ctx, p.leaseName(), p.holder); err != nil {
+		log.Printf("[%s] Failed to release lease: %v", p.name, err)
+	}
+	p.fence = nil
+}
+
+func (p *quotePublisher) publish(ctx context.Context, quote *domain.Quote) {
+	last := p.lastQuotes[quote.Symbol]
+	if last != nil && quote.Price.Equal(last.Price) && quote.Timestamp.Equal(last.Timestamp) {
+		return
+	}
+
+	if err := p.saveCurrent(ctx, quote); err != nil {
+		log.Printf("[%s] Current Save Error: %v", quote.Symbol, err)
+
+		return
+	}
+	p.lastQuotes[quote.Symbol] = quote
+
+	if time.Since(p.lastHistorySave[quote.Symbol]) >= time.Minute {
+		if err := p.historyRepo.SaveQuote(ctx, quote); err != nil {
+			log.Printf("[%s] History Save Error: %v", quote.Symbol, err)
+		} else {
+			p.lastHistorySave[quote.Symbol] = time.Now()
+		}
+	}
+}
+
+// saveCurrent writes the live quote, fenced by the lease token when leader election is enabled.
+func (p *quotePublisher) saveCurrent(ctx context.Context, quote *domain.Quote) error {
+	if p.leases == nil {
+		return p.marketRepo.SaveQuote(ctx, quote)
+	}
+
+	if p.fence == nil {
+		return apperrors.ErrStaleFencingToken
+	}
+
+	err := p.marketRepo.SaveQuoteFenced(ctx, quote, *p.fence)
+	if errors.Is(err, apperrors.ErrStaleFencingToken) {
+		log.Printf("[%s] Write rejected, another replica took over", p.name)
+		p.fence = nil
+	}
+
+	return err
+}
@@ -0,0 +1,55 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/service"
+)
+
+// QuoteWarmupWorker restores missing live quotes from history on startup and
+// periodically afterwards, so that a Valkey restart heals without waiting for the fetcher.
+type QuoteWarmupWorker struct {
+	recovery *service.QuoteRecovery
+	interval time.Duration
+}
+
+// NewQuoteWarmupWorker creates a new instance of QuoteWarmupWorker.
+func NewQuoteWarmupWorker(recovery *service.QuoteRecovery, interval time.Duration) *QuoteWarmupWorker {
+	return &QuoteWarmupWorker{
+		recovery: recovery,
+		interval: interval,
+	}
+}
+
+// Start begins the warm-up loop.
+func (w *QuoteWarmupWorker) Start(ctx context.Context) error {
+	w.warmUp(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			w.warmUp(ctx)
+		case <-ctx.Done():
+			log.Println("[QuoteWarmupWorker] Stopping...")
+
+			return ctx.Err()
+		}
+	}
+}
+
+func (w *QuoteWarmupWorker) warmUp(ctx context.Context) {
+	n, err := w.recovery.WarmUp(ctx)
+	if err != nil {
+		log.Printf("[QuoteWarmupWorker] Warm-up failed: %v", err)
+
+		return
+	}
+	if n > 0 {
+		log.Printf("[QuoteWarmupWorker] Restored %d quotes from history", n)
+	}
+}
@@ -0,0 +1,188 @@
+package worker
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+)
+
+// hotSymbolWeight is how much faster a held or watched symbol ages compared to an idle one.
+const hotSymbolWeight = 4
+
+// fetchScheduler decides when the next provider request may be made and which symbol it should refresh.
+// Requests are paced by a token bucket that refills one token per FetchInterval, a rate-limited provider
+// is backed off exponentially (or for as long as its Retry-After asks), and symbols that players hold or
+// watch are refreshed before idle ones.
+type fetchScheduler struct {
+	interval   time.Duration
+	burst      float64
+	maxBackoff time.Duration
+
+	tokens     float64
+	lastRefill time.Time
+
+	failures     int
+	blockedUntil time.Time
+
+	lastAttempt   map[string]time.Time
+	lastRefreshed map[string]time.Time
+	hot           map[string]bool
+}
+
+func newFetchScheduler(cfg *FetcherConfig, now time.Time) *fetchScheduler {
+	burst := float64(max(cfg.Burst, 1))
+
+	return &fetchScheduler{
+		interval:      cfg.FetchInterval,
+		burst:         burst,
+		maxBackoff:    cfg.MaxBackoff,
+		tokens:        burst,
+		lastRefill:    now,
+		lastAttempt:   make(map[string]time.Time),
+		lastRefreshed: make(map[string]time.Time),
+		hot:           make(map[string]bool),
+	}
+}
+
+func (s *fetchScheduler) refill(now time.Time) {
+	elapsed := now.Sub(s.lastRefill)
+	if elapsed <= 0 {
+		return
+	}
+
+	s.tokens = math.Min(s.burst, s.tokens+float64(elapsed)/float64(s.interval))
+	s.lastRefill = now
+}
+
+// delay returns how long to wait before the next request may be made.
+func (s *fetchScheduler) delay(now time.Time) time.Duration {
+	s.refill(now)
+
+	var wait time.Duration
+	if s.tokens < 1 {
+		wait = time.Duration((1 - s.tokens) * float64(s.interval))
+	}
+
+	if blocked := s.blockedUntil.Sub(now); blocked > wait {
+		wait = blocked
+	}
+
+	return wait
+}
+
+// take consumes a token if a request may be made right now.
+func (s *fetchScheduler) take(now time.Time) bool {
+	if s.delay(now) > 0 {
+		return false
+	}
+
+	s.tokens--
+
+	return true
+}
+
+// succeeded resets the backoff after a request went through.
+func (s *fetchScheduler) succeeded() {
+	s.failures = 0
+	s.blockedUntil = time.Time{}
+}
+
+// failed backs the provider off if err says we are being rate limited. Other errors
+// are specific to a symbol and do not slow down the rest.
+func (s *fetchScheduler) failed(now time.Time, err error) time.Duration {
+	if !errors.Is(err, clients.ErrRateLimited) {
+		return 0
+	}
+
+	s.failures++
+	s.tokens = 0
+
+	backoff := s.interval << min(s.failures-1, 16)
+	if s.maxBackoff > 0 && backoff > s.maxBackoff {
+		backoff = s.maxBackoff
+	}
+
+	var rlErr *clients.RateLimitError
+	if errors.As(err, &rlErr) && rlErr.RetryAfter > backoff {
+		backoff = rlErr.RetryAfter
+	}
+
+	s.blockedUntil = now.Add(backoff)
+
+	return backoff
+}
+
+// setHot replaces the set of symbols that are currently held or watched.
+func (s *fetchScheduler) setHot(symbols []string) {
+	s.hot = make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		s.hot[symbol] = true
+	}
+}
+
+// next picks the most overdue symbol. Symbols that were never attempted come first,
+// hot ones before idle ones; after that, hot symbols age hotSymbolWeight times faster.
+func (s *fetchScheduler) next(symbols []string, now time.Time) string {
+	var (
+		best      string
+		bestScore = -1.0
+	)
+
+	for _, symbol := range symbols {
+		weight := 1.0
+		if s.hot[symbol] {
+			weight = hotSymbolWeight
+		}
+
+		score := math.Inf(1)
+		if last, ok := s.lastAttempt[symbol]; ok {
+			score = now.Sub(last).Seconds() * weight
+		} else if !s.hot[symbol] {
+			score = math.MaxFloat64
+		}
+
+		if score > bestScore {
+			best, bestScore = symbol, score
+		}
+	}
+
+	return best
+}
+
+// attempted records that a request for symbol was made, whether or not it succeeded.
+func (s *fetchScheduler) attempted(symbol string, now time.Time) {
+	s.lastAttempt[symbol] = now
+}
+
+// refreshed records a successful refresh of symbol and returns the time since its previous one.
+func (s *fetchScheduler) refreshed(symbol string, now time.Time) (time.Duration, bool) {
+	last, ok := s.lastRefreshed[symbol]
+	s.lastRefreshed[symbol] = now
+	if !ok {
+		return 0, false
+	}
+
+	return now.Sub(last), true
+}
+
+// forget drops bookkeeping for symbols that are no longer tracked.
+func (s *fetchScheduler) forget(symbols []string) {
+	tracked := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		tracked[symbol] = true
+	}
+
+	for symbol := range s.lastAttempt {
+		if !tracked[symbol] {
+			delete(s.lastAttempt, symbol)
+		}
+	}
+
+	for symbol := range s.lastRefreshed {
+		if !tracked[symbol] {
+			delete(s.lastRefreshed, symbol)
+		}
+	}
+}
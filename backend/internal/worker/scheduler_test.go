@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/clients"
+)
+
+func TestFetchScheduler_TokenBucket(t *testing.T) {
+	now := time.Now()
+	sched := newFetchScheduler(&FetcherConfig{FetchInterval: 10 * time.Second, Burst: 2}, now)
+
+	assert.True(t, sched.take(now))
+	assert.True(t, sched.take(now))
+	assert.False(t, sched.take(now))
+	assert.Equal(t, 10*time.Second, sched.delay(now))
+
+	assert.False(t, sched.take(now.Add(5*time.Second)))
+	assert.True(t, sched.take(now.Add(10*time.Second)))
+}
+
+func TestFetchScheduler_Backoff(t *testing.T) {
+	cfg := &FetcherConfig{FetchInterval: 10 * time.Second, Burst: 1, MaxBackoff: 30 * time.Second}
+
+	t.Run("Exponential up to MaxBackoff", func(t *testing.T) {
+		now := time.Now()
+		sched := newFetchScheduler(cfg, now)
+
+		assert.Equal(t, 10*time.Second, sched.failed(now, &clients.RateLimitError{}))
+		assert.Equal(t, 20*time.Second, sched.failed(now, &clients.RateLimitError{}))
+		assert.Equal(t, 30*time.Second, sched.failed(now, &clients.RateLimitError{}))
+		assert.False(t, sched.take(now.Add(29*time.Second)))
+
+		sched.succeeded()
+		assert.Equal(t, 10*time.Second, sched.failed(now, &clients.RateLimitError{}))
+	})
+
+	t.Run("Honours Retry-After", func(t *testing.T) {
+		now := time.Now()
+		sched := newFetchScheduler(cfg, now)
+
+		backoff := sched.failed(now, &clients.RateLimitError{RetryAfter: time.Minute})
+
+		assert.Equal(t, time.Minute, backoff)
+		assert.False(t, sched.take(now.Add(59*time.Second)))
+		assert.True(t, sched.take(now.Add(time.Minute)))
+	})
+
+	t.Run("Ignores Other Errors", func(t *testing.T) {
+		now := time.Now()
+		sched := newFetchScheduler(cfg, now)
+
+		assert.Zero(t, sched.failed(now, errors.New("zero price received")))
+		assert.True(t, sched.take(now))
+	})
+}
+
+func TestFetchScheduler_Next(t *testing.T) {
+	now := time.Now()
+	symbols := []string{"AAPL", "MSFT", "TSLA"}
+
+	t.Run("Hot Symbols First", func(t *testing.T) {
+		sched := newFetchScheduler(&FetcherConfig{FetchInterval: time.Second}, now)
+		sched.setHot([]string{"TSLA"})
+
+		assert.Equal(t, "TSLA", sched.next(symbols, now))
+		sched.attempted("TSLA", now)
+		assert.Equal(t, "AAPL", sched.next(symbols, now))
+	})
+
+	t.Run("Hot Symbols Age Faster", func(t *testing.T) {
+		sched := newFetchScheduler(&FetcherConfig{FetchInterval: time.Second}, now)
+		sched.setHot([]string{"TSLA"})
+		sched.attempted("AAPL", now.Add(-30*time.Second))
+		sched.attempted("MSFT", now.Add(-20*time.Second))
+		sched.attempted("TSLA", now.Add(-10*time.Second))
+
+		assert.Equal(t, "TSLA", sched.next(symbols, now))
+
+		sched.setHot(nil)
+		assert.Equal(t, "AAPL", sched.next(symbols, now))
+	})
+}
+
+func TestFetchScheduler_Refreshed(t *testing.T) {
+	now := time.Now()
+	sched := newFetchScheduler(&FetcherConfig{FetchInterval: time.Second}, now)
+
+	_, ok := sched.refreshed("AAPL", now)
+	assert.False(t, ok)
+
+	latency, ok := sched.refreshed("AAPL", now.Add(15*time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, 15*time.Second, latency)
+
+	sched.forget([]string{"MSFT"})
+	_, ok = sched.refreshed("AAPL", now.Add(20*time.Second))
+	assert.False(t, ok)
+}
@@ -0,0 +1,152 @@
+package worker
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+const (
+	// defaultValidationWindow is how many accepted prices the rolling median is taken over.
+	defaultValidationWindow = 20
+	// levelShiftQuotes is how many consecutive, mutually consistent outliers are taken as a
+	// move of the market to a new level rather than a bad feed.
+	levelShiftQuotes = 3
+	// maxClockSkew is how far ahead of our clock a provider timestamp may be.
+	maxClockSkew = time.Minute
+)
+
+// quoteValidator flags quotes that deviate too far from the rolling median of recently
+// accepted prices or carry implausible provider timestamps.
+type quoteValidator struct {
+	window            int
+	maxDeviation      float64
+	maxAge            time.Duration
+	acceptLevelShifts bool
+
+	prices   map[string][]float64
+	outliers map[string][]float64
+	lastTS   map[string]time.Time
+}
+
+func newQuoteValidator(cfg *FetcherConfig) *quoteValidator {
+	window := cfg.ValidationWindow
+	if window <= 0 {
+		window = defaultValidationWindow
+	}
+
+	return &quoteValidator{
+		window:            window,
+		maxDeviation:      cfg.MaxDeviation,
+		maxAge:            cfg.MaxQuoteAge,
+		acceptLevelShifts: cfg.AcceptLevelShifts,
+		prices:            make(map[string][]float64),
+		outliers:          make(map[string][]float64),
+		lastTS:            make(map[string]time.Time),
+	}
+}
+
+// check returns the quarantine reason and an explanation, or an empty reason if the quote looks sane.
+func (v *quoteValidator) check(quote *domain.Quote, now time.Time) (string, string) {
+	symbol := quote.Symbol
+	price := quote.Price.InexactFloat64()
+	ts := quote.Timestamp
+
+	if ts.After(now.Add(maxClockSkew)) {
+		return domain.QuarantineReasonStale, fmt.Sprintf("timestamp %s is %s in the future", ts.Format(time.RFC3339), ts.Sub(now).Round(time.Second))
+	}
+
+	if last, ok := v.lastTS[symbol]; ok && ts.Before(last) {
+		return domain.QuarantineReasonStale, fmt.Sprintf("timestamp %s is older than the last accepted %s", ts.Format(time.RFC3339), last.Format(time.RFC3339))
+	}
+
+	// An unchanged price with an old timestamp is just a closed market; a new price should be recent.
+	prices := v.prices[symbol]
+	if v.maxAge > 0 && len(prices) > 0 && price != prices[len(prices)-1] && now.Sub(ts) > v.maxAge {
+		return domain.QuarantineReasonStale, fmt.Sprintf("price changed but timestamp is %s old", now.Sub(ts).Round(time.Second))
+	}
+
+	if v.maxDeviation <= 0 || len(prices) == 0 {
+		return "", ""
+	}
+
+	med := median(prices)
+	deviation := math.Abs(price-med) / med
+	if deviation <= v.maxDeviation {
+		v.outliers[symbol] = nil
+
+		return "", ""
+	}
+
+	outliers := append(v.outliers[symbol], price)
+	if len(outliers) > levelShiftQuotes {
+		outliers = outliers[len(outliers)-levelShiftQuotes:]
+	}
+	v.outliers[symbol] = outliers
+
+	if len(outliers) == levelShiftQuotes && consistent(outliers, v.maxDeviation) {
+		if !v.acceptLevelShifts {
+			return domain.QuarantineReasonLevelShift, fmt.Sprintf(
+				"%d consecutive quotes agree on a level %.1f%% from median %g; release one to accept it",
+				len(outliers), deviation*100, med)
+		}
+
+		// The market really moved: restart the window from the new level.
+		v.rebase(symbol, outliers[:len(outliers)-1])
+
+		return "", ""
+	}
+
+	return domain.QuarantineReasonDeviation, fmt.Sprintf("deviates %.1f%% from median %g (max %.1f%%)", deviation*100, med, v.maxDeviation*100)
+}
+
+// confirmed restarts the window from a quote an admin released, accepting its price level.
+func (v *quoteValidator) confirmed(quote *domain.Quote) {
+	v.rebase(quote.Symbol, []float64{quote.Price.InexactFloat64()})
+	v.lastTS[quote.Symbol] = quote.Timestamp
+}
+
+// newerThanAccepted reports whether the quote is newer than the last one this validator
+// accepted for its symbol, i.e. whether someone else stored it.
+func (v *quoteValidator) newerThanAccepted(quote *domain.Quote) bool {
+	return quote.Timestamp.After(v.lastTS[quote.Symbol])
+}
+
+func (v *quoteValidator) rebase(symbol string, prices []float64) {
+	v.prices[symbol] = slices.Clone(prices)
+	v.outliers[symbol] = nil
+}
+
+// accepted records a quote that passed validation and was stored.
+func (v *quoteValidator) accepted(quote *domain.Quote) {
+	symbol := quote.Symbol
+
+	prices := append(v.prices[symbol], quote.Price.InexactFloat64())
+	if len(prices) > v.window {
+		prices = prices[len(prices)-v.window:]
+	}
+	v.prices[symbol] = prices
+	v.lastTS[symbol] = quote.Timestamp
+}
+
+// consistent reports whether all prices lie within maxDeviation of their median.
+func consistent(prices []float64, maxDeviation float64) bool {
+	med := median(prices)
+
+	return !slices.ContainsFunc(prices, func(p float64) bool {
+		return math.Abs(p-med)/med > maxDeviation
+	})
+}
+
+func median(prices []float64) float64 {
+	sorted := slices.Sorted(slices.Values(prices))
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+
+	return sorted[mid]
+}
@@ -0,0 +1,108 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tmythicator/ticker-rush/backend/internal/domain"
+)
+
+func TestQuoteValidator(t *testing.T) {
+	now := time.Now()
+	cfg := &FetcherConfig{MaxDeviation: 0.1, MaxQuoteAge: 15 * time.Minute, ValidationWindow: 5}
+
+	quoteAt := func(price float64, ts time.Time) *domain.Quote {
+		return &domain.Quote{Symbol: "AAPL", Price: decimal.NewFromFloat(price), Timestamp: ts}
+	}
+	feed := func(v *quoteValidator, prices ...float64) {
+		for _, p := range prices {
+			q := quoteAt(p, now)
+			reason, _ := v.check(q, now)
+			assert.Empty(t, reason)
+			v.accepted(q)
+		}
+	}
+
+	t.Run("Rejects Outlier From Rolling Median", func(t *testing.T) {
+		v := newQuoteValidator(cfg)
+		feed(v, 100, 101, 99, 100)
+
+		reason, detail := v.check(quoteAt(150, now), now)
+		assert.Equal(t, domain.QuarantineReasonDeviation, reason)
+		assert.Contains(t, detail, "median 100 ")
+
+		reason, _ = v.check(quoteAt(105, now), now)
+		assert.Empty(t, reason)
+	})
+
+	t.Run("Holds Level Shift Until Released", func(t *testing.T) {
+		v := newQuoteValidator(cfg)
+		feed(v, 100, 100, 100)
+
+		for range levelShiftQuotes - 1 {
+			reason, _ := v.check(quoteAt(60, now), now)
+			assert.Equal(t, domain.QuarantineReasonDeviation, reason)
+		}
+
+		for range 2 {
+			reason, detail := v.check(quoteAt(61, now), now)
+			assert.Equal(t, domain.QuarantineReasonLevelShift, reason)
+			assert.Contains(t, detail, "median 100;")
+		}
+
+		released := quoteAt(61, now.Add(time.Second))
+		assert.True(t, v.newerThanAccepted(released))
+		v.confirmed(released)
+		assert.False(t, v.newerThanAccepted(released))
+
+		reason, _ := v.check(quoteAt(60, now.Add(2*time.Second)), now.Add(2*time.Second))
+		assert.Empty(t, reason)
+	})
+
+	t.Run("Accepts Consistent Level Shift When Enabled", func(t *testing.T) {
+		v := newQuoteValidator(&FetcherConfig{MaxDeviation: 0.1, ValidationWindow: 5, AcceptLevelShifts: true})
+		feed(v, 100, 100, 100)
+
+		for range levelShiftQuotes - 1 {
+			reason, _ := v.check(quoteAt(60, now), now)
+			assert.Equal(t, domain.QuarantineReasonDeviation, reason)
+		}
+
+		reason, _ := v.check(quoteAt(61, now), now)
+		assert.Empty(t, reason)
+		v.accepted(quoteAt(61, now))
+
+		reason, _ = v.check(quoteAt(100, now), now)
+		assert.Equal(t, domain.QuarantineReasonDeviation, reason)
+	})
+
+	t.Run("Flags Stale Timestamps", func(t *testing.T) {
+		v := newQuoteValidator(cfg)
+		feed(v, 100)
+
+		reason, _ := v.check(quoteAt(100, now.Add(5*time.Minute)), now)
+		assert.Equal(t, domain.QuarantineReasonStale, reason, "future timestamp")
+
+		reason, _ = v.check(quoteAt(100, now.Add(-time.Second)), now)
+		assert.Equal(t, domain.QuarantineReasonStale, reason, "timestamp went backwards")
+	})
+
+	t.Run("Closed Market Is Not Stale", func(t *testing.T) {
+		v := newQuoteValidator(cfg)
+		closedAt := now.Add(-48 * time.Hour)
+
+		q := quoteAt(100, closedAt)
+		reason, _ := v.check(q, now)
+		assert.Empty(t, reason)
+		v.accepted(q)
+
+		reason, _ = v.check(quoteAt(100, closedAt), now)
+		assert.Empty(t, reason)
+
+		reason, _ = v.check(quoteAt(102, closedAt.Add(time.Minute)), now)
+		assert.Equal(t, domain.QuarantineReasonStale, reason, "new price with an old timestamp")
+	})
+}
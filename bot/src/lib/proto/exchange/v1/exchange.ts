@@ -57,7 +57,7 @@ export function tradeActionToJSON(object: TradeAction): string {
 
 /** Real-time stock price data. */
 export interface Quote {
-  /** Stock ticker symbol. */
+  /** Instrument ID, the provider symbol qualified with its source, e.g. FH:AAPL or CG:bitcoin. */
   symbol: string;
   /** Current price in USD. */
   price: number;
@@ -73,11 +73,24 @@ export interface Quote {
   source: string;
   /** Indicates if the market is closed for this stock. */
   is_closed: boolean;
+  /** Exact current price as a decimal string, e.g. "0.00001235". */
+  price_decimal: string;
+  /** Number of decimal places the instrument is quoted to. */
+  price_precision: number;
+  /**
+   * Best bid as a decimal string for instruments quoted two-sided, such as currency pairs;
+   * empty otherwise. The price is then the mid price.
+   */
+  bid: string;
+  /** Best ask as a decimal string; empty unless the instrument is quoted two-sided. */
+  ask: string;
+  /** Pip size of currency pairs as a decimal string, e.g. "0.0001"; empty otherwise. */
+  pip_size: string;
 }
 
 /** Request to fetch a stock quote. */
 export interface GetQuoteRequest {
-  /** Stock ticker symbol. */
+  /** Instrument ID, e.g. FH:AAPL. */
   symbol: string;
 }
 
@@ -89,7 +102,7 @@ export interface GetQuoteResponse {
 
 /** Request to retrieve price history. */
 export interface GetHistoryRequest {
-  /** Stock ticker symbol. */
+  /** Instrument ID, e.g. FH:AAPL. */
   symbol: string;
   /** Maximum number of historical records to return. */
   limit: number;
@@ -101,21 +114,113 @@ export interface GetHistoryResponse {
   history: Quote[];
 }
 
+/** Request to compute technical indicators. */
+export interface GetIndicatorsRequest {
+  /** Instrument ID, e.g. FH:AAPL. */
+  symbol: string;
+  /** Comma-separated indicator types: sma, ema, rsi, macd, bollinger. */
+  type: string;
+  /** Lookback in quotes, between 2 and 500. Defaults to 14 for RSI and 20 otherwise; MACD always uses 12/26/9. */
+  period: number;
+  /** Maximum number of points per indicator, 100 by default. */
+  limit: number;
+}
+
+/** Response containing one series per requested indicator, in request order. */
+export interface GetIndicatorsResponse {
+  /** Computed indicators. */
+  indicators: Indicator[];
+}
+
+/** A technical indicator series. */
+export interface Indicator {
+  /** Indicator type. */
+  type: string;
+  /** Lookback in quotes; 0 for MACD. */
+  period: number;
+  /** Indicator values, oldest first. */
+  points: IndicatorPoint[];
+}
+
+/** An indicator's value at a stored quote. */
+export interface IndicatorPoint {
+  /** Timestamp of the quote. */
+  timestamp:
+    | Date
+    | undefined;
+  /** SMA, EMA, RSI, MACD line or middle Bollinger band. */
+  value: number;
+  /** MACD signal line. */
+  signal: number;
+  /** MACD histogram. */
+  histogram: number;
+  /** Upper Bollinger band. */
+  upper: number;
+  /** Lower Bollinger band. */
+  lower: number;
+}
+
+/** A catalogued instrument a ticker symbol stands for. */
+export interface Instrument {
+  /** Instrument ID, e.g. FH:AAPL. */
+  symbol: string;
+  /** Source provider for market data. */
+  source: string;
+  /** Display name, e.g. "Apple Inc". */
+  name: string;
+  /** Asset class, e.g. "stock", "crypto" or "forex". */
+  asset_class: string;
+  /** Currency prices are quoted in. */
+  currency: string;
+  /** Exchange or venue the instrument trades on. */
+  exchange: string;
+  /** Smallest price increment. */
+  tick_size: number;
+  /** Pip size of currency pairs, e.g. 0.0001; 0 for other asset classes. */
+  pip_size: number;
+}
+
+/** Request to search the instrument catalogue. */
+export interface SearchInstrumentsRequest {
+  /** Symbol or name to search for. Empty lists the catalogue. */
+  query: string;
+  /** Maximum number of instruments to return. */
+  limit: number;
+}
+
+/** Response containing matching instruments, best matches first. */
+export interface SearchInstrumentsResponse {
+  /** Matching instruments. */
+  instruments: Instrument[];
+}
+
 /** Request to establish a real-time quote stream. */
 export interface StreamQuotesRequest {
-  /** Optional ticker symbol to filter updates. If empty, streams all tickers. */
+  /** Optional instrument ID to filter updates. Deprecated in favour of symbols. */
   symbol: string;
+  /**
+   * Optional instrument IDs to multiplex over one stream, e.g. ?symbols=FH:AAPL,CG:bitcoin.
+   * If both symbol and symbols are empty, streams all tickers of the active ladder.
+   */
+  symbols: string[];
 }
 
 /** Response payload for real-time quote stream. */
 export interface StreamQuotesResponse {
   /** Live stock quote update. */
-  quote: Quote | undefined;
+  quote:
+    | Quote
+    | undefined;
+  /**
+   * Event ID of the quote. Clients resume a stream by sending the last one they received
+   * as "last-event-id" metadata.
+   */
+  event_id: string;
 }
 
 /** Request payload to place a trade. */
 export interface CreateTradeRequest {
-  /** Stock ticker symbol to trade. */
+  /** Instrument ID to trade, e.g. FH:AAPL. */
   symbol: string;
   /** Quantity of shares to trade. */
   quantity: number;
@@ -129,8 +234,407 @@ export interface CreateTradeResponse {
   participant: LadderParticipant | undefined;
 }
 
+/** A quote that failed the fetcher's sanity checks and awaits review. */
+export interface QuarantinedQuote {
+  /** Quarantine entry identifier. */
+  id: string;
+  /** The quote as received from the provider. */
+  quote:
+    | Quote
+    | undefined;
+  /** Check that failed, e.g. "deviation" or "stale". */
+  reason: string;
+  /** Human-readable explanation of the failed check. */
+  detail: string;
+  /** When the quote was quarantined. */
+  quarantined_at: Date | undefined;
+}
+
+/** Request to list quarantined quotes. */
+export interface ListQuarantinedQuotesRequest {
+}
+
+/** Response containing quarantined quotes, oldest first. */
+export interface ListQuarantinedQuotesResponse {
+  /** Quotes awaiting review. */
+  quotes: QuarantinedQuote[];
+}
+
+/** Request to release a quarantined quote. */
+export interface ReleaseQuarantinedQuoteRequest {
+  /** Quarantine entry identifier. */
+  id: string;
+}
+
+/** Response containing the released quote. */
+export interface ReleaseQuarantinedQuoteResponse {
+  /** The quote that was published. */
+  quote: Quote | undefined;
+}
+
+/** Request to discard a quarantined quote. */
+export interface DiscardQuarantinedQuoteRequest {
+  /** Quarantine entry identifier. */
+  id: string;
+}
+
+/** Response for a discarded quote. */
+export interface DiscardQuarantinedQuoteResponse {
+}
+
+/** A weighted component of a basket. */
+export interface BasketComponent {
+  /** Instrument ID of the component, e.g. "FH:AAPL". */
+  symbol: string;
+  /**
+   * Share of the basket's value at creation. Normalized to sum to 1; omit all weights to
+   * weight the components equally.
+   */
+  weight: number;
+  /** Units of the component held per basket, fixed at creation. */
+  units: number;
+}
+
+/** A composite instrument priced from the quotes of its components. */
+export interface Basket {
+  /** Instrument ID of the basket, e.g. "BK:TECH5". */
+  symbol: string;
+  /** Display name. */
+  name: string;
+  /** Price of the basket at creation. */
+  base_value: number;
+  /** Weighted components. */
+  components: BasketComponent[];
+  /** When the basket was created. */
+  created_at:
+    | Date
+    | undefined;
+  /**
+   * Username of the player who published the basket as a custom index. Empty for baskets
+   * defined by admins.
+   */
+  owner: string;
+  /** How often the original weights are restored: "none", "weekly", "monthly" or "quarterly". */
+  rebalance: string;
+  /** When the weights were last restored. */
+  rebalanced_at:
+    | Date
+    | undefined;
+  /** Number of players following the basket. */
+  follower_count: string;
+}
+
+/** Request to create a basket. */
+export interface CreateBasketRequest {
+  /** Symbol of the basket, e.g. "TECH5". It is qualified as "BK:TECH5". */
+  symbol: string;
+  /** Display name. Defaults to the symbol. */
+  name: string;
+  /** Between 2 and 20 distinct components. */
+  components: BasketComponent[];
+}
+
+/** Response containing the created basket. */
+export interface CreateBasketResponse {
+  /** The created basket. */
+  basket: Basket | undefined;
+}
+
+/** Request to list baskets. */
+export interface ListBasketsRequest {
+}
+
+/** Response containing all baskets, in symbol order. */
+export interface ListBasketsResponse {
+  /** Defined baskets. */
+  baskets: Basket[];
+}
+
+/** Request to delete a basket. */
+export interface DeleteBasketRequest {
+  /** Instrument ID of the basket. */
+  symbol: string;
+}
+
+/** Response for a deleted basket. */
+export interface DeleteBasketResponse {
+}
+
+/** A custom index with its current performance. */
+export interface Index {
+  /** The index and its components. */
+  basket:
+    | Basket
+    | undefined;
+  /** Current quote. Unset while a component has no price. */
+  quote:
+    | Quote
+    | undefined;
+  /** Change of the price since creation, in percent. */
+  return_percent: number;
+}
+
+/** Request to publish a custom index. */
+export interface CreateIndexRequest {
+  /** Symbol of the index, e.g. "MYTECH". It is qualified as "BK:MYTECH". */
+  symbol: string;
+  /** Display name. */
+  name: string;
+  /** Between 2 and 20 distinct instruments of the active ladder. */
+  components: BasketComponent[];
+  /** How often the weights are restored: "none" (default), "weekly", "monthly" or "quarterly". */
+  rebalance: string;
+}
+
+/** Response containing the published index. */
+export interface CreateIndexResponse {
+  /** The published index. */
+  basket: Basket | undefined;
+}
+
+/** Request to list custom indices. */
+export interface ListIndicesRequest {
+  /** Maximum number of indices to return, at most 100. */
+  limit: number;
+  /** Pagination offset. */
+  offset: number;
+}
+
+/** Response containing a page of custom indices, in symbol order. */
+export interface ListIndicesResponse {
+  /** Published indices. */
+  indices: Index[];
+}
+
+/** Request to fetch the public page of a custom index. */
+export interface GetIndexRequest {
+  /** Instrument ID of the index. */
+  symbol: string;
+  /** Maximum number of historical prices to return. */
+  limit: number;
+}
+
+/** Response containing the public page of a custom index. */
+export interface GetIndexResponse {
+  /** The index and its performance. */
+  index:
+    | Index
+    | undefined;
+  /** Usernames of the followers, earliest first. */
+  followers: string[];
+  /** Most recent recorded prices, oldest first. */
+  history: Quote[];
+}
+
+/** Request to follow a custom index. */
+export interface FollowIndexRequest {
+  /** Instrument ID of the index. */
+  symbol: string;
+}
+
+/** Response for a followed index. */
+export interface FollowIndexResponse {
+}
+
+/** Request to stop following a custom index. */
+export interface UnfollowIndexRequest {
+  /** Instrument ID of the index. */
+  symbol: string;
+}
+
+/** Response for an unfollowed index. */
+export interface UnfollowIndexResponse {
+}
+
+/** A cash-settled European option listed in a ladder. */
+export interface OptionContract {
+  /** Instrument ID of the contract, e.g. "OPT:AAPL-261120-C200". */
+  symbol: string;
+  /** Instrument ID of the underlying. */
+  underlying: string;
+  /** "call" or "put". */
+  type: string;
+  /** Strike price, in USD. */
+  strike: number;
+  /** When the contract expires and is settled. */
+  expires_at:
+    | Date
+    | undefined;
+  /**
+   * Annualized volatility the premium is priced with. Zero uses the configured or historical
+   * volatility of the underlying.
+   */
+  volatility: number;
+  /** Current premium per unit of the underlying. Unset until the contract is priced. */
+  premium:
+    | Quote
+    | undefined;
+  /** Price of the underlying the contract was settled at. */
+  settlement_price: number;
+  /** When the contract was settled. Unset until it expires. */
+  settled_at: Date | undefined;
+}
+
+/** Request to list an option contract. */
+export interface CreateOptionContractRequest {
+  /** Instrument ID of a USD-priced underlying of the active ladder. */
+  underlying: string;
+  /** "call" or "put". */
+  type: string;
+  /** Strike price, in USD. */
+  strike: number;
+  /** Expiry, before the end of the active ladder. */
+  expires_at:
+    | Date
+    | undefined;
+  /**
+   * Annualized volatility to price the premium with, e.g. 0.3. Omit to use the configured
+   * or historical volatility of the underlying.
+   */
+  volatility: number;
+}
+
+/** Response containing the listed option contract. */
+export interface CreateOptionContractResponse {
+  /** The listed contract. */
+  contract: OptionContract | undefined;
+}
+
+/** Request to list option contracts. */
+export interface ListOptionContractsRequest {
+}
+
+/** Response containing the option contracts of the active ladder. */
+export interface ListOptionContractsResponse {
+  /** Contracts by underlying and expiry. */
+  contracts: OptionContract[];
+}
+
+/**
+ * A binary market on whether a ticker's price is above a threshold at the resolution time.
+ * Shares are priced by a logarithmic market scoring rule market maker, and every winning
+ * share pays out one unit of the ladder's base currency.
+ */
+export interface PredictionMarket {
+  /** Unique identifier of the market. */
+  id: string;
+  /** Instrument ID of the ticker the question is about. */
+  symbol: string;
+  /** The question, e.g. "Will Bitcoin close above 100000 USD on Fri 23 Oct 2026 20:00 UTC?". */
+  question: string;
+  /** Price the ticker must be above for the market to resolve YES, in the ticker's currency. */
+  threshold: number;
+  /** When the market closes and is resolved. */
+  resolves_at:
+    | Date
+    | undefined;
+  /** Liquidity parameter of the market maker; higher values make stakes move prices less. */
+  liquidity: number;
+  /** Price of a YES share, which is also the implied probability of YES. */
+  yes_price: number;
+  /** Price of a NO share. */
+  no_price: number;
+  /** Outstanding YES shares. */
+  yes_shares: number;
+  /** Outstanding NO shares. */
+  no_shares: number;
+  /** "yes" or "no" once resolved, empty before. */
+  outcome: string;
+  /** Recorded price of the ticker at the resolution time. */
+  resolution_price: number;
+  /** When the market was resolved. Unset until it is. */
+  resolved_at: Date | undefined;
+}
+
+/** A user's shares in a prediction market. */
+export interface PredictionPosition {
+  /** Identifier of the market. */
+  market_id: string;
+  /** YES shares held. */
+  yes_shares: number;
+  /** NO shares held. */
+  no_shares: number;
+  /** Total cash staked on the market, in the ladder's base currency. */
+  staked: number;
+}
+
+/** Request to open a prediction market. */
+export interface CreatePredictionMarketRequest {
+  /** Instrument ID of a ticker of the active ladder. */
+  symbol: string;
+  /** Price the ticker must be above for the market to resolve YES. */
+  threshold: number;
+  /** Resolution time, before the end of the active ladder. */
+  resolves_at:
+    | Date
+    | undefined;
+  /** Question to display. Omit to phrase it from the terms. */
+  question: string;
+  /** Liquidity parameter of the market maker. Omit to use the configured default. */
+  liquidity: number;
+}
+
+/** Response containing the opened prediction market. */
+export interface CreatePredictionMarketResponse {
+  /** The opened market. */
+  market: PredictionMarket | undefined;
+}
+
+/** Request to list prediction markets. */
+export interface ListPredictionMarketsRequest {
+}
+
+/** Response containing the prediction markets of the active ladder. */
+export interface ListPredictionMarketsResponse {
+  /** Markets by resolution time. */
+  markets: PredictionMarket[];
+}
+
+/** Request to stake on a prediction market. */
+export interface StakePredictionRequest {
+  /** Identifier of the market. */
+  market_id: string;
+  /** "yes" or "no". */
+  outcome: string;
+  /** Cash to stake, in the ladder's base currency. */
+  amount: number;
+}
+
+/** Response containing the market and the user's position after a stake. */
+export interface StakePredictionResponse {
+  /** The market with its updated prices. */
+  market:
+    | PredictionMarket
+    | undefined;
+  /** The user's position in the market. */
+  position: PredictionPosition | undefined;
+}
+
+/** Request to list the user's prediction positions. */
+export interface ListPredictionPositionsRequest {
+}
+
+/** Response containing the user's prediction positions. */
+export interface ListPredictionPositionsResponse {
+  /** Positions by market resolution time. */
+  positions: PredictionPosition[];
+}
+
 function createBaseQuote(): Quote {
-  return { symbol: "", price: 0, change: 0, change_percent: 0, timestamp: undefined, source: "", is_closed: false };
+  return {
+    symbol: "",
+    price: 0,
+    change: 0,
+    change_percent: 0,
+    timestamp: undefined,
+    source: "",
+    is_closed: false,
+    price_decimal: "",
+    price_precision: 0,
+    bid: "",
+    ask: "",
+    pip_size: "",
+  };
 }
 
 export const Quote: MessageFns<Quote> = {
@@ -156,6 +660,21 @@ export const Quote: MessageFns<Quote> = {
     if (message.is_closed !== false) {
       writer.uint32(56).bool(message.is_closed);
     }
+    if (message.price_decimal !== "") {
+      writer.uint32(66).string(message.price_decimal);
+    }
+    if (message.price_precision !== 0) {
+      writer.uint32(72).int32(message.price_precision);
+    }
+    if (message.bid !== "") {
+      writer.uint32(82).string(message.bid);
+    }
+    if (message.ask !== "") {
+      writer.uint32(90).string(message.ask);
+    }
+    if (message.pip_size !== "") {
+      writer.uint32(98).string(message.pip_size);
+    }
     return writer;
   },
 
@@ -222,6 +741,46 @@ export const Quote: MessageFns<Quote> = {
           message.is_closed = reader.bool();
           continue;
         }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.price_decimal = reader.string();
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.price_precision = reader.int32();
+          continue;
+        }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.bid = reader.string();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.ask = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 98) {
+            break;
+          }
+
+          message.pip_size = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -248,6 +807,23 @@ export const Quote: MessageFns<Quote> = {
         : isSet(object.is_closed)
         ? globalThis.Boolean(object.is_closed)
         : false,
+      price_decimal: isSet(object.priceDecimal)
+        ? globalThis.String(object.priceDecimal)
+        : isSet(object.price_decimal)
+        ? globalThis.String(object.price_decimal)
+        : "",
+      price_precision: isSet(object.pricePrecision)
+        ? globalThis.Number(object.pricePrecision)
+        : isSet(object.price_precision)
+        ? globalThis.Number(object.price_precision)
+        : 0,
+      bid: isSet(object.bid) ? globalThis.String(object.bid) : "",
+      ask: isSet(object.ask) ? globalThis.String(object.ask) : "",
+      pip_size: isSet(object.pipSize)
+        ? globalThis.String(object.pipSize)
+        : isSet(object.pip_size)
+        ? globalThis.String(object.pip_size)
+        : "",
     };
   },
 
@@ -274,6 +850,21 @@ export const Quote: MessageFns<Quote> = {
     if (message.is_closed !== false) {
       obj.isClosed = message.is_closed;
     }
+    if (message.price_decimal !== "") {
+      obj.priceDecimal = message.price_decimal;
+    }
+    if (message.price_precision !== 0) {
+      obj.pricePrecision = Math.round(message.price_precision);
+    }
+    if (message.bid !== "") {
+      obj.bid = message.bid;
+    }
+    if (message.ask !== "") {
+      obj.ask = message.ask;
+    }
+    if (message.pip_size !== "") {
+      obj.pipSize = message.pip_size;
+    }
     return obj;
   },
 
@@ -289,6 +880,11 @@ export const Quote: MessageFns<Quote> = {
     message.timestamp = object.timestamp ?? undefined;
     message.source = object.source ?? "";
     message.is_closed = object.is_closed ?? false;
+    message.price_decimal = object.price_decimal ?? "";
+    message.price_precision = object.price_precision ?? 0;
+    message.bid = object.bid ?? "";
+    message.ask = object.ask ?? "";
+    message.pip_size = object.pip_size ?? "";
     return message;
   },
 };
@@ -545,22 +1141,31 @@ export const GetHistoryResponse: MessageFns<GetHistoryResponse> = {
   },
 };
 
-function createBaseStreamQuotesRequest(): StreamQuotesRequest {
-  return { symbol: "" };
+function createBaseGetIndicatorsRequest(): GetIndicatorsRequest {
+  return { symbol: "", type: "", period: 0, limit: 0 };
 }
 
-export const StreamQuotesRequest: MessageFns<StreamQuotesRequest> = {
-  encode(message: StreamQuotesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+export const GetIndicatorsRequest: MessageFns<GetIndicatorsRequest> = {
+  encode(message: GetIndicatorsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
     if (message.symbol !== "") {
       writer.uint32(10).string(message.symbol);
     }
+    if (message.type !== "") {
+      writer.uint32(18).string(message.type);
+    }
+    if (message.period !== 0) {
+      writer.uint32(24).int32(message.period);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(32).int32(message.limit);
+    }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): StreamQuotesRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): GetIndicatorsRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseStreamQuotesRequest();
+    const message = createBaseGetIndicatorsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -572,6 +1177,30 @@ export const StreamQuotesRequest: MessageFns<StreamQuotesRequest> = {
           message.symbol = reader.string();
           continue;
         }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.period = reader.int32();
+          continue;
+        }
+        case 4: {
+          if (tag !== 32) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -581,44 +1210,61 @@ export const StreamQuotesRequest: MessageFns<StreamQuotesRequest> = {
     return message;
   },
 
-  fromJSON(object: any): StreamQuotesRequest {
-    return { symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "" };
+  fromJSON(object: any): GetIndicatorsRequest {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      period: isSet(object.period) ? globalThis.Number(object.period) : 0,
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+    };
   },
 
-  toJSON(message: StreamQuotesRequest): unknown {
+  toJSON(message: GetIndicatorsRequest): unknown {
     const obj: any = {};
     if (message.symbol !== "") {
       obj.symbol = message.symbol;
     }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.period !== 0) {
+      obj.period = Math.round(message.period);
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<StreamQuotesRequest>, I>>(base?: I): StreamQuotesRequest {
-    return StreamQuotesRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<GetIndicatorsRequest>, I>>(base?: I): GetIndicatorsRequest {
+    return GetIndicatorsRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<StreamQuotesRequest>, I>>(object: I): StreamQuotesRequest {
-    const message = createBaseStreamQuotesRequest();
+  fromPartial<I extends Exact<DeepPartial<GetIndicatorsRequest>, I>>(object: I): GetIndicatorsRequest {
+    const message = createBaseGetIndicatorsRequest();
     message.symbol = object.symbol ?? "";
+    message.type = object.type ?? "";
+    message.period = object.period ?? 0;
+    message.limit = object.limit ?? 0;
     return message;
   },
 };
 
-function createBaseStreamQuotesResponse(): StreamQuotesResponse {
-  return { quote: undefined };
+function createBaseGetIndicatorsResponse(): GetIndicatorsResponse {
+  return { indicators: [] };
 }
 
-export const StreamQuotesResponse: MessageFns<StreamQuotesResponse> = {
-  encode(message: StreamQuotesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.quote !== undefined) {
-      Quote.encode(message.quote, writer.uint32(10).fork()).join();
+export const GetIndicatorsResponse: MessageFns<GetIndicatorsResponse> = {
+  encode(message: GetIndicatorsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.indicators) {
+      Indicator.encode(v!, writer.uint32(10).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): StreamQuotesResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): GetIndicatorsResponse {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseStreamQuotesResponse();
+    const message = createBaseGetIndicatorsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -627,7 +1273,7 @@ export const StreamQuotesResponse: MessageFns<StreamQuotesResponse> = {
             break;
           }
 
-          message.quote = Quote.decode(reader, reader.uint32());
+          message.indicators.push(Indicator.decode(reader, reader.uint32()));
           continue;
         }
       }
@@ -639,50 +1285,54 @@ export const StreamQuotesResponse: MessageFns<StreamQuotesResponse> = {
     return message;
   },
 
-  fromJSON(object: any): StreamQuotesResponse {
-    return { quote: isSet(object.quote) ? Quote.fromJSON(object.quote) : undefined };
+  fromJSON(object: any): GetIndicatorsResponse {
+    return {
+      indicators: globalThis.Array.isArray(object?.indicators)
+        ? object.indicators.map((e: any) => Indicator.fromJSON(e))
+        : [],
+    };
   },
 
-  toJSON(message: StreamQuotesResponse): unknown {
+  toJSON(message: GetIndicatorsResponse): unknown {
     const obj: any = {};
-    if (message.quote !== undefined) {
-      obj.quote = Quote.toJSON(message.quote);
+    if (message.indicators?.length) {
+      obj.indicators = message.indicators.map((e) => Indicator.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<StreamQuotesResponse>, I>>(base?: I): StreamQuotesResponse {
-    return StreamQuotesResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<GetIndicatorsResponse>, I>>(base?: I): GetIndicatorsResponse {
+    return GetIndicatorsResponse.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<StreamQuotesResponse>, I>>(object: I): StreamQuotesResponse {
-    const message = createBaseStreamQuotesResponse();
-    message.quote = (object.quote !== undefined && object.quote !== null) ? Quote.fromPartial(object.quote) : undefined;
+  fromPartial<I extends Exact<DeepPartial<GetIndicatorsResponse>, I>>(object: I): GetIndicatorsResponse {
+    const message = createBaseGetIndicatorsResponse();
+    message.indicators = object.indicators?.map((e) => Indicator.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseCreateTradeRequest(): CreateTradeRequest {
-  return { symbol: "", quantity: 0, action: 0 };
+function createBaseIndicator(): Indicator {
+  return { type: "", period: 0, points: [] };
 }
 
-export const CreateTradeRequest: MessageFns<CreateTradeRequest> = {
-  encode(message: CreateTradeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.symbol !== "") {
-      writer.uint32(10).string(message.symbol);
+export const Indicator: MessageFns<Indicator> = {
+  encode(message: Indicator, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.type !== "") {
+      writer.uint32(10).string(message.type);
     }
-    if (message.quantity !== 0) {
-      writer.uint32(17).double(message.quantity);
+    if (message.period !== 0) {
+      writer.uint32(16).int32(message.period);
     }
-    if (message.action !== 0) {
-      writer.uint32(24).int32(message.action);
+    for (const v of message.points) {
+      IndicatorPoint.encode(v!, writer.uint32(26).fork()).join();
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): CreateTradeRequest {
+  decode(input: BinaryReader | Uint8Array, length?: number): Indicator {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCreateTradeRequest();
+    const message = createBaseIndicator();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -691,7 +1341,108 @@ export const CreateTradeRequest: MessageFns<CreateTradeRequest> = {
             break;
           }
 
-          message.symbol = reader.string();
+          message.type = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.period = reader.int32();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.points.push(IndicatorPoint.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Indicator {
+    return {
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      period: isSet(object.period) ? globalThis.Number(object.period) : 0,
+      points: globalThis.Array.isArray(object?.points) ? object.points.map((e: any) => IndicatorPoint.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: Indicator): unknown {
+    const obj: any = {};
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.period !== 0) {
+      obj.period = Math.round(message.period);
+    }
+    if (message.points?.length) {
+      obj.points = message.points.map((e) => IndicatorPoint.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Indicator>, I>>(base?: I): Indicator {
+    return Indicator.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Indicator>, I>>(object: I): Indicator {
+    const message = createBaseIndicator();
+    message.type = object.type ?? "";
+    message.period = object.period ?? 0;
+    message.points = object.points?.map((e) => IndicatorPoint.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseIndicatorPoint(): IndicatorPoint {
+  return { timestamp: undefined, value: 0, signal: 0, histogram: 0, upper: 0, lower: 0 };
+}
+
+export const IndicatorPoint: MessageFns<IndicatorPoint> = {
+  encode(message: IndicatorPoint, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.timestamp !== undefined) {
+      Timestamp.encode(toTimestamp(message.timestamp), writer.uint32(10).fork()).join();
+    }
+    if (message.value !== 0) {
+      writer.uint32(17).double(message.value);
+    }
+    if (message.signal !== 0) {
+      writer.uint32(25).double(message.signal);
+    }
+    if (message.histogram !== 0) {
+      writer.uint32(33).double(message.histogram);
+    }
+    if (message.upper !== 0) {
+      writer.uint32(41).double(message.upper);
+    }
+    if (message.lower !== 0) {
+      writer.uint32(49).double(message.lower);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): IndicatorPoint {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIndicatorPoint();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.timestamp = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         }
         case 2: {
@@ -699,15 +1450,39 @@ export const CreateTradeRequest: MessageFns<CreateTradeRequest> = {
             break;
           }
 
-          message.quantity = reader.double();
+          message.value = reader.double();
           continue;
         }
         case 3: {
-          if (tag !== 24) {
+          if (tag !== 25) {
             break;
           }
 
-          message.action = reader.int32() as any;
+          message.signal = reader.double();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.histogram = reader.double();
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.upper = reader.double();
+          continue;
+        }
+        case 6: {
+          if (tag !== 49) {
+            break;
+          }
+
+          message.lower = reader.double();
           continue;
         }
       }
@@ -719,56 +1494,258 @@ export const CreateTradeRequest: MessageFns<CreateTradeRequest> = {
     return message;
   },
 
-  fromJSON(object: any): CreateTradeRequest {
+  fromJSON(object: any): IndicatorPoint {
+    return {
+      timestamp: isSet(object.timestamp) ? fromJsonTimestamp(object.timestamp) : undefined,
+      value: isSet(object.value) ? globalThis.Number(object.value) : 0,
+      signal: isSet(object.signal) ? globalThis.Number(object.signal) : 0,
+      histogram: isSet(object.histogram) ? globalThis.Number(object.histogram) : 0,
+      upper: isSet(object.upper) ? globalThis.Number(object.upper) : 0,
+      lower: isSet(object.lower) ? globalThis.Number(object.lower) : 0,
+    };
+  },
+
+  toJSON(message: IndicatorPoint): unknown {
+    const obj: any = {};
+    if (message.timestamp !== undefined) {
+      obj.timestamp = message.timestamp.toISOString();
+    }
+    if (message.value !== 0) {
+      obj.value = message.value;
+    }
+    if (message.signal !== 0) {
+      obj.signal = message.signal;
+    }
+    if (message.histogram !== 0) {
+      obj.histogram = message.histogram;
+    }
+    if (message.upper !== 0) {
+      obj.upper = message.upper;
+    }
+    if (message.lower !== 0) {
+      obj.lower = message.lower;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<IndicatorPoint>, I>>(base?: I): IndicatorPoint {
+    return IndicatorPoint.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<IndicatorPoint>, I>>(object: I): IndicatorPoint {
+    const message = createBaseIndicatorPoint();
+    message.timestamp = object.timestamp ?? undefined;
+    message.value = object.value ?? 0;
+    message.signal = object.signal ?? 0;
+    message.histogram = object.histogram ?? 0;
+    message.upper = object.upper ?? 0;
+    message.lower = object.lower ?? 0;
+    return message;
+  },
+};
+
+function createBaseInstrument(): Instrument {
+  return { symbol: "", source: "", name: "", asset_class: "", currency: "", exchange: "", tick_size: 0, pip_size: 0 };
+}
+
+export const Instrument: MessageFns<Instrument> = {
+  encode(message: Instrument, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.source !== "") {
+      writer.uint32(18).string(message.source);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.asset_class !== "") {
+      writer.uint32(34).string(message.asset_class);
+    }
+    if (message.currency !== "") {
+      writer.uint32(42).string(message.currency);
+    }
+    if (message.exchange !== "") {
+      writer.uint32(50).string(message.exchange);
+    }
+    if (message.tick_size !== 0) {
+      writer.uint32(57).double(message.tick_size);
+    }
+    if (message.pip_size !== 0) {
+      writer.uint32(65).double(message.pip_size);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Instrument {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseInstrument();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.source = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.asset_class = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.currency = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.exchange = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 57) {
+            break;
+          }
+
+          message.tick_size = reader.double();
+          continue;
+        }
+        case 8: {
+          if (tag !== 65) {
+            break;
+          }
+
+          message.pip_size = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Instrument {
     return {
       symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
-      quantity: isSet(object.quantity) ? globalThis.Number(object.quantity) : 0,
-      action: isSet(object.action) ? tradeActionFromJSON(object.action) : 0,
+      source: isSet(object.source) ? globalThis.String(object.source) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      asset_class: isSet(object.assetClass)
+        ? globalThis.String(object.assetClass)
+        : isSet(object.asset_class)
+        ? globalThis.String(object.asset_class)
+        : "",
+      currency: isSet(object.currency) ? globalThis.String(object.currency) : "",
+      exchange: isSet(object.exchange) ? globalThis.String(object.exchange) : "",
+      tick_size: isSet(object.tickSize)
+        ? globalThis.Number(object.tickSize)
+        : isSet(object.tick_size)
+        ? globalThis.Number(object.tick_size)
+        : 0,
+      pip_size: isSet(object.pipSize)
+        ? globalThis.Number(object.pipSize)
+        : isSet(object.pip_size)
+        ? globalThis.Number(object.pip_size)
+        : 0,
     };
   },
 
-  toJSON(message: CreateTradeRequest): unknown {
+  toJSON(message: Instrument): unknown {
     const obj: any = {};
     if (message.symbol !== "") {
       obj.symbol = message.symbol;
     }
-    if (message.quantity !== 0) {
-      obj.quantity = message.quantity;
+    if (message.source !== "") {
+      obj.source = message.source;
     }
-    if (message.action !== 0) {
-      obj.action = tradeActionToJSON(message.action);
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.asset_class !== "") {
+      obj.assetClass = message.asset_class;
+    }
+    if (message.currency !== "") {
+      obj.currency = message.currency;
+    }
+    if (message.exchange !== "") {
+      obj.exchange = message.exchange;
+    }
+    if (message.tick_size !== 0) {
+      obj.tickSize = message.tick_size;
+    }
+    if (message.pip_size !== 0) {
+      obj.pipSize = message.pip_size;
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<CreateTradeRequest>, I>>(base?: I): CreateTradeRequest {
-    return CreateTradeRequest.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<Instrument>, I>>(base?: I): Instrument {
+    return Instrument.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<CreateTradeRequest>, I>>(object: I): CreateTradeRequest {
-    const message = createBaseCreateTradeRequest();
+  fromPartial<I extends Exact<DeepPartial<Instrument>, I>>(object: I): Instrument {
+    const message = createBaseInstrument();
     message.symbol = object.symbol ?? "";
-    message.quantity = object.quantity ?? 0;
-    message.action = object.action ?? 0;
+    message.source = object.source ?? "";
+    message.name = object.name ?? "";
+    message.asset_class = object.asset_class ?? "";
+    message.currency = object.currency ?? "";
+    message.exchange = object.exchange ?? "";
+    message.tick_size = object.tick_size ?? 0;
+    message.pip_size = object.pip_size ?? 0;
     return message;
   },
 };
 
-function createBaseCreateTradeResponse(): CreateTradeResponse {
-  return { participant: undefined };
+function createBaseSearchInstrumentsRequest(): SearchInstrumentsRequest {
+  return { query: "", limit: 0 };
 }
 
-export const CreateTradeResponse: MessageFns<CreateTradeResponse> = {
-  encode(message: CreateTradeResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
-    if (message.participant !== undefined) {
-      LadderParticipant.encode(message.participant, writer.uint32(10).fork()).join();
+export const SearchInstrumentsRequest: MessageFns<SearchInstrumentsRequest> = {
+  encode(message: SearchInstrumentsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(16).int32(message.limit);
     }
     return writer;
   },
 
-  decode(input: BinaryReader | Uint8Array, length?: number): CreateTradeResponse {
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchInstrumentsRequest {
     const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
     const end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCreateTradeResponse();
+    const message = createBaseSearchInstrumentsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -777,7 +1754,15 @@ export const CreateTradeResponse: MessageFns<CreateTradeResponse> = {
             break;
           }
 
-          message.participant = LadderParticipant.decode(reader, reader.uint32());
+          message.query = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.limit = reader.int32();
           continue;
         }
       }
@@ -789,26 +1774,3802 @@ export const CreateTradeResponse: MessageFns<CreateTradeResponse> = {
     return message;
   },
 
-  fromJSON(object: any): CreateTradeResponse {
-    return { participant: isSet(object.participant) ? LadderParticipant.fromJSON(object.participant) : undefined };
+  fromJSON(object: any): SearchInstrumentsRequest {
+    return {
+      query: isSet(object.query) ? globalThis.String(object.query) : "",
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+    };
   },
 
-  toJSON(message: CreateTradeResponse): unknown {
+  toJSON(message: SearchInstrumentsRequest): unknown {
     const obj: any = {};
-    if (message.participant !== undefined) {
-      obj.participant = LadderParticipant.toJSON(message.participant);
+    if (message.query !== "") {
+      obj.query = message.query;
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<CreateTradeResponse>, I>>(base?: I): CreateTradeResponse {
-    return CreateTradeResponse.fromPartial(base ?? ({} as any));
+  create<I extends Exact<DeepPartial<SearchInstrumentsRequest>, I>>(base?: I): SearchInstrumentsRequest {
+    return SearchInstrumentsRequest.fromPartial(base ?? ({} as any));
   },
-  fromPartial<I extends Exact<DeepPartial<CreateTradeResponse>, I>>(object: I): CreateTradeResponse {
-    const message = createBaseCreateTradeResponse();
-    message.participant = (object.participant !== undefined && object.participant !== null)
-      ? LadderParticipant.fromPartial(object.participant)
-      : undefined;
+  fromPartial<I extends Exact<DeepPartial<SearchInstrumentsRequest>, I>>(object: I): SearchInstrumentsRequest {
+    const message = createBaseSearchInstrumentsRequest();
+    message.query = object.query ?? "";
+    message.limit = object.limit ?? 0;
+    return message;
+  },
+};
+
+function createBaseSearchInstrumentsResponse(): SearchInstrumentsResponse {
+  return { instruments: [] };
+}
+
+export const SearchInstrumentsResponse: MessageFns<SearchInstrumentsResponse> = {
+  encode(message: SearchInstrumentsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.instruments) {
+      Instrument.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): SearchInstrumentsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSearchInstrumentsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.instruments.push(Instrument.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SearchInstrumentsResponse {
+    return {
+      instruments: globalThis.Array.isArray(object?.instruments)
+        ? object.instruments.map((e: any) => Instrument.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SearchInstrumentsResponse): unknown {
+    const obj: any = {};
+    if (message.instruments?.length) {
+      obj.instruments = message.instruments.map((e) => Instrument.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SearchInstrumentsResponse>, I>>(base?: I): SearchInstrumentsResponse {
+    return SearchInstrumentsResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<SearchInstrumentsResponse>, I>>(object: I): SearchInstrumentsResponse {
+    const message = createBaseSearchInstrumentsResponse();
+    message.instruments = object.instruments?.map((e) => Instrument.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseStreamQuotesRequest(): StreamQuotesRequest {
+  return { symbol: "", symbols: [] };
+}
+
+export const StreamQuotesRequest: MessageFns<StreamQuotesRequest> = {
+  encode(message: StreamQuotesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    for (const v of message.symbols) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): StreamQuotesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStreamQuotesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.symbols.push(reader.string());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StreamQuotesRequest {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      symbols: globalThis.Array.isArray(object?.symbols) ? object.symbols.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: StreamQuotesRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.symbols?.length) {
+      obj.symbols = message.symbols;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StreamQuotesRequest>, I>>(base?: I): StreamQuotesRequest {
+    return StreamQuotesRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<StreamQuotesRequest>, I>>(object: I): StreamQuotesRequest {
+    const message = createBaseStreamQuotesRequest();
+    message.symbol = object.symbol ?? "";
+    message.symbols = object.symbols?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseStreamQuotesResponse(): StreamQuotesResponse {
+  return { quote: undefined, event_id: "" };
+}
+
+export const StreamQuotesResponse: MessageFns<StreamQuotesResponse> = {
+  encode(message: StreamQuotesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.quote !== undefined) {
+      Quote.encode(message.quote, writer.uint32(10).fork()).join();
+    }
+    if (message.event_id !== "") {
+      writer.uint32(18).string(message.event_id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): StreamQuotesResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStreamQuotesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.quote = Quote.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.event_id = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StreamQuotesResponse {
+    return {
+      quote: isSet(object.quote) ? Quote.fromJSON(object.quote) : undefined,
+      event_id: isSet(object.eventId)
+        ? globalThis.String(object.eventId)
+        : isSet(object.event_id)
+        ? globalThis.String(object.event_id)
+        : "",
+    };
+  },
+
+  toJSON(message: StreamQuotesResponse): unknown {
+    const obj: any = {};
+    if (message.quote !== undefined) {
+      obj.quote = Quote.toJSON(message.quote);
+    }
+    if (message.event_id !== "") {
+      obj.eventId = message.event_id;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StreamQuotesResponse>, I>>(base?: I): StreamQuotesResponse {
+    return StreamQuotesResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<StreamQuotesResponse>, I>>(object: I): StreamQuotesResponse {
+    const message = createBaseStreamQuotesResponse();
+    message.quote = (object.quote !== undefined && object.quote !== null) ? Quote.fromPartial(object.quote) : undefined;
+    message.event_id = object.event_id ?? "";
+    return message;
+  },
+};
+
+function createBaseCreateTradeRequest(): CreateTradeRequest {
+  return { symbol: "", quantity: 0, action: 0 };
+}
+
+export const CreateTradeRequest: MessageFns<CreateTradeRequest> = {
+  encode(message: CreateTradeRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.quantity !== 0) {
+      writer.uint32(17).double(message.quantity);
+    }
+    if (message.action !== 0) {
+      writer.uint32(24).int32(message.action);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateTradeRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateTradeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.quantity = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 24) {
+            break;
+          }
+
+          message.action = reader.int32() as any;
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateTradeRequest {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      quantity: isSet(object.quantity) ? globalThis.Number(object.quantity) : 0,
+      action: isSet(object.action) ? tradeActionFromJSON(object.action) : 0,
+    };
+  },
+
+  toJSON(message: CreateTradeRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.quantity !== 0) {
+      obj.quantity = message.quantity;
+    }
+    if (message.action !== 0) {
+      obj.action = tradeActionToJSON(message.action);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateTradeRequest>, I>>(base?: I): CreateTradeRequest {
+    return CreateTradeRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateTradeRequest>, I>>(object: I): CreateTradeRequest {
+    const message = createBaseCreateTradeRequest();
+    message.symbol = object.symbol ?? "";
+    message.quantity = object.quantity ?? 0;
+    message.action = object.action ?? 0;
+    return message;
+  },
+};
+
+function createBaseCreateTradeResponse(): CreateTradeResponse {
+  return { participant: undefined };
+}
+
+export const CreateTradeResponse: MessageFns<CreateTradeResponse> = {
+  encode(message: CreateTradeResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.participant !== undefined) {
+      LadderParticipant.encode(message.participant, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateTradeResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateTradeResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.participant = LadderParticipant.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateTradeResponse {
+    return { participant: isSet(object.participant) ? LadderParticipant.fromJSON(object.participant) : undefined };
+  },
+
+  toJSON(message: CreateTradeResponse): unknown {
+    const obj: any = {};
+    if (message.participant !== undefined) {
+      obj.participant = LadderParticipant.toJSON(message.participant);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateTradeResponse>, I>>(base?: I): CreateTradeResponse {
+    return CreateTradeResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateTradeResponse>, I>>(object: I): CreateTradeResponse {
+    const message = createBaseCreateTradeResponse();
+    message.participant = (object.participant !== undefined && object.participant !== null)
+      ? LadderParticipant.fromPartial(object.participant)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseQuarantinedQuote(): QuarantinedQuote {
+  return { id: "", quote: undefined, reason: "", detail: "", quarantined_at: undefined };
+}
+
+export const QuarantinedQuote: MessageFns<QuarantinedQuote> = {
+  encode(message: QuarantinedQuote, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.quote !== undefined) {
+      Quote.encode(message.quote, writer.uint32(18).fork()).join();
+    }
+    if (message.reason !== "") {
+      writer.uint32(26).string(message.reason);
+    }
+    if (message.detail !== "") {
+      writer.uint32(34).string(message.detail);
+    }
+    if (message.quarantined_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.quarantined_at), writer.uint32(42).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): QuarantinedQuote {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuarantinedQuote();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.quote = Quote.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.reason = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.quarantined_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuarantinedQuote {
+    return {
+      id: isSet(object.id) ? globalThis.String(object.id) : "",
+      quote: isSet(object.quote) ? Quote.fromJSON(object.quote) : undefined,
+      reason: isSet(object.reason) ? globalThis.String(object.reason) : "",
+      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
+      quarantined_at: isSet(object.quarantinedAt)
+        ? fromJsonTimestamp(object.quarantinedAt)
+        : isSet(object.quarantined_at)
+        ? fromJsonTimestamp(object.quarantined_at)
+        : undefined,
+    };
+  },
+
+  toJSON(message: QuarantinedQuote): unknown {
+    const obj: any = {};
+    if (message.id !== "") {
+      obj.id = message.id;
+    }
+    if (message.quote !== undefined) {
+      obj.quote = Quote.toJSON(message.quote);
+    }
+    if (message.reason !== "") {
+      obj.reason = message.reason;
+    }
+    if (message.detail !== "") {
+      obj.detail = message.detail;
+    }
+    if (message.quarantined_at !== undefined) {
+      obj.quarantinedAt = message.quarantined_at.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuarantinedQuote>, I>>(base?: I): QuarantinedQuote {
+    return QuarantinedQuote.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<QuarantinedQuote>, I>>(object: I): QuarantinedQuote {
+    const message = createBaseQuarantinedQuote();
+    message.id = object.id ?? "";
+    message.quote = (object.quote !== undefined && object.quote !== null) ? Quote.fromPartial(object.quote) : undefined;
+    message.reason = object.reason ?? "";
+    message.detail = object.detail ?? "";
+    message.quarantined_at = object.quarantined_at ?? undefined;
+    return message;
+  },
+};
+
+function createBaseListQuarantinedQuotesRequest(): ListQuarantinedQuotesRequest {
+  return {};
+}
+
+export const ListQuarantinedQuotesRequest: MessageFns<ListQuarantinedQuotesRequest> = {
+  encode(_: ListQuarantinedQuotesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListQuarantinedQuotesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListQuarantinedQuotesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListQuarantinedQuotesRequest {
+    return {};
+  },
+
+  toJSON(_: ListQuarantinedQuotesRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListQuarantinedQuotesRequest>, I>>(base?: I): ListQuarantinedQuotesRequest {
+    return ListQuarantinedQuotesRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListQuarantinedQuotesRequest>, I>>(_: I): ListQuarantinedQuotesRequest {
+    const message = createBaseListQuarantinedQuotesRequest();
+    return message;
+  },
+};
+
+function createBaseListQuarantinedQuotesResponse(): ListQuarantinedQuotesResponse {
+  return { quotes: [] };
+}
+
+export const ListQuarantinedQuotesResponse: MessageFns<ListQuarantinedQuotesResponse> = {
+  encode(message: ListQuarantinedQuotesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.quotes) {
+      QuarantinedQuote.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListQuarantinedQuotesResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListQuarantinedQuotesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.quotes.push(QuarantinedQuote.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListQuarantinedQuotesResponse {
+    return {
+      quotes: globalThis.Array.isArray(object?.quotes)
+        ? object.quotes.map((e: any) => QuarantinedQuote.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListQuarantinedQuotesResponse): unknown {
+    const obj: any = {};
+    if (message.quotes?.length) {
+      obj.quotes = message.quotes.map((e) => QuarantinedQuote.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListQuarantinedQuotesResponse>, I>>(base?: I): ListQuarantinedQuotesResponse {
+    return ListQuarantinedQuotesResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListQuarantinedQuotesResponse>, I>>(
+    object: I,
+  ): ListQuarantinedQuotesResponse {
+    const message = createBaseListQuarantinedQuotesResponse();
+    message.quotes = object.quotes?.map((e) => QuarantinedQuote.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseReleaseQuarantinedQuoteRequest(): ReleaseQuarantinedQuoteRequest {
+  return { id: "" };
+}
+
+export const ReleaseQuarantinedQuoteRequest: MessageFns<ReleaseQuarantinedQuoteRequest> = {
+  encode(message: ReleaseQuarantinedQuoteRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ReleaseQuarantinedQuoteRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReleaseQuarantinedQuoteRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReleaseQuarantinedQuoteRequest {
+    return { id: isSet(object.id) ? globalThis.String(object.id) : "" };
+  },
+
+  toJSON(message: ReleaseQuarantinedQuoteRequest): unknown {
+    const obj: any = {};
+    if (message.id !== "") {
+      obj.id = message.id;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ReleaseQuarantinedQuoteRequest>, I>>(base?: I): ReleaseQuarantinedQuoteRequest {
+    return ReleaseQuarantinedQuoteRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ReleaseQuarantinedQuoteRequest>, I>>(
+    object: I,
+  ): ReleaseQuarantinedQuoteRequest {
+    const message = createBaseReleaseQuarantinedQuoteRequest();
+    message.id = object.id ?? "";
+    return message;
+  },
+};
+
+function createBaseReleaseQuarantinedQuoteResponse(): ReleaseQuarantinedQuoteResponse {
+  return { quote: undefined };
+}
+
+export const ReleaseQuarantinedQuoteResponse: MessageFns<ReleaseQuarantinedQuoteResponse> = {
+  encode(message: ReleaseQuarantinedQuoteResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.quote !== undefined) {
+      Quote.encode(message.quote, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ReleaseQuarantinedQuoteResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReleaseQuarantinedQuoteResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.quote = Quote.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReleaseQuarantinedQuoteResponse {
+    return { quote: isSet(object.quote) ? Quote.fromJSON(object.quote) : undefined };
+  },
+
+  toJSON(message: ReleaseQuarantinedQuoteResponse): unknown {
+    const obj: any = {};
+    if (message.quote !== undefined) {
+      obj.quote = Quote.toJSON(message.quote);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ReleaseQuarantinedQuoteResponse>, I>>(base?: I): ReleaseQuarantinedQuoteResponse {
+    return ReleaseQuarantinedQuoteResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ReleaseQuarantinedQuoteResponse>, I>>(
+    object: I,
+  ): ReleaseQuarantinedQuoteResponse {
+    const message = createBaseReleaseQuarantinedQuoteResponse();
+    message.quote = (object.quote !== undefined && object.quote !== null) ? Quote.fromPartial(object.quote) : undefined;
+    return message;
+  },
+};
+
+function createBaseDiscardQuarantinedQuoteRequest(): DiscardQuarantinedQuoteRequest {
+  return { id: "" };
+}
+
+export const DiscardQuarantinedQuoteRequest: MessageFns<DiscardQuarantinedQuoteRequest> = {
+  encode(message: DiscardQuarantinedQuoteRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DiscardQuarantinedQuoteRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDiscardQuarantinedQuoteRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DiscardQuarantinedQuoteRequest {
+    return { id: isSet(object.id) ? globalThis.String(object.id) : "" };
+  },
+
+  toJSON(message: DiscardQuarantinedQuoteRequest): unknown {
+    const obj: any = {};
+    if (message.id !== "") {
+      obj.id = message.id;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DiscardQuarantinedQuoteRequest>, I>>(base?: I): DiscardQuarantinedQuoteRequest {
+    return DiscardQuarantinedQuoteRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DiscardQuarantinedQuoteRequest>, I>>(
+    object: I,
+  ): DiscardQuarantinedQuoteRequest {
+    const message = createBaseDiscardQuarantinedQuoteRequest();
+    message.id = object.id ?? "";
+    return message;
+  },
+};
+
+function createBaseDiscardQuarantinedQuoteResponse(): DiscardQuarantinedQuoteResponse {
+  return {};
+}
+
+export const DiscardQuarantinedQuoteResponse: MessageFns<DiscardQuarantinedQuoteResponse> = {
+  encode(_: DiscardQuarantinedQuoteResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DiscardQuarantinedQuoteResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDiscardQuarantinedQuoteResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): DiscardQuarantinedQuoteResponse {
+    return {};
+  },
+
+  toJSON(_: DiscardQuarantinedQuoteResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DiscardQuarantinedQuoteResponse>, I>>(base?: I): DiscardQuarantinedQuoteResponse {
+    return DiscardQuarantinedQuoteResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DiscardQuarantinedQuoteResponse>, I>>(_: I): DiscardQuarantinedQuoteResponse {
+    const message = createBaseDiscardQuarantinedQuoteResponse();
+    return message;
+  },
+};
+
+function createBaseBasketComponent(): BasketComponent {
+  return { symbol: "", weight: 0, units: 0 };
+}
+
+export const BasketComponent: MessageFns<BasketComponent> = {
+  encode(message: BasketComponent, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.weight !== 0) {
+      writer.uint32(17).double(message.weight);
+    }
+    if (message.units !== 0) {
+      writer.uint32(25).double(message.units);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): BasketComponent {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBasketComponent();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.weight = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.units = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BasketComponent {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      weight: isSet(object.weight) ? globalThis.Number(object.weight) : 0,
+      units: isSet(object.units) ? globalThis.Number(object.units) : 0,
+    };
+  },
+
+  toJSON(message: BasketComponent): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.weight !== 0) {
+      obj.weight = message.weight;
+    }
+    if (message.units !== 0) {
+      obj.units = message.units;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<BasketComponent>, I>>(base?: I): BasketComponent {
+    return BasketComponent.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<BasketComponent>, I>>(object: I): BasketComponent {
+    const message = createBaseBasketComponent();
+    message.symbol = object.symbol ?? "";
+    message.weight = object.weight ?? 0;
+    message.units = object.units ?? 0;
+    return message;
+  },
+};
+
+function createBaseBasket(): Basket {
+  return {
+    symbol: "",
+    name: "",
+    base_value: 0,
+    components: [],
+    created_at: undefined,
+    owner: "",
+    rebalance: "",
+    rebalanced_at: undefined,
+    follower_count: "0",
+  };
+}
+
+export const Basket: MessageFns<Basket> = {
+  encode(message: Basket, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.base_value !== 0) {
+      writer.uint32(25).double(message.base_value);
+    }
+    for (const v of message.components) {
+      BasketComponent.encode(v!, writer.uint32(34).fork()).join();
+    }
+    if (message.created_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.created_at), writer.uint32(42).fork()).join();
+    }
+    if (message.owner !== "") {
+      writer.uint32(50).string(message.owner);
+    }
+    if (message.rebalance !== "") {
+      writer.uint32(58).string(message.rebalance);
+    }
+    if (message.rebalanced_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.rebalanced_at), writer.uint32(66).fork()).join();
+    }
+    if (message.follower_count !== "0") {
+      writer.uint32(72).int64(message.follower_count);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Basket {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBasket();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.base_value = reader.double();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.components.push(BasketComponent.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.created_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.owner = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.rebalance = reader.string();
+          continue;
+        }
+        case 8: {
+          if (tag !== 66) {
+            break;
+          }
+
+          message.rebalanced_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 9: {
+          if (tag !== 72) {
+            break;
+          }
+
+          message.follower_count = reader.int64().toString();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Basket {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      base_value: isSet(object.baseValue)
+        ? globalThis.Number(object.baseValue)
+        : isSet(object.base_value)
+        ? globalThis.Number(object.base_value)
+        : 0,
+      components: globalThis.Array.isArray(object?.components)
+        ? object.components.map((e: any) => BasketComponent.fromJSON(e))
+        : [],
+      created_at: isSet(object.createdAt)
+        ? fromJsonTimestamp(object.createdAt)
+        : isSet(object.created_at)
+        ? fromJsonTimestamp(object.created_at)
+        : undefined,
+      owner: isSet(object.owner) ? globalThis.String(object.owner) : "",
+      rebalance: isSet(object.rebalance) ? globalThis.String(object.rebalance) : "",
+      rebalanced_at: isSet(object.rebalancedAt)
+        ? fromJsonTimestamp(object.rebalancedAt)
+        : isSet(object.rebalanced_at)
+        ? fromJsonTimestamp(object.rebalanced_at)
+        : undefined,
+      follower_count: isSet(object.followerCount)
+        ? globalThis.String(object.followerCount)
+        : isSet(object.follower_count)
+        ? globalThis.String(object.follower_count)
+        : "0",
+    };
+  },
+
+  toJSON(message: Basket): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.base_value !== 0) {
+      obj.baseValue = message.base_value;
+    }
+    if (message.components?.length) {
+      obj.components = message.components.map((e) => BasketComponent.toJSON(e));
+    }
+    if (message.created_at !== undefined) {
+      obj.createdAt = message.created_at.toISOString();
+    }
+    if (message.owner !== "") {
+      obj.owner = message.owner;
+    }
+    if (message.rebalance !== "") {
+      obj.rebalance = message.rebalance;
+    }
+    if (message.rebalanced_at !== undefined) {
+      obj.rebalancedAt = message.rebalanced_at.toISOString();
+    }
+    if (message.follower_count !== "0") {
+      obj.followerCount = message.follower_count;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Basket>, I>>(base?: I): Basket {
+    return Basket.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Basket>, I>>(object: I): Basket {
+    const message = createBaseBasket();
+    message.symbol = object.symbol ?? "";
+    message.name = object.name ?? "";
+    message.base_value = object.base_value ?? 0;
+    message.components = object.components?.map((e) => BasketComponent.fromPartial(e)) || [];
+    message.created_at = object.created_at ?? undefined;
+    message.owner = object.owner ?? "";
+    message.rebalance = object.rebalance ?? "";
+    message.rebalanced_at = object.rebalanced_at ?? undefined;
+    message.follower_count = object.follower_count ?? "0";
+    return message;
+  },
+};
+
+function createBaseCreateBasketRequest(): CreateBasketRequest {
+  return { symbol: "", name: "", components: [] };
+}
+
+export const CreateBasketRequest: MessageFns<CreateBasketRequest> = {
+  encode(message: CreateBasketRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    for (const v of message.components) {
+      BasketComponent.encode(v!, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateBasketRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateBasketRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.components.push(BasketComponent.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateBasketRequest {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      components: globalThis.Array.isArray(object?.components)
+        ? object.components.map((e: any) => BasketComponent.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: CreateBasketRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.components?.length) {
+      obj.components = message.components.map((e) => BasketComponent.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateBasketRequest>, I>>(base?: I): CreateBasketRequest {
+    return CreateBasketRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateBasketRequest>, I>>(object: I): CreateBasketRequest {
+    const message = createBaseCreateBasketRequest();
+    message.symbol = object.symbol ?? "";
+    message.name = object.name ?? "";
+    message.components = object.components?.map((e) => BasketComponent.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseCreateBasketResponse(): CreateBasketResponse {
+  return { basket: undefined };
+}
+
+export const CreateBasketResponse: MessageFns<CreateBasketResponse> = {
+  encode(message: CreateBasketResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.basket !== undefined) {
+      Basket.encode(message.basket, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateBasketResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateBasketResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.basket = Basket.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateBasketResponse {
+    return { basket: isSet(object.basket) ? Basket.fromJSON(object.basket) : undefined };
+  },
+
+  toJSON(message: CreateBasketResponse): unknown {
+    const obj: any = {};
+    if (message.basket !== undefined) {
+      obj.basket = Basket.toJSON(message.basket);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateBasketResponse>, I>>(base?: I): CreateBasketResponse {
+    return CreateBasketResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateBasketResponse>, I>>(object: I): CreateBasketResponse {
+    const message = createBaseCreateBasketResponse();
+    message.basket = (object.basket !== undefined && object.basket !== null)
+      ? Basket.fromPartial(object.basket)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseListBasketsRequest(): ListBasketsRequest {
+  return {};
+}
+
+export const ListBasketsRequest: MessageFns<ListBasketsRequest> = {
+  encode(_: ListBasketsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListBasketsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListBasketsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListBasketsRequest {
+    return {};
+  },
+
+  toJSON(_: ListBasketsRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListBasketsRequest>, I>>(base?: I): ListBasketsRequest {
+    return ListBasketsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListBasketsRequest>, I>>(_: I): ListBasketsRequest {
+    const message = createBaseListBasketsRequest();
+    return message;
+  },
+};
+
+function createBaseListBasketsResponse(): ListBasketsResponse {
+  return { baskets: [] };
+}
+
+export const ListBasketsResponse: MessageFns<ListBasketsResponse> = {
+  encode(message: ListBasketsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.baskets) {
+      Basket.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListBasketsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListBasketsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.baskets.push(Basket.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListBasketsResponse {
+    return {
+      baskets: globalThis.Array.isArray(object?.baskets) ? object.baskets.map((e: any) => Basket.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: ListBasketsResponse): unknown {
+    const obj: any = {};
+    if (message.baskets?.length) {
+      obj.baskets = message.baskets.map((e) => Basket.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListBasketsResponse>, I>>(base?: I): ListBasketsResponse {
+    return ListBasketsResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListBasketsResponse>, I>>(object: I): ListBasketsResponse {
+    const message = createBaseListBasketsResponse();
+    message.baskets = object.baskets?.map((e) => Basket.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDeleteBasketRequest(): DeleteBasketRequest {
+  return { symbol: "" };
+}
+
+export const DeleteBasketRequest: MessageFns<DeleteBasketRequest> = {
+  encode(message: DeleteBasketRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteBasketRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteBasketRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteBasketRequest {
+    return { symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "" };
+  },
+
+  toJSON(message: DeleteBasketRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeleteBasketRequest>, I>>(base?: I): DeleteBasketRequest {
+    return DeleteBasketRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeleteBasketRequest>, I>>(object: I): DeleteBasketRequest {
+    const message = createBaseDeleteBasketRequest();
+    message.symbol = object.symbol ?? "";
+    return message;
+  },
+};
+
+function createBaseDeleteBasketResponse(): DeleteBasketResponse {
+  return {};
+}
+
+export const DeleteBasketResponse: MessageFns<DeleteBasketResponse> = {
+  encode(_: DeleteBasketResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): DeleteBasketResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteBasketResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): DeleteBasketResponse {
+    return {};
+  },
+
+  toJSON(_: DeleteBasketResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<DeleteBasketResponse>, I>>(base?: I): DeleteBasketResponse {
+    return DeleteBasketResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<DeleteBasketResponse>, I>>(_: I): DeleteBasketResponse {
+    const message = createBaseDeleteBasketResponse();
+    return message;
+  },
+};
+
+function createBaseIndex(): Index {
+  return { basket: undefined, quote: undefined, return_percent: 0 };
+}
+
+export const Index: MessageFns<Index> = {
+  encode(message: Index, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.basket !== undefined) {
+      Basket.encode(message.basket, writer.uint32(10).fork()).join();
+    }
+    if (message.quote !== undefined) {
+      Quote.encode(message.quote, writer.uint32(18).fork()).join();
+    }
+    if (message.return_percent !== 0) {
+      writer.uint32(25).double(message.return_percent);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): Index {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIndex();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.basket = Basket.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.quote = Quote.decode(reader, reader.uint32());
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.return_percent = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Index {
+    return {
+      basket: isSet(object.basket) ? Basket.fromJSON(object.basket) : undefined,
+      quote: isSet(object.quote) ? Quote.fromJSON(object.quote) : undefined,
+      return_percent: isSet(object.returnPercent)
+        ? globalThis.Number(object.returnPercent)
+        : isSet(object.return_percent)
+        ? globalThis.Number(object.return_percent)
+        : 0,
+    };
+  },
+
+  toJSON(message: Index): unknown {
+    const obj: any = {};
+    if (message.basket !== undefined) {
+      obj.basket = Basket.toJSON(message.basket);
+    }
+    if (message.quote !== undefined) {
+      obj.quote = Quote.toJSON(message.quote);
+    }
+    if (message.return_percent !== 0) {
+      obj.returnPercent = message.return_percent;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Index>, I>>(base?: I): Index {
+    return Index.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<Index>, I>>(object: I): Index {
+    const message = createBaseIndex();
+    message.basket = (object.basket !== undefined && object.basket !== null)
+      ? Basket.fromPartial(object.basket)
+      : undefined;
+    message.quote = (object.quote !== undefined && object.quote !== null) ? Quote.fromPartial(object.quote) : undefined;
+    message.return_percent = object.return_percent ?? 0;
+    return message;
+  },
+};
+
+function createBaseCreateIndexRequest(): CreateIndexRequest {
+  return { symbol: "", name: "", components: [], rebalance: "" };
+}
+
+export const CreateIndexRequest: MessageFns<CreateIndexRequest> = {
+  encode(message: CreateIndexRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    for (const v of message.components) {
+      BasketComponent.encode(v!, writer.uint32(26).fork()).join();
+    }
+    if (message.rebalance !== "") {
+      writer.uint32(34).string(message.rebalance);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateIndexRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateIndexRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.components.push(BasketComponent.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.rebalance = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateIndexRequest {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      components: globalThis.Array.isArray(object?.components)
+        ? object.components.map((e: any) => BasketComponent.fromJSON(e))
+        : [],
+      rebalance: isSet(object.rebalance) ? globalThis.String(object.rebalance) : "",
+    };
+  },
+
+  toJSON(message: CreateIndexRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.components?.length) {
+      obj.components = message.components.map((e) => BasketComponent.toJSON(e));
+    }
+    if (message.rebalance !== "") {
+      obj.rebalance = message.rebalance;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateIndexRequest>, I>>(base?: I): CreateIndexRequest {
+    return CreateIndexRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateIndexRequest>, I>>(object: I): CreateIndexRequest {
+    const message = createBaseCreateIndexRequest();
+    message.symbol = object.symbol ?? "";
+    message.name = object.name ?? "";
+    message.components = object.components?.map((e) => BasketComponent.fromPartial(e)) || [];
+    message.rebalance = object.rebalance ?? "";
+    return message;
+  },
+};
+
+function createBaseCreateIndexResponse(): CreateIndexResponse {
+  return { basket: undefined };
+}
+
+export const CreateIndexResponse: MessageFns<CreateIndexResponse> = {
+  encode(message: CreateIndexResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.basket !== undefined) {
+      Basket.encode(message.basket, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateIndexResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateIndexResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.basket = Basket.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateIndexResponse {
+    return { basket: isSet(object.basket) ? Basket.fromJSON(object.basket) : undefined };
+  },
+
+  toJSON(message: CreateIndexResponse): unknown {
+    const obj: any = {};
+    if (message.basket !== undefined) {
+      obj.basket = Basket.toJSON(message.basket);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateIndexResponse>, I>>(base?: I): CreateIndexResponse {
+    return CreateIndexResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateIndexResponse>, I>>(object: I): CreateIndexResponse {
+    const message = createBaseCreateIndexResponse();
+    message.basket = (object.basket !== undefined && object.basket !== null)
+      ? Basket.fromPartial(object.basket)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseListIndicesRequest(): ListIndicesRequest {
+  return { limit: 0, offset: 0 };
+}
+
+export const ListIndicesRequest: MessageFns<ListIndicesRequest> = {
+  encode(message: ListIndicesRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.limit !== 0) {
+      writer.uint32(8).int32(message.limit);
+    }
+    if (message.offset !== 0) {
+      writer.uint32(16).int32(message.offset);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListIndicesRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListIndicesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.offset = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListIndicesRequest {
+    return {
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+      offset: isSet(object.offset) ? globalThis.Number(object.offset) : 0,
+    };
+  },
+
+  toJSON(message: ListIndicesRequest): unknown {
+    const obj: any = {};
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    if (message.offset !== 0) {
+      obj.offset = Math.round(message.offset);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListIndicesRequest>, I>>(base?: I): ListIndicesRequest {
+    return ListIndicesRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListIndicesRequest>, I>>(object: I): ListIndicesRequest {
+    const message = createBaseListIndicesRequest();
+    message.limit = object.limit ?? 0;
+    message.offset = object.offset ?? 0;
+    return message;
+  },
+};
+
+function createBaseListIndicesResponse(): ListIndicesResponse {
+  return { indices: [] };
+}
+
+export const ListIndicesResponse: MessageFns<ListIndicesResponse> = {
+  encode(message: ListIndicesResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.indices) {
+      Index.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListIndicesResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListIndicesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.indices.push(Index.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListIndicesResponse {
+    return {
+      indices: globalThis.Array.isArray(object?.indices) ? object.indices.map((e: any) => Index.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: ListIndicesResponse): unknown {
+    const obj: any = {};
+    if (message.indices?.length) {
+      obj.indices = message.indices.map((e) => Index.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListIndicesResponse>, I>>(base?: I): ListIndicesResponse {
+    return ListIndicesResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListIndicesResponse>, I>>(object: I): ListIndicesResponse {
+    const message = createBaseListIndicesResponse();
+    message.indices = object.indices?.map((e) => Index.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGetIndexRequest(): GetIndexRequest {
+  return { symbol: "", limit: 0 };
+}
+
+export const GetIndexRequest: MessageFns<GetIndexRequest> = {
+  encode(message: GetIndexRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(16).int32(message.limit);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetIndexRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetIndexRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 16) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetIndexRequest {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+    };
+  },
+
+  toJSON(message: GetIndexRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GetIndexRequest>, I>>(base?: I): GetIndexRequest {
+    return GetIndexRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GetIndexRequest>, I>>(object: I): GetIndexRequest {
+    const message = createBaseGetIndexRequest();
+    message.symbol = object.symbol ?? "";
+    message.limit = object.limit ?? 0;
+    return message;
+  },
+};
+
+function createBaseGetIndexResponse(): GetIndexResponse {
+  return { index: undefined, followers: [], history: [] };
+}
+
+export const GetIndexResponse: MessageFns<GetIndexResponse> = {
+  encode(message: GetIndexResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.index !== undefined) {
+      Index.encode(message.index, writer.uint32(10).fork()).join();
+    }
+    for (const v of message.followers) {
+      writer.uint32(18).string(v!);
+    }
+    for (const v of message.history) {
+      Quote.encode(v!, writer.uint32(26).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): GetIndexResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetIndexResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.index = Index.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.followers.push(reader.string());
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.history.push(Quote.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetIndexResponse {
+    return {
+      index: isSet(object.index) ? Index.fromJSON(object.index) : undefined,
+      followers: globalThis.Array.isArray(object?.followers)
+        ? object.followers.map((e: any) => globalThis.String(e))
+        : [],
+      history: globalThis.Array.isArray(object?.history) ? object.history.map((e: any) => Quote.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: GetIndexResponse): unknown {
+    const obj: any = {};
+    if (message.index !== undefined) {
+      obj.index = Index.toJSON(message.index);
+    }
+    if (message.followers?.length) {
+      obj.followers = message.followers;
+    }
+    if (message.history?.length) {
+      obj.history = message.history.map((e) => Quote.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GetIndexResponse>, I>>(base?: I): GetIndexResponse {
+    return GetIndexResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GetIndexResponse>, I>>(object: I): GetIndexResponse {
+    const message = createBaseGetIndexResponse();
+    message.index = (object.index !== undefined && object.index !== null) ? Index.fromPartial(object.index) : undefined;
+    message.followers = object.followers?.map((e) => e) || [];
+    message.history = object.history?.map((e) => Quote.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseFollowIndexRequest(): FollowIndexRequest {
+  return { symbol: "" };
+}
+
+export const FollowIndexRequest: MessageFns<FollowIndexRequest> = {
+  encode(message: FollowIndexRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FollowIndexRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFollowIndexRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): FollowIndexRequest {
+    return { symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "" };
+  },
+
+  toJSON(message: FollowIndexRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<FollowIndexRequest>, I>>(base?: I): FollowIndexRequest {
+    return FollowIndexRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<FollowIndexRequest>, I>>(object: I): FollowIndexRequest {
+    const message = createBaseFollowIndexRequest();
+    message.symbol = object.symbol ?? "";
+    return message;
+  },
+};
+
+function createBaseFollowIndexResponse(): FollowIndexResponse {
+  return {};
+}
+
+export const FollowIndexResponse: MessageFns<FollowIndexResponse> = {
+  encode(_: FollowIndexResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): FollowIndexResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFollowIndexResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): FollowIndexResponse {
+    return {};
+  },
+
+  toJSON(_: FollowIndexResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<FollowIndexResponse>, I>>(base?: I): FollowIndexResponse {
+    return FollowIndexResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<FollowIndexResponse>, I>>(_: I): FollowIndexResponse {
+    const message = createBaseFollowIndexResponse();
+    return message;
+  },
+};
+
+function createBaseUnfollowIndexRequest(): UnfollowIndexRequest {
+  return { symbol: "" };
+}
+
+export const UnfollowIndexRequest: MessageFns<UnfollowIndexRequest> = {
+  encode(message: UnfollowIndexRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UnfollowIndexRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUnfollowIndexRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UnfollowIndexRequest {
+    return { symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "" };
+  },
+
+  toJSON(message: UnfollowIndexRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<UnfollowIndexRequest>, I>>(base?: I): UnfollowIndexRequest {
+    return UnfollowIndexRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<UnfollowIndexRequest>, I>>(object: I): UnfollowIndexRequest {
+    const message = createBaseUnfollowIndexRequest();
+    message.symbol = object.symbol ?? "";
+    return message;
+  },
+};
+
+function createBaseUnfollowIndexResponse(): UnfollowIndexResponse {
+  return {};
+}
+
+export const UnfollowIndexResponse: MessageFns<UnfollowIndexResponse> = {
+  encode(_: UnfollowIndexResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): UnfollowIndexResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUnfollowIndexResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): UnfollowIndexResponse {
+    return {};
+  },
+
+  toJSON(_: UnfollowIndexResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<UnfollowIndexResponse>, I>>(base?: I): UnfollowIndexResponse {
+    return UnfollowIndexResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<UnfollowIndexResponse>, I>>(_: I): UnfollowIndexResponse {
+    const message = createBaseUnfollowIndexResponse();
+    return message;
+  },
+};
+
+function createBaseOptionContract(): OptionContract {
+  return {
+    symbol: "",
+    underlying: "",
+    type: "",
+    strike: 0,
+    expires_at: undefined,
+    volatility: 0,
+    premium: undefined,
+    settlement_price: 0,
+    settled_at: undefined,
+  };
+}
+
+export const OptionContract: MessageFns<OptionContract> = {
+  encode(message: OptionContract, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.underlying !== "") {
+      writer.uint32(18).string(message.underlying);
+    }
+    if (message.type !== "") {
+      writer.uint32(26).string(message.type);
+    }
+    if (message.strike !== 0) {
+      writer.uint32(33).double(message.strike);
+    }
+    if (message.expires_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.expires_at), writer.uint32(42).fork()).join();
+    }
+    if (message.volatility !== 0) {
+      writer.uint32(49).double(message.volatility);
+    }
+    if (message.premium !== undefined) {
+      Quote.encode(message.premium, writer.uint32(58).fork()).join();
+    }
+    if (message.settlement_price !== 0) {
+      writer.uint32(65).double(message.settlement_price);
+    }
+    if (message.settled_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.settled_at), writer.uint32(74).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): OptionContract {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOptionContract();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.underlying = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.strike = reader.double();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.expires_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 49) {
+            break;
+          }
+
+          message.volatility = reader.double();
+          continue;
+        }
+        case 7: {
+          if (tag !== 58) {
+            break;
+          }
+
+          message.premium = Quote.decode(reader, reader.uint32());
+          continue;
+        }
+        case 8: {
+          if (tag !== 65) {
+            break;
+          }
+
+          message.settlement_price = reader.double();
+          continue;
+        }
+        case 9: {
+          if (tag !== 74) {
+            break;
+          }
+
+          message.settled_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OptionContract {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      underlying: isSet(object.underlying) ? globalThis.String(object.underlying) : "",
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      strike: isSet(object.strike) ? globalThis.Number(object.strike) : 0,
+      expires_at: isSet(object.expiresAt)
+        ? fromJsonTimestamp(object.expiresAt)
+        : isSet(object.expires_at)
+        ? fromJsonTimestamp(object.expires_at)
+        : undefined,
+      volatility: isSet(object.volatility) ? globalThis.Number(object.volatility) : 0,
+      premium: isSet(object.premium) ? Quote.fromJSON(object.premium) : undefined,
+      settlement_price: isSet(object.settlementPrice)
+        ? globalThis.Number(object.settlementPrice)
+        : isSet(object.settlement_price)
+        ? globalThis.Number(object.settlement_price)
+        : 0,
+      settled_at: isSet(object.settledAt)
+        ? fromJsonTimestamp(object.settledAt)
+        : isSet(object.settled_at)
+        ? fromJsonTimestamp(object.settled_at)
+        : undefined,
+    };
+  },
+
+  toJSON(message: OptionContract): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.underlying !== "") {
+      obj.underlying = message.underlying;
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.strike !== 0) {
+      obj.strike = message.strike;
+    }
+    if (message.expires_at !== undefined) {
+      obj.expiresAt = message.expires_at.toISOString();
+    }
+    if (message.volatility !== 0) {
+      obj.volatility = message.volatility;
+    }
+    if (message.premium !== undefined) {
+      obj.premium = Quote.toJSON(message.premium);
+    }
+    if (message.settlement_price !== 0) {
+      obj.settlementPrice = message.settlement_price;
+    }
+    if (message.settled_at !== undefined) {
+      obj.settledAt = message.settled_at.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OptionContract>, I>>(base?: I): OptionContract {
+    return OptionContract.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<OptionContract>, I>>(object: I): OptionContract {
+    const message = createBaseOptionContract();
+    message.symbol = object.symbol ?? "";
+    message.underlying = object.underlying ?? "";
+    message.type = object.type ?? "";
+    message.strike = object.strike ?? 0;
+    message.expires_at = object.expires_at ?? undefined;
+    message.volatility = object.volatility ?? 0;
+    message.premium = (object.premium !== undefined && object.premium !== null)
+      ? Quote.fromPartial(object.premium)
+      : undefined;
+    message.settlement_price = object.settlement_price ?? 0;
+    message.settled_at = object.settled_at ?? undefined;
+    return message;
+  },
+};
+
+function createBaseCreateOptionContractRequest(): CreateOptionContractRequest {
+  return { underlying: "", type: "", strike: 0, expires_at: undefined, volatility: 0 };
+}
+
+export const CreateOptionContractRequest: MessageFns<CreateOptionContractRequest> = {
+  encode(message: CreateOptionContractRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.underlying !== "") {
+      writer.uint32(10).string(message.underlying);
+    }
+    if (message.type !== "") {
+      writer.uint32(18).string(message.type);
+    }
+    if (message.strike !== 0) {
+      writer.uint32(25).double(message.strike);
+    }
+    if (message.expires_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.expires_at), writer.uint32(34).fork()).join();
+    }
+    if (message.volatility !== 0) {
+      writer.uint32(41).double(message.volatility);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateOptionContractRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateOptionContractRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.underlying = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.strike = reader.double();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expires_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.volatility = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateOptionContractRequest {
+    return {
+      underlying: isSet(object.underlying) ? globalThis.String(object.underlying) : "",
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      strike: isSet(object.strike) ? globalThis.Number(object.strike) : 0,
+      expires_at: isSet(object.expiresAt)
+        ? fromJsonTimestamp(object.expiresAt)
+        : isSet(object.expires_at)
+        ? fromJsonTimestamp(object.expires_at)
+        : undefined,
+      volatility: isSet(object.volatility) ? globalThis.Number(object.volatility) : 0,
+    };
+  },
+
+  toJSON(message: CreateOptionContractRequest): unknown {
+    const obj: any = {};
+    if (message.underlying !== "") {
+      obj.underlying = message.underlying;
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.strike !== 0) {
+      obj.strike = message.strike;
+    }
+    if (message.expires_at !== undefined) {
+      obj.expiresAt = message.expires_at.toISOString();
+    }
+    if (message.volatility !== 0) {
+      obj.volatility = message.volatility;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateOptionContractRequest>, I>>(base?: I): CreateOptionContractRequest {
+    return CreateOptionContractRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateOptionContractRequest>, I>>(object: I): CreateOptionContractRequest {
+    const message = createBaseCreateOptionContractRequest();
+    message.underlying = object.underlying ?? "";
+    message.type = object.type ?? "";
+    message.strike = object.strike ?? 0;
+    message.expires_at = object.expires_at ?? undefined;
+    message.volatility = object.volatility ?? 0;
+    return message;
+  },
+};
+
+function createBaseCreateOptionContractResponse(): CreateOptionContractResponse {
+  return { contract: undefined };
+}
+
+export const CreateOptionContractResponse: MessageFns<CreateOptionContractResponse> = {
+  encode(message: CreateOptionContractResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.contract !== undefined) {
+      OptionContract.encode(message.contract, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreateOptionContractResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateOptionContractResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.contract = OptionContract.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateOptionContractResponse {
+    return { contract: isSet(object.contract) ? OptionContract.fromJSON(object.contract) : undefined };
+  },
+
+  toJSON(message: CreateOptionContractResponse): unknown {
+    const obj: any = {};
+    if (message.contract !== undefined) {
+      obj.contract = OptionContract.toJSON(message.contract);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreateOptionContractResponse>, I>>(base?: I): CreateOptionContractResponse {
+    return CreateOptionContractResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreateOptionContractResponse>, I>>(object: I): CreateOptionContractResponse {
+    const message = createBaseCreateOptionContractResponse();
+    message.contract = (object.contract !== undefined && object.contract !== null)
+      ? OptionContract.fromPartial(object.contract)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseListOptionContractsRequest(): ListOptionContractsRequest {
+  return {};
+}
+
+export const ListOptionContractsRequest: MessageFns<ListOptionContractsRequest> = {
+  encode(_: ListOptionContractsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListOptionContractsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListOptionContractsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListOptionContractsRequest {
+    return {};
+  },
+
+  toJSON(_: ListOptionContractsRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListOptionContractsRequest>, I>>(base?: I): ListOptionContractsRequest {
+    return ListOptionContractsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListOptionContractsRequest>, I>>(_: I): ListOptionContractsRequest {
+    const message = createBaseListOptionContractsRequest();
+    return message;
+  },
+};
+
+function createBaseListOptionContractsResponse(): ListOptionContractsResponse {
+  return { contracts: [] };
+}
+
+export const ListOptionContractsResponse: MessageFns<ListOptionContractsResponse> = {
+  encode(message: ListOptionContractsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.contracts) {
+      OptionContract.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListOptionContractsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListOptionContractsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.contracts.push(OptionContract.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListOptionContractsResponse {
+    return {
+      contracts: globalThis.Array.isArray(object?.contracts)
+        ? object.contracts.map((e: any) => OptionContract.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListOptionContractsResponse): unknown {
+    const obj: any = {};
+    if (message.contracts?.length) {
+      obj.contracts = message.contracts.map((e) => OptionContract.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListOptionContractsResponse>, I>>(base?: I): ListOptionContractsResponse {
+    return ListOptionContractsResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListOptionContractsResponse>, I>>(object: I): ListOptionContractsResponse {
+    const message = createBaseListOptionContractsResponse();
+    message.contracts = object.contracts?.map((e) => OptionContract.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBasePredictionMarket(): PredictionMarket {
+  return {
+    id: "0",
+    symbol: "",
+    question: "",
+    threshold: 0,
+    resolves_at: undefined,
+    liquidity: 0,
+    yes_price: 0,
+    no_price: 0,
+    yes_shares: 0,
+    no_shares: 0,
+    outcome: "",
+    resolution_price: 0,
+    resolved_at: undefined,
+  };
+}
+
+export const PredictionMarket: MessageFns<PredictionMarket> = {
+  encode(message: PredictionMarket, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.id !== "0") {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.symbol !== "") {
+      writer.uint32(18).string(message.symbol);
+    }
+    if (message.question !== "") {
+      writer.uint32(26).string(message.question);
+    }
+    if (message.threshold !== 0) {
+      writer.uint32(33).double(message.threshold);
+    }
+    if (message.resolves_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.resolves_at), writer.uint32(42).fork()).join();
+    }
+    if (message.liquidity !== 0) {
+      writer.uint32(49).double(message.liquidity);
+    }
+    if (message.yes_price !== 0) {
+      writer.uint32(57).double(message.yes_price);
+    }
+    if (message.no_price !== 0) {
+      writer.uint32(65).double(message.no_price);
+    }
+    if (message.yes_shares !== 0) {
+      writer.uint32(73).double(message.yes_shares);
+    }
+    if (message.no_shares !== 0) {
+      writer.uint32(81).double(message.no_shares);
+    }
+    if (message.outcome !== "") {
+      writer.uint32(90).string(message.outcome);
+    }
+    if (message.resolution_price !== 0) {
+      writer.uint32(97).double(message.resolution_price);
+    }
+    if (message.resolved_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.resolved_at), writer.uint32(106).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PredictionMarket {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePredictionMarket();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = reader.int64().toString();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.question = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.threshold = reader.double();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.resolves_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 6: {
+          if (tag !== 49) {
+            break;
+          }
+
+          message.liquidity = reader.double();
+          continue;
+        }
+        case 7: {
+          if (tag !== 57) {
+            break;
+          }
+
+          message.yes_price = reader.double();
+          continue;
+        }
+        case 8: {
+          if (tag !== 65) {
+            break;
+          }
+
+          message.no_price = reader.double();
+          continue;
+        }
+        case 9: {
+          if (tag !== 73) {
+            break;
+          }
+
+          message.yes_shares = reader.double();
+          continue;
+        }
+        case 10: {
+          if (tag !== 81) {
+            break;
+          }
+
+          message.no_shares = reader.double();
+          continue;
+        }
+        case 11: {
+          if (tag !== 90) {
+            break;
+          }
+
+          message.outcome = reader.string();
+          continue;
+        }
+        case 12: {
+          if (tag !== 97) {
+            break;
+          }
+
+          message.resolution_price = reader.double();
+          continue;
+        }
+        case 13: {
+          if (tag !== 106) {
+            break;
+          }
+
+          message.resolved_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PredictionMarket {
+    return {
+      id: isSet(object.id) ? globalThis.String(object.id) : "0",
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      question: isSet(object.question) ? globalThis.String(object.question) : "",
+      threshold: isSet(object.threshold) ? globalThis.Number(object.threshold) : 0,
+      resolves_at: isSet(object.resolvesAt)
+        ? fromJsonTimestamp(object.resolvesAt)
+        : isSet(object.resolves_at)
+        ? fromJsonTimestamp(object.resolves_at)
+        : undefined,
+      liquidity: isSet(object.liquidity) ? globalThis.Number(object.liquidity) : 0,
+      yes_price: isSet(object.yesPrice)
+        ? globalThis.Number(object.yesPrice)
+        : isSet(object.yes_price)
+        ? globalThis.Number(object.yes_price)
+        : 0,
+      no_price: isSet(object.noPrice)
+        ? globalThis.Number(object.noPrice)
+        : isSet(object.no_price)
+        ? globalThis.Number(object.no_price)
+        : 0,
+      yes_shares: isSet(object.yesShares)
+        ? globalThis.Number(object.yesShares)
+        : isSet(object.yes_shares)
+        ? globalThis.Number(object.yes_shares)
+        : 0,
+      no_shares: isSet(object.noShares)
+        ? globalThis.Number(object.noShares)
+        : isSet(object.no_shares)
+        ? globalThis.Number(object.no_shares)
+        : 0,
+      outcome: isSet(object.outcome) ? globalThis.String(object.outcome) : "",
+      resolution_price: isSet(object.resolutionPrice)
+        ? globalThis.Number(object.resolutionPrice)
+        : isSet(object.resolution_price)
+        ? globalThis.Number(object.resolution_price)
+        : 0,
+      resolved_at: isSet(object.resolvedAt)
+        ? fromJsonTimestamp(object.resolvedAt)
+        : isSet(object.resolved_at)
+        ? fromJsonTimestamp(object.resolved_at)
+        : undefined,
+    };
+  },
+
+  toJSON(message: PredictionMarket): unknown {
+    const obj: any = {};
+    if (message.id !== "0") {
+      obj.id = message.id;
+    }
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.question !== "") {
+      obj.question = message.question;
+    }
+    if (message.threshold !== 0) {
+      obj.threshold = message.threshold;
+    }
+    if (message.resolves_at !== undefined) {
+      obj.resolvesAt = message.resolves_at.toISOString();
+    }
+    if (message.liquidity !== 0) {
+      obj.liquidity = message.liquidity;
+    }
+    if (message.yes_price !== 0) {
+      obj.yesPrice = message.yes_price;
+    }
+    if (message.no_price !== 0) {
+      obj.noPrice = message.no_price;
+    }
+    if (message.yes_shares !== 0) {
+      obj.yesShares = message.yes_shares;
+    }
+    if (message.no_shares !== 0) {
+      obj.noShares = message.no_shares;
+    }
+    if (message.outcome !== "") {
+      obj.outcome = message.outcome;
+    }
+    if (message.resolution_price !== 0) {
+      obj.resolutionPrice = message.resolution_price;
+    }
+    if (message.resolved_at !== undefined) {
+      obj.resolvedAt = message.resolved_at.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PredictionMarket>, I>>(base?: I): PredictionMarket {
+    return PredictionMarket.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<PredictionMarket>, I>>(object: I): PredictionMarket {
+    const message = createBasePredictionMarket();
+    message.id = object.id ?? "0";
+    message.symbol = object.symbol ?? "";
+    message.question = object.question ?? "";
+    message.threshold = object.threshold ?? 0;
+    message.resolves_at = object.resolves_at ?? undefined;
+    message.liquidity = object.liquidity ?? 0;
+    message.yes_price = object.yes_price ?? 0;
+    message.no_price = object.no_price ?? 0;
+    message.yes_shares = object.yes_shares ?? 0;
+    message.no_shares = object.no_shares ?? 0;
+    message.outcome = object.outcome ?? "";
+    message.resolution_price = object.resolution_price ?? 0;
+    message.resolved_at = object.resolved_at ?? undefined;
+    return message;
+  },
+};
+
+function createBasePredictionPosition(): PredictionPosition {
+  return { market_id: "0", yes_shares: 0, no_shares: 0, staked: 0 };
+}
+
+export const PredictionPosition: MessageFns<PredictionPosition> = {
+  encode(message: PredictionPosition, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.market_id !== "0") {
+      writer.uint32(8).int64(message.market_id);
+    }
+    if (message.yes_shares !== 0) {
+      writer.uint32(17).double(message.yes_shares);
+    }
+    if (message.no_shares !== 0) {
+      writer.uint32(25).double(message.no_shares);
+    }
+    if (message.staked !== 0) {
+      writer.uint32(33).double(message.staked);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): PredictionPosition {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePredictionPosition();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.market_id = reader.int64().toString();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.yes_shares = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.no_shares = reader.double();
+          continue;
+        }
+        case 4: {
+          if (tag !== 33) {
+            break;
+          }
+
+          message.staked = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PredictionPosition {
+    return {
+      market_id: isSet(object.marketId)
+        ? globalThis.String(object.marketId)
+        : isSet(object.market_id)
+        ? globalThis.String(object.market_id)
+        : "0",
+      yes_shares: isSet(object.yesShares)
+        ? globalThis.Number(object.yesShares)
+        : isSet(object.yes_shares)
+        ? globalThis.Number(object.yes_shares)
+        : 0,
+      no_shares: isSet(object.noShares)
+        ? globalThis.Number(object.noShares)
+        : isSet(object.no_shares)
+        ? globalThis.Number(object.no_shares)
+        : 0,
+      staked: isSet(object.staked) ? globalThis.Number(object.staked) : 0,
+    };
+  },
+
+  toJSON(message: PredictionPosition): unknown {
+    const obj: any = {};
+    if (message.market_id !== "0") {
+      obj.marketId = message.market_id;
+    }
+    if (message.yes_shares !== 0) {
+      obj.yesShares = message.yes_shares;
+    }
+    if (message.no_shares !== 0) {
+      obj.noShares = message.no_shares;
+    }
+    if (message.staked !== 0) {
+      obj.staked = message.staked;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PredictionPosition>, I>>(base?: I): PredictionPosition {
+    return PredictionPosition.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<PredictionPosition>, I>>(object: I): PredictionPosition {
+    const message = createBasePredictionPosition();
+    message.market_id = object.market_id ?? "0";
+    message.yes_shares = object.yes_shares ?? 0;
+    message.no_shares = object.no_shares ?? 0;
+    message.staked = object.staked ?? 0;
+    return message;
+  },
+};
+
+function createBaseCreatePredictionMarketRequest(): CreatePredictionMarketRequest {
+  return { symbol: "", threshold: 0, resolves_at: undefined, question: "", liquidity: 0 };
+}
+
+export const CreatePredictionMarketRequest: MessageFns<CreatePredictionMarketRequest> = {
+  encode(message: CreatePredictionMarketRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.symbol !== "") {
+      writer.uint32(10).string(message.symbol);
+    }
+    if (message.threshold !== 0) {
+      writer.uint32(17).double(message.threshold);
+    }
+    if (message.resolves_at !== undefined) {
+      Timestamp.encode(toTimestamp(message.resolves_at), writer.uint32(26).fork()).join();
+    }
+    if (message.question !== "") {
+      writer.uint32(34).string(message.question);
+    }
+    if (message.liquidity !== 0) {
+      writer.uint32(41).double(message.liquidity);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreatePredictionMarketRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreatePredictionMarketRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.symbol = reader.string();
+          continue;
+        }
+        case 2: {
+          if (tag !== 17) {
+            break;
+          }
+
+          message.threshold = reader.double();
+          continue;
+        }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.resolves_at = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.question = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 41) {
+            break;
+          }
+
+          message.liquidity = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreatePredictionMarketRequest {
+    return {
+      symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
+      threshold: isSet(object.threshold) ? globalThis.Number(object.threshold) : 0,
+      resolves_at: isSet(object.resolvesAt)
+        ? fromJsonTimestamp(object.resolvesAt)
+        : isSet(object.resolves_at)
+        ? fromJsonTimestamp(object.resolves_at)
+        : undefined,
+      question: isSet(object.question) ? globalThis.String(object.question) : "",
+      liquidity: isSet(object.liquidity) ? globalThis.Number(object.liquidity) : 0,
+    };
+  },
+
+  toJSON(message: CreatePredictionMarketRequest): unknown {
+    const obj: any = {};
+    if (message.symbol !== "") {
+      obj.symbol = message.symbol;
+    }
+    if (message.threshold !== 0) {
+      obj.threshold = message.threshold;
+    }
+    if (message.resolves_at !== undefined) {
+      obj.resolvesAt = message.resolves_at.toISOString();
+    }
+    if (message.question !== "") {
+      obj.question = message.question;
+    }
+    if (message.liquidity !== 0) {
+      obj.liquidity = message.liquidity;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreatePredictionMarketRequest>, I>>(base?: I): CreatePredictionMarketRequest {
+    return CreatePredictionMarketRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreatePredictionMarketRequest>, I>>(
+    object: I,
+  ): CreatePredictionMarketRequest {
+    const message = createBaseCreatePredictionMarketRequest();
+    message.symbol = object.symbol ?? "";
+    message.threshold = object.threshold ?? 0;
+    message.resolves_at = object.resolves_at ?? undefined;
+    message.question = object.question ?? "";
+    message.liquidity = object.liquidity ?? 0;
+    return message;
+  },
+};
+
+function createBaseCreatePredictionMarketResponse(): CreatePredictionMarketResponse {
+  return { market: undefined };
+}
+
+export const CreatePredictionMarketResponse: MessageFns<CreatePredictionMarketResponse> = {
+  encode(message: CreatePredictionMarketResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.market !== undefined) {
+      PredictionMarket.encode(message.market, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): CreatePredictionMarketResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreatePredictionMarketResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.market = PredictionMarket.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreatePredictionMarketResponse {
+    return { market: isSet(object.market) ? PredictionMarket.fromJSON(object.market) : undefined };
+  },
+
+  toJSON(message: CreatePredictionMarketResponse): unknown {
+    const obj: any = {};
+    if (message.market !== undefined) {
+      obj.market = PredictionMarket.toJSON(message.market);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CreatePredictionMarketResponse>, I>>(base?: I): CreatePredictionMarketResponse {
+    return CreatePredictionMarketResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<CreatePredictionMarketResponse>, I>>(
+    object: I,
+  ): CreatePredictionMarketResponse {
+    const message = createBaseCreatePredictionMarketResponse();
+    message.market = (object.market !== undefined && object.market !== null)
+      ? PredictionMarket.fromPartial(object.market)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseListPredictionMarketsRequest(): ListPredictionMarketsRequest {
+  return {};
+}
+
+export const ListPredictionMarketsRequest: MessageFns<ListPredictionMarketsRequest> = {
+  encode(_: ListPredictionMarketsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListPredictionMarketsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPredictionMarketsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListPredictionMarketsRequest {
+    return {};
+  },
+
+  toJSON(_: ListPredictionMarketsRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListPredictionMarketsRequest>, I>>(base?: I): ListPredictionMarketsRequest {
+    return ListPredictionMarketsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListPredictionMarketsRequest>, I>>(_: I): ListPredictionMarketsRequest {
+    const message = createBaseListPredictionMarketsRequest();
+    return message;
+  },
+};
+
+function createBaseListPredictionMarketsResponse(): ListPredictionMarketsResponse {
+  return { markets: [] };
+}
+
+export const ListPredictionMarketsResponse: MessageFns<ListPredictionMarketsResponse> = {
+  encode(message: ListPredictionMarketsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.markets) {
+      PredictionMarket.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListPredictionMarketsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPredictionMarketsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.markets.push(PredictionMarket.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListPredictionMarketsResponse {
+    return {
+      markets: globalThis.Array.isArray(object?.markets)
+        ? object.markets.map((e: any) => PredictionMarket.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListPredictionMarketsResponse): unknown {
+    const obj: any = {};
+    if (message.markets?.length) {
+      obj.markets = message.markets.map((e) => PredictionMarket.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListPredictionMarketsResponse>, I>>(base?: I): ListPredictionMarketsResponse {
+    return ListPredictionMarketsResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListPredictionMarketsResponse>, I>>(
+    object: I,
+  ): ListPredictionMarketsResponse {
+    const message = createBaseListPredictionMarketsResponse();
+    message.markets = object.markets?.map((e) => PredictionMarket.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseStakePredictionRequest(): StakePredictionRequest {
+  return { market_id: "0", outcome: "", amount: 0 };
+}
+
+export const StakePredictionRequest: MessageFns<StakePredictionRequest> = {
+  encode(message: StakePredictionRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.market_id !== "0") {
+      writer.uint32(8).int64(message.market_id);
+    }
+    if (message.outcome !== "") {
+      writer.uint32(18).string(message.outcome);
+    }
+    if (message.amount !== 0) {
+      writer.uint32(25).double(message.amount);
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): StakePredictionRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStakePredictionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 8) {
+            break;
+          }
+
+          message.market_id = reader.int64().toString();
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.outcome = reader.string();
+          continue;
+        }
+        case 3: {
+          if (tag !== 25) {
+            break;
+          }
+
+          message.amount = reader.double();
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StakePredictionRequest {
+    return {
+      market_id: isSet(object.marketId)
+        ? globalThis.String(object.marketId)
+        : isSet(object.market_id)
+        ? globalThis.String(object.market_id)
+        : "0",
+      outcome: isSet(object.outcome) ? globalThis.String(object.outcome) : "",
+      amount: isSet(object.amount) ? globalThis.Number(object.amount) : 0,
+    };
+  },
+
+  toJSON(message: StakePredictionRequest): unknown {
+    const obj: any = {};
+    if (message.market_id !== "0") {
+      obj.marketId = message.market_id;
+    }
+    if (message.outcome !== "") {
+      obj.outcome = message.outcome;
+    }
+    if (message.amount !== 0) {
+      obj.amount = message.amount;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StakePredictionRequest>, I>>(base?: I): StakePredictionRequest {
+    return StakePredictionRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<StakePredictionRequest>, I>>(object: I): StakePredictionRequest {
+    const message = createBaseStakePredictionRequest();
+    message.market_id = object.market_id ?? "0";
+    message.outcome = object.outcome ?? "";
+    message.amount = object.amount ?? 0;
+    return message;
+  },
+};
+
+function createBaseStakePredictionResponse(): StakePredictionResponse {
+  return { market: undefined, position: undefined };
+}
+
+export const StakePredictionResponse: MessageFns<StakePredictionResponse> = {
+  encode(message: StakePredictionResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    if (message.market !== undefined) {
+      PredictionMarket.encode(message.market, writer.uint32(10).fork()).join();
+    }
+    if (message.position !== undefined) {
+      PredictionPosition.encode(message.position, writer.uint32(18).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): StakePredictionResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStakePredictionResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.market = PredictionMarket.decode(reader, reader.uint32());
+          continue;
+        }
+        case 2: {
+          if (tag !== 18) {
+            break;
+          }
+
+          message.position = PredictionPosition.decode(reader, reader.uint32());
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StakePredictionResponse {
+    return {
+      market: isSet(object.market) ? PredictionMarket.fromJSON(object.market) : undefined,
+      position: isSet(object.position) ? PredictionPosition.fromJSON(object.position) : undefined,
+    };
+  },
+
+  toJSON(message: StakePredictionResponse): unknown {
+    const obj: any = {};
+    if (message.market !== undefined) {
+      obj.market = PredictionMarket.toJSON(message.market);
+    }
+    if (message.position !== undefined) {
+      obj.position = PredictionPosition.toJSON(message.position);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StakePredictionResponse>, I>>(base?: I): StakePredictionResponse {
+    return StakePredictionResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<StakePredictionResponse>, I>>(object: I): StakePredictionResponse {
+    const message = createBaseStakePredictionResponse();
+    message.market = (object.market !== undefined && object.market !== null)
+      ? PredictionMarket.fromPartial(object.market)
+      : undefined;
+    message.position = (object.position !== undefined && object.position !== null)
+      ? PredictionPosition.fromPartial(object.position)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseListPredictionPositionsRequest(): ListPredictionPositionsRequest {
+  return {};
+}
+
+export const ListPredictionPositionsRequest: MessageFns<ListPredictionPositionsRequest> = {
+  encode(_: ListPredictionPositionsRequest, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListPredictionPositionsRequest {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPredictionPositionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListPredictionPositionsRequest {
+    return {};
+  },
+
+  toJSON(_: ListPredictionPositionsRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListPredictionPositionsRequest>, I>>(base?: I): ListPredictionPositionsRequest {
+    return ListPredictionPositionsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListPredictionPositionsRequest>, I>>(_: I): ListPredictionPositionsRequest {
+    const message = createBaseListPredictionPositionsRequest();
+    return message;
+  },
+};
+
+function createBaseListPredictionPositionsResponse(): ListPredictionPositionsResponse {
+  return { positions: [] };
+}
+
+export const ListPredictionPositionsResponse: MessageFns<ListPredictionPositionsResponse> = {
+  encode(message: ListPredictionPositionsResponse, writer: BinaryWriter = new BinaryWriter()): BinaryWriter {
+    for (const v of message.positions) {
+      PredictionPosition.encode(v!, writer.uint32(10).fork()).join();
+    }
+    return writer;
+  },
+
+  decode(input: BinaryReader | Uint8Array, length?: number): ListPredictionPositionsResponse {
+    const reader = input instanceof BinaryReader ? input : new BinaryReader(input);
+    const end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPredictionPositionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1: {
+          if (tag !== 10) {
+            break;
+          }
+
+          message.positions.push(PredictionPosition.decode(reader, reader.uint32()));
+          continue;
+        }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skip(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListPredictionPositionsResponse {
+    return {
+      positions: globalThis.Array.isArray(object?.positions)
+        ? object.positions.map((e: any) => PredictionPosition.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListPredictionPositionsResponse): unknown {
+    const obj: any = {};
+    if (message.positions?.length) {
+      obj.positions = message.positions.map((e) => PredictionPosition.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ListPredictionPositionsResponse>, I>>(base?: I): ListPredictionPositionsResponse {
+    return ListPredictionPositionsResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<ListPredictionPositionsResponse>, I>>(
+    object: I,
+  ): ListPredictionPositionsResponse {
+    const message = createBaseListPredictionPositionsResponse();
+    message.positions = object.positions?.map((e) => PredictionPosition.fromPartial(e)) || [];
     return message;
   },
 };
@@ -37,14 +37,30 @@ export interface Ladder {
   initial_balance: number;
   /** List of stock tickers allowed in this competition. */
   allowed_tickers: TickerInfo[];
+  /** Currency balances and net worth are kept in, e.g. "USD". */
+  base_currency: string;
 }
 
 /** Configuration of an allowed stock in the ladder. */
 export interface TickerInfo {
-  /** Stock ticker symbol. */
+  /** Instrument ID, e.g. FH:AAPL. */
   symbol: string;
   /** Source provider for market data. */
   source: string;
+  /** Display name from the instrument catalogue; empty if uncatalogued. */
+  name: string;
+  /** Asset class, e.g. "stock", "crypto" or "forex". */
+  asset_class: string;
+  /** Currency prices are quoted in. */
+  currency: string;
+  /** Exchange or venue the instrument trades on. */
+  exchange: string;
+  /** Smallest price increment; 0 if uncatalogued. */
+  tick_size: number;
+  /** Pip size of currency pairs, e.g. 0.0001; 0 for other asset classes. */
+  pip_size: number;
+  /** Base currency value of one unit of the ticker's currency; 0 if no rate is available. */
+  fx_rate: number;
 }
 
 /** User standing and status in a ladder. */
@@ -90,6 +106,7 @@ function createBaseLadder(): Ladder {
     created_at: undefined,
     initial_balance: 0,
     allowed_tickers: [],
+    base_currency: "",
   };
 }
 
@@ -122,6 +139,9 @@ export const Ladder: MessageFns<Ladder> = {
     for (const v of message.allowed_tickers) {
       TickerInfo.encode(v!, writer.uint32(74).fork()).join();
     }
+    if (message.base_currency !== "") {
+      writer.uint32(82).string(message.base_currency);
+    }
     return writer;
   },
 
@@ -204,6 +224,14 @@ export const Ladder: MessageFns<Ladder> = {
           message.allowed_tickers.push(TickerInfo.decode(reader, reader.uint32()));
           continue;
         }
+        case 10: {
+          if (tag !== 82) {
+            break;
+          }
+
+          message.base_currency = reader.string();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -248,6 +276,11 @@ export const Ladder: MessageFns<Ladder> = {
         : globalThis.Array.isArray(object?.allowed_tickers)
         ? object.allowed_tickers.map((e: any) => TickerInfo.fromJSON(e))
         : [],
+      base_currency: isSet(object.baseCurrency)
+        ? globalThis.String(object.baseCurrency)
+        : isSet(object.base_currency)
+        ? globalThis.String(object.base_currency)
+        : "",
     };
   },
 
@@ -280,6 +313,9 @@ export const Ladder: MessageFns<Ladder> = {
     if (message.allowed_tickers?.length) {
       obj.allowedTickers = message.allowed_tickers.map((e) => TickerInfo.toJSON(e));
     }
+    if (message.base_currency !== "") {
+      obj.baseCurrency = message.base_currency;
+    }
     return obj;
   },
 
@@ -297,12 +333,23 @@ export const Ladder: MessageFns<Ladder> = {
     message.created_at = object.created_at ?? undefined;
     message.initial_balance = object.initial_balance ?? 0;
     message.allowed_tickers = object.allowed_tickers?.map((e) => TickerInfo.fromPartial(e)) || [];
+    message.base_currency = object.base_currency ?? "";
     return message;
   },
 };
 
 function createBaseTickerInfo(): TickerInfo {
-  return { symbol: "", source: "" };
+  return {
+    symbol: "",
+    source: "",
+    name: "",
+    asset_class: "",
+    currency: "",
+    exchange: "",
+    tick_size: 0,
+    pip_size: 0,
+    fx_rate: 0,
+  };
 }
 
 export const TickerInfo: MessageFns<TickerInfo> = {
@@ -313,6 +360,27 @@ export const TickerInfo: MessageFns<TickerInfo> = {
     if (message.source !== "") {
       writer.uint32(18).string(message.source);
     }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.asset_class !== "") {
+      writer.uint32(34).string(message.asset_class);
+    }
+    if (message.currency !== "") {
+      writer.uint32(42).string(message.currency);
+    }
+    if (message.exchange !== "") {
+      writer.uint32(50).string(message.exchange);
+    }
+    if (message.tick_size !== 0) {
+      writer.uint32(57).double(message.tick_size);
+    }
+    if (message.pip_size !== 0) {
+      writer.uint32(65).double(message.pip_size);
+    }
+    if (message.fx_rate !== 0) {
+      writer.uint32(73).double(message.fx_rate);
+    }
     return writer;
   },
 
@@ -339,6 +407,62 @@ export const TickerInfo: MessageFns<TickerInfo> = {
           message.source = reader.string();
           continue;
         }
+        case 3: {
+          if (tag !== 26) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        }
+        case 4: {
+          if (tag !== 34) {
+            break;
+          }
+
+          message.asset_class = reader.string();
+          continue;
+        }
+        case 5: {
+          if (tag !== 42) {
+            break;
+          }
+
+          message.currency = reader.string();
+          continue;
+        }
+        case 6: {
+          if (tag !== 50) {
+            break;
+          }
+
+          message.exchange = reader.string();
+          continue;
+        }
+        case 7: {
+          if (tag !== 57) {
+            break;
+          }
+
+          message.tick_size = reader.double();
+          continue;
+        }
+        case 8: {
+          if (tag !== 65) {
+            break;
+          }
+
+          message.pip_size = reader.double();
+          continue;
+        }
+        case 9: {
+          if (tag !== 73) {
+            break;
+          }
+
+          message.fx_rate = reader.double();
+          continue;
+        }
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -352,6 +476,29 @@ export const TickerInfo: MessageFns<TickerInfo> = {
     return {
       symbol: isSet(object.symbol) ? globalThis.String(object.symbol) : "",
       source: isSet(object.source) ? globalThis.String(object.source) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      asset_class: isSet(object.assetClass)
+        ? globalThis.String(object.assetClass)
+        : isSet(object.asset_class)
+        ? globalThis.String(object.asset_class)
+        : "",
+      currency: isSet(object.currency) ? globalThis.String(object.currency) : "",
+      exchange: isSet(object.exchange) ? globalThis.String(object.exchange) : "",
+      tick_size: isSet(object.tickSize)
+        ? globalThis.Number(object.tickSize)
+        : isSet(object.tick_size)
+        ? globalThis.Number(object.tick_size)
+        : 0,
+      pip_size: isSet(object.pipSize)
+        ? globalThis.Number(object.pipSize)
+        : isSet(object.pip_size)
+        ? globalThis.Number(object.pip_size)
+        : 0,
+      fx_rate: isSet(object.fxRate)
+        ? globalThis.Number(object.fxRate)
+        : isSet(object.fx_rate)
+        ? globalThis.Number(object.fx_rate)
+        : 0,
     };
   },
 
@@ -363,6 +510,27 @@ export const TickerInfo: MessageFns<TickerInfo> = {
     if (message.source !== "") {
       obj.source = message.source;
     }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.asset_class !== "") {
+      obj.assetClass = message.asset_class;
+    }
+    if (message.currency !== "") {
+      obj.currency = message.currency;
+    }
+    if (message.exchange !== "") {
+      obj.exchange = message.exchange;
+    }
+    if (message.tick_size !== 0) {
+      obj.tickSize = message.tick_size;
+    }
+    if (message.pip_size !== 0) {
+      obj.pipSize = message.pip_size;
+    }
+    if (message.fx_rate !== 0) {
+      obj.fxRate = message.fx_rate;
+    }
     return obj;
   },
 
@@ -373,6 +541,13 @@ export const TickerInfo: MessageFns<TickerInfo> = {
     const message = createBaseTickerInfo();
     message.symbol = object.symbol ?? "";
     message.source = object.source ?? "";
+    message.name = object.name ?? "";
+    message.asset_class = object.asset_class ?? "";
+    message.currency = object.currency ?? "";
+    message.exchange = object.exchange ?? "";
+    message.tick_size = object.tick_size ?? 0;
+    message.pip_size = object.pip_size ?? 0;
+    message.fx_rate = object.fx_rate ?? 0;
     return message;
   },
 };
@@ -69,7 +69,7 @@ export interface PublicProfile_PortfolioEntry {
 
 /** PortfolioItem represents a single stock holding in a user's portfolio. */
 export interface PortfolioItem {
-  /** The stock ticker symbol. */
+  /** The instrument ID, e.g. FH:AAPL. */
   stock_symbol: string;
   /** The quantity of shares owned. */
   quantity: number;
@@ -1,5 +1,6 @@
 import { usePriceColor } from '@/hooks/usePriceColor';
 import { type Quote } from '@/types';
+import { formatQuotePrice } from '@/lib/utils';
 import styles from './MarketChart.module.css';
 
 interface ChartSymbolIndicatorProps {
@@ -22,7 +23,7 @@ export const ChartSymbolIndicator = ({ quote, isLoading, isError }: ChartSymbolI
       ) : (
         <>
           <span className={styles.price} data-trend={priceColorStatus}>
-            {price ? `$${price.toFixed(2)}` : '—'}
+            {quote && price ? formatQuotePrice(quote) : '—'}
           </span>
           {isClosed ? (
             <span className={styles.statusLabel} data-status="closed">
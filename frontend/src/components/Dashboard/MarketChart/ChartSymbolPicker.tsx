@@ -1,6 +1,7 @@
 import { IconChevronDown } from '@/components/icons/CustomIcons';
 import { SourceBadge } from '@/components/shared/SourceBadge';
 import { useTickers } from '@/hooks/useTickers';
+import { displaySymbol } from '@/lib/utils';
 import { isTradeSymbol, type TickerSource, type TradeSymbol } from '@/types';
 import styles from './MarketChart.module.css';
 
@@ -41,7 +42,7 @@ export const ChartSymbolPicker = ({ symbol, onSymbolChange }: ChartSymbolPickerP
           ) : (
             tickers.map((t) => (
               <option key={t.symbol} value={t.symbol} className={styles.option}>
-                {t.symbol.toUpperCase()}
+                {displaySymbol(t.symbol).toUpperCase()}
               </option>
             ))
           )}
@@ -1,6 +1,7 @@
 import { SourceBadge } from '@/components/shared/SourceBadge';
 import { Input } from '@/components/shared/Input';
 import { Label } from '@/components/shared/Label';
+import { displaySymbol } from '@/lib/utils';
 import type { TickerSource } from '@/types';
 import styles from './TradePanel.module.css';
 import { useId } from 'react';
@@ -25,7 +26,7 @@ export const SymbolField = ({ symbol, source }: SymbolFieldProps) => {
         <Input
           id={inputId}
           type="text"
-          value={symbol}
+          value={displaySymbol(symbol)}
           disabled
           variant="unstyled"
           size="unstyled"
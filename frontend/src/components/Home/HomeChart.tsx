@@ -4,6 +4,7 @@ import { ColorType, CrosshairMode, type ChartOptions, type DeepPartial } from 'l
 import { useRef, useMemo } from 'react';
 import type { TradeSymbol } from '@/types';
 import { getHistory } from '@/lib/api';
+import { displaySymbol } from '@/lib/utils';
 import { queryKeys } from '@/lib/queryKeys';
 import { queryConfig } from '@/lib/queryConfig';
 import styles from './HomeChart.module.css';
@@ -70,7 +71,7 @@ export const HomeChart = ({ symbol }: { symbol: TradeSymbol }) => {
       <div className={styles.chartLegend}>
         <div className={styles.titleRow}>
           <h2 id="chart-heading" className={styles.title}>
-            {symbol.toUpperCase()}
+            {displaySymbol(symbol).toUpperCase()}
           </h2>
         </div>
 
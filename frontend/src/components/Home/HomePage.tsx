@@ -58,7 +58,7 @@ export const HomePage = () => {
 
         <div className={styles.chartWrapper}>
           <div className={styles.chartCard}>
-            <HomeChart symbol="bitcoin" />
+            <HomeChart symbol="CG:bitcoin" />
           </div>
         </div>
       </section>
@@ -1,4 +1,5 @@
 import { SourceBadge } from '@/components/shared/SourceBadge';
+import { displaySymbol } from '@/lib/utils';
 import type { TickerInfo, TickerSource } from '@/types';
 import styles from './LadderAssets.module.css';
 import { useId } from 'react';
@@ -22,7 +23,7 @@ export const LadderAssets = ({ assets }: LadderAssetsProps) => {
       <div className={styles.assetsGrid}>
         {assets.map((t) => (
           <div key={t.symbol} className={styles.assetItem}>
-            <span className={styles.assetSymbol}>{t.symbol.toUpperCase()}</span>
+            <span className={styles.assetSymbol}>{displaySymbol(t.symbol).toUpperCase()}</span>
             <SourceBadge source={t.source as TickerSource} className={styles.assetSourceBadge} />
           </div>
         ))}
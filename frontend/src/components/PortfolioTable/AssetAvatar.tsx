@@ -1,4 +1,5 @@
 import { BaseAvatar } from '@/components/shared/BaseAvatar';
+import { displaySymbol } from '@/lib/utils';
 
 interface AssetAvatarProps {
   symbol: string;
@@ -6,14 +7,15 @@ interface AssetAvatarProps {
 }
 
 export const AssetAvatar = ({ symbol, className }: AssetAvatarProps) => {
-  const initials = symbol?.[0]?.toUpperCase() ?? '?';
+  const name = symbol ? displaySymbol(symbol) : symbol;
+  const initials = name?.[0]?.toUpperCase() ?? '?';
 
   return (
     <BaseAvatar
       initials={initials}
       className={className}
-      label={`${symbol} token`}
-      aria-label={`Asset avatar for ${symbol}`}
+      label={`${name} token`}
+      aria-label={`Asset avatar for ${name}`}
     />
   );
 };
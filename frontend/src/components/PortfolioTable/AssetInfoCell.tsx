@@ -1,4 +1,5 @@
 import { SourceBadge } from '@/components/shared/SourceBadge';
+import { displaySymbol } from '@/lib/utils';
 import type { TickerSource } from '@/types';
 import styles from './PortfolioTable.module.css';
 import { AssetAvatar } from './AssetAvatar';
@@ -14,7 +15,7 @@ export const AssetInfoCell = ({ symbol, source, isTradable = true }: AssetInfoCe
     <div className={styles.assetInfoWrapper}>
       <AssetAvatar symbol={symbol} className={styles.assetAvatar} />
       <div className={styles.assetMeta}>
-        <span className={styles.assetName}>{symbol}</span>
+        <span className={styles.assetName}>{displaySymbol(symbol)}</span>
         <div className={styles.sourceBadges}>
           <SourceBadge source={source} />
           {!isTradable && (
@@ -3,6 +3,7 @@ import { AssetInfoCell } from '@/components/PortfolioTable/AssetInfoCell';
 import { RowActionsCell } from '@/components/PortfolioTable/RowActionsCell';
 import { AssetFininfoCell } from '@/components/PortfolioTable/AssetFininfoCell';
 import { usePortfolioRowState } from '@/hooks/usePortfolioRowState';
+import { formatQuotePrice } from '@/lib/utils';
 import styles from './PortfolioTable.module.css';
 
 interface PortfolioRowProps {
@@ -32,7 +33,7 @@ export const PortfolioRow = ({
       <AssetFininfoCell variant="muted">${item.average_price.toFixed(2)}</AssetFininfoCell>
 
       <AssetFininfoCell variant="medium">
-        {quote ? `$${quote.price.toFixed(2)}` : loadingIndicator}
+        {quote ? formatQuotePrice(quote) : loadingIndicator}
       </AssetFininfoCell>
 
       <AssetFininfoCell variant="bold">{marketValue ?? loadingIndicator}</AssetFininfoCell>
@@ -1,4 +1,5 @@
 import { usePortfolioValue } from '@/hooks/usePortfolioValue';
+import { useTickers } from '@/hooks/useTickers';
 import { INITIAL_BALANCE } from '@/lib/constants';
 import { calculateInvestedCapital } from '@/lib/utils';
 import { type PortfolioItem } from '@/types';
@@ -12,7 +13,8 @@ interface StatsGridProps {
 
 export const StatsGrid = ({ balance, portfolio = {} }: StatsGridProps) => {
   const portfolioItems = Object.values(portfolio);
-  const investedCapital = calculateInvestedCapital(portfolio);
+  const { data: tickers } = useTickers();
+  const investedCapital = calculateInvestedCapital(portfolio, tickers);
 
   const { totalValue: currentPortfolioValue, isLoading, isError } = usePortfolioValue(portfolio);
 
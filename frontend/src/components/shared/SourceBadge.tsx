@@ -9,6 +9,9 @@ const badgeVariants = cva(styles.badge, {
     variant: {
       CoinGecko: styles.variantCoinGecko,
       Finnhub: styles.variantFinnhub,
+      OANDA: styles.variantOanda,
+      Basket: styles.variantBasket,
+      Options: styles.variantOptions,
     },
   },
 });
@@ -1,8 +1,12 @@
 import { renderHook } from '@testing-library/react';
-import { describe, it, expect } from 'vitest';
+import { describe, it, expect, vi } from 'vitest';
 import { useDashboardStats } from './useDashboardStats';
 import { mockUserParticipating } from '@/test/mocks';
 
+vi.mock('@/hooks/useTickers', () => ({
+  useTickers: vi.fn(() => ({ data: undefined, isLoading: false, error: null })),
+}));
+
 describe('useDashboardStats', () => {
   it('returns placeholder values when user is null', () => {
     const { result } = renderHook(() => useDashboardStats(null));
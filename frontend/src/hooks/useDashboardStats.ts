@@ -1,13 +1,16 @@
 import { useMemo } from 'react';
+import { useTickers } from '@/hooks/useTickers';
 import { calculateInvestedCapital } from '@/lib/utils';
 import { IconBriefcase, IconDollarSign, IconWallet } from '@icons/CustomIcons';
 import type { User } from '@/types';
 
 export const useDashboardStats = (user: User | null) => {
+  const { data: tickers } = useTickers();
+
   return useMemo(() => {
     const portfolio = user?.portfolio ?? {};
     const portfolioCount = Object.keys(portfolio).length;
-    const investedCapital = calculateInvestedCapital(portfolio);
+    const investedCapital = calculateInvestedCapital(portfolio, tickers);
 
     return [
       {
@@ -26,5 +29,5 @@ export const useDashboardStats = (user: User | null) => {
         icon: IconBriefcase,
       },
     ];
-  }, [user]);
+  }, [user, tickers]);
 };
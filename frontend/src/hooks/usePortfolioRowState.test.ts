@@ -4,6 +4,7 @@ import { usePortfolioRowState } from './usePortfolioRowState';
 import { useTickers } from '@/hooks/useTickers';
 import { useQuoteQuery } from '@/hooks/useQuoteQuery';
 import { mockPortfolioItemAAPL } from '@/test/mocks';
+import { TickerInfo } from '@/lib/proto/ladder/v1/ladder';
 
 vi.mock('@/hooks/useTickers', () => ({
   useTickers: vi.fn(),
@@ -20,7 +21,7 @@ describe('usePortfolioRowState', () => {
 
   it('computes state correctly for allowed/tradable and active assets', () => {
     vi.mocked(useTickers).mockReturnValue({
-      data: [{ symbol: 'AAPL', source: 'Finnhub' }],
+      data: [TickerInfo.fromPartial({ symbol: 'AAPL', source: 'Finnhub' })],
       isLoading: false,
       error: null,
     });
@@ -49,9 +50,42 @@ describe('usePortfolioRowState', () => {
     expect(result.current.pnlStatus).toBe('positive');
   });
 
+  it('converts market value and pnl into the base currency', () => {
+    vi.mocked(useTickers).mockReturnValue({
+      data: [
+        TickerInfo.fromPartial({
+          symbol: 'AAPL',
+          source: 'Finnhub',
+          currency: 'EUR',
+          fx_rate: 1.5,
+        }),
+      ],
+      isLoading: false,
+      error: null,
+    });
+    vi.mocked(useQuoteQuery).mockReturnValue({
+      data: {
+        symbol: 'AAPL',
+        price: 160.0,
+        change: 10.0,
+        change_percent: 6.67,
+        timestamp: new Date().toISOString(),
+        source: 'Finnhub',
+        is_closed: false,
+      },
+      isLoading: false,
+      error: null,
+    } as unknown as ReturnType<typeof useQuoteQuery>);
+
+    const { result } = renderHook(() => usePortfolioRowState(mockPortfolioItemAAPL));
+
+    expect(result.current.marketValue).toBe('$2400.00');
+    expect(result.current.pnl).toBe('+$150.00');
+  });
+
   it('computes isTradable as false if symbol is not in allowed tickers list', () => {
     vi.mocked(useTickers).mockReturnValue({
-      data: [{ symbol: 'GOOG', source: 'Finnhub' }],
+      data: [TickerInfo.fromPartial({ symbol: 'GOOG', source: 'Finnhub' })],
       isLoading: false,
       error: null,
     });
@@ -76,7 +110,7 @@ describe('usePortfolioRowState', () => {
 
   it('computes isMarketClosed as true if quote says the market is closed', () => {
     vi.mocked(useTickers).mockReturnValue({
-      data: [{ symbol: 'AAPL', source: 'Finnhub' }],
+      data: [TickerInfo.fromPartial({ symbol: 'AAPL', source: 'Finnhub' })],
       isLoading: false,
       error: null,
     });
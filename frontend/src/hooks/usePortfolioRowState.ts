@@ -1,7 +1,7 @@
 import { useMemo } from 'react';
 import { useQuoteQuery } from '@/hooks/useQuoteQuery';
 import { useTickers } from '@/hooks/useTickers';
-import { formatCurrencyWithSign } from '@/lib/utils';
+import { formatCurrencyWithSign, getFxRate } from '@/lib/utils';
 import type { PortfolioItem, TickerSource } from '@/types';
 
 export const usePortfolioRowState = (item: PortfolioItem) => {
@@ -15,15 +15,17 @@ export const usePortfolioRowState = (item: PortfolioItem) => {
   const { marketValue, pnl, pnlStatus } = useMemo(() => {
     if (!quote) return { marketValue: null, pnl: null, pnlStatus: 'neutral' };
 
-    const currentMarketValue = quote.price * item.quantity;
-    const currentPnl = (quote.price - item.average_price) * item.quantity;
+    // Prices and the average price are in the instrument's currency, values in the ladder's.
+    const fxRate = getFxRate(config, item.stock_symbol);
+    const currentMarketValue = quote.price * item.quantity * fxRate;
+    const currentPnl = (quote.price - item.average_price) * item.quantity * fxRate;
 
     return {
       marketValue: `$${currentMarketValue.toFixed(2)}`,
       pnl: formatCurrencyWithSign(currentPnl),
       pnlStatus: currentPnl >= 0 ? 'positive' : 'negative',
     };
-  }, [quote, item.quantity, item.average_price]);
+  }, [quote, item.stock_symbol, item.quantity, item.average_price, config]);
 
   return {
     symbol,
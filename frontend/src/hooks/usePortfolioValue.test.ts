@@ -10,6 +10,10 @@ vi.mock('@/lib/api', () => ({
   getQuote: vi.fn(),
 }));
 
+vi.mock('@/hooks/useTickers', () => ({
+  useTickers: vi.fn(() => ({ data: undefined, isLoading: false, error: null })),
+}));
+
 describe('usePortfolioValue', () => {
   let queryClient: QueryClient;
 
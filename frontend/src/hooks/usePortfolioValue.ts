@@ -1,12 +1,15 @@
 import { getQuote } from '@/lib/api';
+import { useTickers } from '@/hooks/useTickers';
 import { queryKeys } from '@/lib/queryKeys';
 import { queryConfig } from '@/lib/queryConfig';
+import { getFxRate } from '@/lib/utils';
 import { type PortfolioItem } from '@/types';
 import { useQueries } from '@tanstack/react-query';
 import { useMemo } from 'react';
 import type { TradeSymbol } from '@/types';
 
 export const usePortfolioValue = (portfolio: Record<string, PortfolioItem> | undefined) => {
+  const { data: tickers } = useTickers();
   const symbols = useMemo(() => Object.keys(portfolio || {}), [portfolio]);
 
   const results = useQueries({
@@ -28,9 +31,9 @@ export const usePortfolioValue = (portfolio: Record<string, PortfolioItem> | und
       const quote = results[index].data;
       const quantity = portfolio[symbol]?.quantity || 0;
       const price = quote?.price || 0;
-      return acc + quantity * price;
+      return acc + quantity * price * getFxRate(tickers, symbol);
     }, 0);
-  }, [portfolio, symbols, results, isLoading, isError]);
+  }, [portfolio, symbols, results, isLoading, isError, tickers]);
 
   return { totalValue, isLoading, isError };
 };
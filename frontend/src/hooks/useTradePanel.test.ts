@@ -10,6 +10,10 @@ vi.mock('@/hooks/useAuth', () => ({
   useAuth: vi.fn(),
 }));
 
+vi.mock('@/hooks/useTickers', () => ({
+  useTickers: vi.fn(() => ({ data: undefined, isLoading: false, error: null })),
+}));
+
 vi.mock('@/hooks/useTrade', () => ({
   useTrade: vi.fn(),
 }));
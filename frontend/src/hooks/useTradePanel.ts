@@ -1,6 +1,8 @@
 import { useState } from 'react';
 import { useAuth } from '@/hooks/useAuth';
+import { useTickers } from '@/hooks/useTickers';
 import { useTrade } from '@/hooks/useTrade';
+import { getFxRate } from '@/lib/utils';
 import type { Quote, TickerSource, TradeAction, User } from '@/types';
 
 interface UseTradePanelProps {
@@ -11,9 +13,14 @@ interface UseTradePanelProps {
 export const useTradePanel = ({ quote, onTradeSuccess }: UseTradePanelProps) => {
   const [quantity, setQuantity] = useState<string>('');
   const { user } = useAuth();
+  const { data: tickers } = useTickers();
 
   const meta = getTradeMetadata(user, quote);
-  const { roundedQty, estCost } = calculateTradeMath(quantity, meta.currentPrice);
+  // The cost is paid from the balance, which is kept in the ladder's base currency.
+  const { roundedQty, estCost } = calculateTradeMath(
+    quantity,
+    meta.buyPrice * getFxRate(tickers, meta.symbol),
+  );
 
   const { executeTrade, isLoading, error } = useTrade({
     symbol: meta.symbol,
@@ -53,10 +60,13 @@ export const useTradePanel = ({ quote, onTradeSuccess }: UseTradePanelProps) =>
 const getTradeMetadata = (user: User | null | undefined, quote: Quote | null | undefined) => {
   const symbol = quote?.symbol || '';
   const currentPrice = quote?.price || 0;
+  // Two-sided quotes such as currency pairs fill buys at the ask rather than the mid price.
+  const buyPrice = parseFloat(quote?.ask || '') || currentPrice;
 
   return {
     buyingPower: user?.balance || 0,
     currentPrice,
+    buyPrice,
     symbol,
     positionQuantity: user?.portfolio?.[symbol]?.quantity || 0,
     source: (quote?.source || 'Finnhub') as TickerSource,
@@ -106,7 +106,7 @@ export const api = {
 };
 
 export const getQuote = async (req: GetQuoteRequest): Promise<Quote> => {
-  const json = await api.get(`/quotes/${req.symbol}`);
+  const json = await api.get(`/quotes/${encodeURIComponent(req.symbol)}`);
   const { quote } = GetQuoteResponse.fromJSON(json);
   if (!quote) throw new Error('Quote not found');
   return quote;
@@ -162,7 +162,7 @@ export const joinLadder = async (): Promise<void> => {
 };
 
 export const getHistory = async (req: GetHistoryRequest): Promise<Quote[]> => {
-  const json = await api.get(`/quotes/${req.symbol}/history?limit=${req.limit}`);
+  const json = await api.get(`/quotes/${encodeURIComponent(req.symbol)}/history?limit=${req.limit}`);
   const { history } = GetHistoryResponse.fromJSON(json);
   return history;
 };
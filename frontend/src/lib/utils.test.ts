@@ -1,5 +1,12 @@
 import { describe, it, expect } from 'vitest';
-import { calculateMaxBuyQuantity, calculateInvestedCapital, formatCurrencyWithSign } from './utils';
+import {
+  calculateMaxBuyQuantity,
+  calculateInvestedCapital,
+  displaySymbol,
+  formatCurrencyWithSign,
+  formatQuotePrice,
+} from './utils';
+import { TickerInfo } from '@/lib/proto/ladder/v1/ladder';
 import { mockPortfolio } from '@/test/mocks';
 
 describe('calculateMaxBuyQuantity', () => {
@@ -42,6 +49,12 @@ describe('calculateInvestedCapital', () => {
     expect(calculateInvestedCapital(mockPortfolio)).toBe(3000);
   });
 
+  it('converts average prices into the base currency at the ticker rates', () => {
+    const tickers = [TickerInfo.fromPartial({ symbol: 'MSFT', currency: 'EUR', fx_rate: 1.1 })];
+    // Expected: 10 * 150 + 5 * 300 * 1.1 = 1500 + 1650 = 3150
+    expect(calculateInvestedCapital(mockPortfolio, tickers)).toBeCloseTo(3150);
+  });
+
   it('handles null or empty portfolio gracefully', () => {
     expect(calculateInvestedCapital(null)).toBe(0);
     expect(calculateInvestedCapital(undefined)).toBe(0);
@@ -59,3 +72,25 @@ describe('formatCurrencyWithSign', () => {
     expect(formatCurrencyWithSign(-456.789)).toBe('-$456.79');
   });
 });
+
+describe('formatQuotePrice', () => {
+  it('uses the exact decimal price when present', () => {
+    expect(formatQuotePrice({ price: 0.00001235, price_decimal: '0.00001235' })).toBe('$0.00001235');
+    expect(formatQuotePrice({ price: 150.2, price_decimal: '150.20' })).toBe('$150.20');
+  });
+
+  it('falls back to two decimals without an exact price', () => {
+    expect(formatQuotePrice({ price: 150.256, price_decimal: '' })).toBe('$150.26');
+  });
+});
+
+describe('displaySymbol', () => {
+  it('strips the source prefix of an instrument ID', () => {
+    expect(displaySymbol('FH:AAPL')).toBe('AAPL');
+    expect(displaySymbol('CG:bitcoin')).toBe('bitcoin');
+  });
+
+  it('returns unqualified symbols unchanged', () => {
+    expect(displaySymbol('AAPL')).toBe('AAPL');
+  });
+});
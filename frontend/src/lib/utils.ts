@@ -1,16 +1,34 @@
-import { type PortfolioItem, type PublicProfile, type TickerSource, type User } from '@/types';
+import type { TickerInfo } from '@/lib/proto/ladder/v1/ladder';
+import {
+  type PortfolioItem,
+  type PublicProfile,
+  type Quote,
+  type TickerSource,
+  type User,
+} from '@/types';
+
+/**
+ * Returns the rate converting prices of a symbol into the ladder's base currency.
+ * Symbols without a known rate, including those quoted in the base currency, convert at 1.
+ */
+export const getFxRate = (tickers: TickerInfo[] | undefined, symbol: string): number => {
+  const ticker = tickers?.find((t) => t.symbol.toUpperCase() === symbol.toUpperCase());
+  return ticker?.fx_rate || 1;
+};
 
 /**
  * Calculates the total invested capital from a user's portfolio.
- * Invested capital is the sum of (quantity * average_price) for all items.
+ * Invested capital is the sum of (quantity * average_price) for all items, converted into
+ * the ladder's base currency if the allowed tickers are given.
  * Handles undefined/null portfolio gracefully.
  */
 export const calculateInvestedCapital = (
   portfolio: Record<string, PortfolioItem> | undefined | null,
+  tickers?: TickerInfo[],
 ): number => {
   if (!portfolio) return 0;
   return Object.values(portfolio).reduce(
-    (acc, item) => acc + item.quantity * item.average_price,
+    (acc, item) => acc + item.quantity * item.average_price * getFxRate(tickers, item.stock_symbol),
     0,
   );
 };
@@ -51,12 +69,42 @@ export const formatCurrencyWithSign = (value: number): string => {
   return `${sign}$${Math.abs(value).toFixed(2)}`;
 };
 
+/**
+ * Formats a quote's price as a dollar string with the instrument's precision
+ * (e.g. "$150.26", "$0.00001235"). Quotes without an exact price fall back to two decimals.
+ * @param quote The quote to format.
+ * @returns The formatted price.
+ */
+export const formatQuotePrice = (quote: Pick<Quote, 'price' | 'price_decimal'>): string => {
+  return `$${quote.price_decimal || quote.price.toFixed(2)}`;
+};
+
+/**
+ * Returns the symbol an instrument ID stands for at its provider, for display
+ * (e.g. "AAPL" for "FH:AAPL"). Unqualified symbols are returned unchanged.
+ * @param id The instrument ID.
+ * @returns The provider symbol.
+ */
+export const displaySymbol = (id: string): string => {
+  const separator = id.indexOf(':');
+  return separator < 0 ? id : id.slice(separator + 1);
+};
+
 /**
  * Returns configuration for a source badge based on the ticker source.
  * @param source The ticker source.
  * @returns The source badge configuration.
  */
 export const getSourceBadgeConfig = (source: TickerSource) => {
+  if (source === 'OANDA' || source === 'OA') {
+    return { variant: 'OANDA', label: 'OA', title: 'Source: OANDA' } as const;
+  }
+  if (source === 'Basket' || source === 'BK') {
+    return { variant: 'Basket', label: 'BK', title: 'Basket of instruments' } as const;
+  }
+  if (source === 'Options' || source === 'OPT') {
+    return { variant: 'Options', label: 'OPT', title: 'Option contract' } as const;
+  }
   const isCG = source === 'CoinGecko' || source === 'CG';
   return {
     variant: isCG ? 'CoinGecko' : 'Finnhub',
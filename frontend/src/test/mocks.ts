@@ -41,6 +41,11 @@ export const mockActiveQuote: Quote = {
   timestamp: new Date(),
   source: 'Finnhub',
   is_closed: false,
+  price_decimal: '150.00',
+  price_precision: 2,
+  bid: '',
+  ask: '',
+  pip_size: '',
 };
 
 export const mockClosedQuote: Quote = {
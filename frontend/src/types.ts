@@ -24,4 +24,14 @@ export type TradeAction = (typeof TradeAction)[keyof typeof TradeAction];
 export const isTradeSymbol = (value: string, validTickers: TickerInfo[]): value is TradeSymbol =>
   validTickers.some((t) => t.symbol === value);
 
-export type TickerSource = 'Finnhub' | 'CoinGecko' | 'CG' | 'FH';
+export type TickerSource =
+  | 'Finnhub'
+  | 'CoinGecko'
+  | 'OANDA'
+  | 'Basket'
+  | 'Options'
+  | 'CG'
+  | 'FH'
+  | 'OA'
+  | 'BK'
+  | 'OPT';